@@ -20,7 +20,9 @@ require (
 	github.com/rakyll/statik v0.1.7
 	github.com/solumD/auth v0.0.0-20241121111616-95b6b5d31eea
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/sync v0.8.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20241021214115-324edc3d5d38
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20241021214115-324edc3d5d38
 )
 
 require (
@@ -53,5 +55,4 @@ require (
 	golang.org/x/net v0.28.0 // indirect
 	golang.org/x/sys v0.24.0 // indirect
 	golang.org/x/text v0.19.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20241021214115-324edc3d5d38 // indirect
 )
@@ -19,13 +19,16 @@ import (
 // объект сервисного слоя (его интерфейса)
 type API struct {
 	desc.UnimplementedChatV1Server
-	chatService service.ChatService
+	chatService         service.ChatService
+	trustClientIdentity bool
 }
 
-// NewAPI возвращает новый объект имплементации API-слоя
-func NewAPI(chatService service.ChatService) *API {
+// NewAPI возвращает новый объект имплементации API-слоя. Если trustClientIdentity
+// равен false, имена пользователей в запросах сверяются с аутентифицированным пользователем
+func NewAPI(chatService service.ChatService, trustClientIdentity bool) *API {
 	return &API{
-		chatService: chatService,
+		chatService:         chatService,
+		trustClientIdentity: trustClientIdentity,
 	}
 }
 
@@ -36,6 +39,12 @@ func (i *API) CreateChat(ctx context.Context, req *desc.CreateChatRequest) (*des
 		return nil, errors.ErrDescChatIsNil
 	}
 
+	creator, err := i.identify(ctx, convertedChat.Creator)
+	if err != nil {
+		return nil, err
+	}
+	convertedChat.Creator = creator
+
 	chatID, err := i.chatService.CreateChat(ctx, convertedChat)
 	if err != nil {
 		return nil, err
@@ -53,7 +62,13 @@ func (i *API) DeleteChat(ctx context.Context, req *desc.DeleteChatRequest) (*emp
 	if req == nil {
 		return nil, fmt.Errorf("req is nil")
 	}
-	_, err := i.chatService.DeleteChat(ctx, req.GetId())
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.DeleteChat(ctx, req.GetId(), actor)
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -70,13 +85,18 @@ func (i *API) GetUserChats(ctx context.Context, req *desc.GetUserChatsRequest) (
 		return nil, fmt.Errorf("req is nil")
 	}
 
-	chatsInfo, err := i.chatService.GetUserChats(ctx, req.GetUsername())
+	username, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	chatsInfo, err := i.chatService.GetUserChats(ctx, username)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
-	logger.Info("got user's chats", zap.String("username", req.GetUsername()), zap.Any("chatsInfo", chatsInfo))
+	logger.Info("got user's chats", zap.String("username", username), zap.Any("chatsInfo", chatsInfo))
 
 	return &desc.GetUserChatsResponse{
 		Chats: converter.ToDescChatInfoFromService(chatsInfo),
@@ -86,8 +106,36 @@ func (i *API) GetUserChats(ctx context.Context, req *desc.GetUserChatsRequest) (
 // ConnectChat подключает юзера к чату и возвращает stream сообщений
 func (i *API) ConnectChat(req *desc.ConnectChatRequest,
 	stream desc.ChatV1_ConnectChatServer) error {
+	if req == nil {
+		return fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(stream.Context(), req.GetUsername())
+	if err != nil {
+		return err
+	}
+
+	err = i.chatService.ConnectChat(stream.Context(), req.GetId(), username, req.GetSinceMessageId(), stream)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
 
-	err := i.chatService.ConnectChat(stream.Context(), req.GetId(), req.GetUsername(), stream)
+// ConnectChatEvents подключает юзера к чату и возвращает stream событий чата
+func (i *API) ConnectChatEvents(req *desc.ConnectChatRequest,
+	stream desc.ChatV1_ConnectChatEventsServer) error {
+	if req == nil {
+		return fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(stream.Context(), req.GetUsername())
+	if err != nil {
+		return err
+	}
+
+	err = i.chatService.ConnectChatEvents(stream.Context(), req.GetId(), username, req.GetSinceMessageId(), stream)
 	if err != nil {
 		return err
 	}
@@ -101,7 +149,14 @@ func (i *API) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*e
 	if convertedMessage == nil {
 		return nil, errors.ErrDescMessageIsNil
 	}
-	_, err := i.chatService.SendMessage(ctx, convertedMessage)
+
+	from, err := i.identify(ctx, convertedMessage.From)
+	if err != nil {
+		return nil, err
+	}
+	convertedMessage.From = from
+
+	_, err = i.chatService.SendMessage(ctx, convertedMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -110,3 +165,26 @@ func (i *API) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*e
 
 	return &emptypb.Empty{}, nil
 }
+
+// GetChatMessages отправляет запрос в сервисный слой на получение истории сообщений чата
+func (i *API) GetChatMessages(ctx context.Context, req *desc.GetChatMessagesRequest) (*desc.GetChatMessagesResponse, error) {
+	filter := converter.ToMessagesFilterFromDesc(req)
+	if filter == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, filter.Username)
+	if err != nil {
+		return nil, err
+	}
+	filter.Username = username
+
+	page, err := i.chatService.GetChatMessages(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("got chat messages", zap.Int64("chatID", req.GetId()), zap.Int("count", len(page.Messages)))
+
+	return converter.ToDescChatMessagesFromService(page), nil
+}
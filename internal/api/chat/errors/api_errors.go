@@ -1,8 +1,18 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 var (
 	ErrDescChatIsNil    = fmt.Errorf("desc chat is nil")    // ErrDescChatIsNil grpc запрос с чатом nil
 	ErrDescMessageIsNil = fmt.Errorf("desc message is nil") // ErrDescMessageIsNil grpc запрос с сообщением nil
+
+	// ErrUnauthenticated запрос без аутентифицированного пользователя
+	ErrUnauthenticated = status.Error(codes.Unauthenticated, "user is not authenticated")
+	// ErrIdentityMismatch имя пользователя в запросе не совпадает с аутентифицированным
+	ErrIdentityMismatch = status.Error(codes.PermissionDenied, "username doesn't match authenticated user")
 )
@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// identify возвращает имя пользователя, от имени которого выполняется запрос.
+// Если клиенту не доверяют, используется аутентифицированный пользователь,
+// а запрос с другим именем отклоняется. Пустое имя в запросе означает
+// аутентифицированного пользователя
+func (i *API) identify(ctx context.Context, claimed string) (string, error) {
+	claimed = strings.TrimSpace(claimed)
+	username, ok := identity.UsernameFromContext(ctx)
+
+	if i.trustClientIdentity {
+		// на время миграции только логируем запросы, которые будут отклонены
+		if ok && claimed != username {
+			logger.Warn("username doesn't match authenticated user",
+				zap.String("claimed", claimed), zap.String("username", username))
+		}
+
+		return claimed, nil
+	}
+
+	if !ok {
+		return "", errors.ErrUnauthenticated
+	}
+
+	if len(claimed) != 0 && claimed != username {
+		return "", errors.ErrIdentityMismatch
+	}
+
+	return username, nil
+}
@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddChatMembers отправляет запрос в сервисный слой на добавление пользователей в чат
+func (i *API) AddChatMembers(ctx context.Context, req *desc.AddChatMembersRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.AddChatMembers(ctx, req.GetId(), actor, req.GetUsernames())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("added users to chat", zap.Int64("chatID", req.GetId()), zap.Strings("usernames", req.GetUsernames()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveChatMember отправляет запрос в сервисный слой на удаление пользователя из чата
+func (i *API) RemoveChatMember(ctx context.Context, req *desc.RemoveChatMemberRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.RemoveChatMember(ctx, req.GetId(), actor, req.GetMember())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveChat отправляет запрос в сервисный слой на выход пользователя из чата
+func (i *API) LeaveChat(ctx context.Context, req *desc.LeaveChatRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.LeaveChat(ctx, req.GetId(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
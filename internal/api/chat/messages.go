@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// EditMessage отправляет запрос в сервисный слой на изменение сообщения
+func (i *API) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.EditMessage(ctx, req.GetId(), req.GetMessageId(), actor, req.GetText())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("edited message in chat", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteMessage отправляет запрос в сервисный слой на удаление сообщения
+func (i *API) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.DeleteMessage(ctx, req.GetId(), req.GetMessageId(), actor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("deleted message in chat", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()),
+		zap.String("by", actor))
+
+	return &emptypb.Empty{}, nil
+}
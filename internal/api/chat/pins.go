@@ -0,0 +1,76 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/converter"
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PinMessage отправляет запрос в сервисный слой на закрепление сообщения
+func (i *API) PinMessage(ctx context.Context, req *desc.PinMessageRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.PinMessage(ctx, req.GetId(), req.GetMessageId(), actor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("pinned message in chat", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()),
+		zap.String("by", actor))
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnpinMessage отправляет запрос в сервисный слой на открепление сообщения
+func (i *API) UnpinMessage(ctx context.Context, req *desc.UnpinMessageRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.UnpinMessage(ctx, req.GetId(), req.GetMessageId(), actor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("unpinned message in chat", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()),
+		zap.String("by", actor))
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPinnedMessages отправляет запрос в сервисный слой на получение закрепленных сообщений чата
+func (i *API) ListPinnedMessages(ctx context.Context, req *desc.ListPinnedMessagesRequest) (*desc.ListPinnedMessagesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := i.chatService.ListPinnedMessages(ctx, req.GetId(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDescPinnedMessagesFromService(messages), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/converter"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+)
+
+// GetPresence отправляет запрос в сервисный слой на получение подключений пользователей
+func (i *API) GetPresence(ctx context.Context, req *desc.GetPresenceRequest) (*desc.GetPresenceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	presences, err := i.chatService.GetPresence(ctx, req.GetUsernames())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDescGetPresenceFromService(presences), nil
+}
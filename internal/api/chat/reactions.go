@@ -0,0 +1,54 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddReaction отправляет запрос в сервисный слой на добавление реакции на сообщение
+func (i *API) AddReaction(ctx context.Context, req *desc.AddReactionRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.AddReaction(ctx, req.GetId(), req.GetMessageId(), actor, req.GetEmoji())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("added reaction to message", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveReaction отправляет запрос в сервисный слой на удаление реакции с сообщения
+func (i *API) RemoveReaction(ctx context.Context, req *desc.RemoveReactionRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.RemoveReaction(ctx, req.GetId(), req.GetMessageId(), actor, req.GetEmoji())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("removed reaction from message", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MarkRead отправляет запрос в сервисный слой на отметку сообщений прочитанными
+func (i *API) MarkRead(ctx context.Context, req *desc.MarkReadRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.MarkRead(ctx, req.GetId(), username, req.GetUpToMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
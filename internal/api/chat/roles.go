@@ -0,0 +1,78 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/converter"
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RenameChat отправляет запрос в сервисный слой на переименование чата
+func (i *API) RenameChat(ctx context.Context, req *desc.RenameChatRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.RenameChat(ctx, req.GetId(), actor, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("renamed chat", zap.Int64("chatID", req.GetId()), zap.String("name", req.GetName()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// TransferOwnership отправляет запрос в сервисный слой на передачу владения чатом
+func (i *API) TransferOwnership(ctx context.Context, req *desc.TransferOwnershipRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.TransferOwnership(ctx, req.GetId(), actor, req.GetNewOwner())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("transferred chat ownership", zap.Int64("chatID", req.GetId()),
+		zap.String("from", actor), zap.String("to", req.GetNewOwner()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetMemberRole отправляет запрос в сервисный слой на изменение роли участника чата
+func (i *API) SetMemberRole(ctx context.Context, req *desc.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.SetMemberRole(ctx, req.GetId(), actor, req.GetMember(), converter.ToRoleFromDesc(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("changed chat member role", zap.Int64("chatID", req.GetId()),
+		zap.String("member", req.GetMember()), zap.String("role", req.GetRole().String()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,28 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/converter"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+)
+
+// GetMessageStatus отправляет запрос в сервисный слой на получение статуса сообщения
+func (i *API) GetMessageStatus(ctx context.Context, req *desc.GetMessageStatusRequest) (*desc.GetMessageStatusResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	actor, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := i.chatService.GetMessageStatus(ctx, req.GetId(), req.GetMessageId(), actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDescMessageStatusFromService(statuses), nil
+}
@@ -112,7 +112,7 @@ func TestCreateChat(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chat.NewAPI(chatServiceMock)
+			api := chat.NewAPI(chatServiceMock, true)
 
 			res, err := api.CreateChat(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
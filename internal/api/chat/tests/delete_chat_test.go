@@ -31,13 +31,15 @@ func TestDeleteChat(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id = gofakeit.Int64()
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
 
 		serviceErr  = fmt.Errorf("service err")
 		reqIsNilErr = fmt.Errorf("req is nil")
 
 		req = &desc.DeleteChatRequest{
-			Id: id,
+			Id:       id,
+			Username: username,
 		}
 
 		res = &emptypb.Empty{}
@@ -61,7 +63,7 @@ func TestDeleteChat(t *testing.T) {
 			err:  nil,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(&emptypb.Empty{}, nil)
+				mock.DeleteChatMock.Expect(ctx, id, username).Return(&emptypb.Empty{}, nil)
 				return mock
 			},
 		},
@@ -75,7 +77,7 @@ func TestDeleteChat(t *testing.T) {
 			err:  serviceErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(nil, serviceErr)
+				mock.DeleteChatMock.Expect(ctx, id, username).Return(nil, serviceErr)
 				return mock
 			},
 		},
@@ -102,7 +104,7 @@ func TestDeleteChat(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chat.NewAPI(chatServiceMock)
+			api := chat.NewAPI(chatServiceMock, true)
 
 			res, err := api.DeleteChat(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetChatMessages(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetChatMessagesRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+		beforeID = int64(gofakeit.IntRange(100, 1000))
+		limit    = int64(gofakeit.IntRange(1, 100))
+
+		firstText  = gofakeit.Fruit()
+		secondText = gofakeit.Fruit()
+		firstAt    = gofakeit.Date()
+		secondAt   = gofakeit.Date()
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		req = &desc.GetChatMessagesRequest{
+			Id:       chatID,
+			Username: username,
+			BeforeId: beforeID,
+			Limit:    limit,
+		}
+
+		filter = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			BeforeID: beforeID,
+			Limit:    uint64(limit),
+		}
+
+		page = &model.MessagesPage{
+			Messages: []*model.Message{
+				{ID: beforeID - 2, ChatID: chatID, From: username, Text: firstText, CreatedAt: firstAt},
+				{ID: beforeID - 1, ChatID: chatID, From: username, Text: secondText, CreatedAt: secondAt},
+			},
+			HasMore: true,
+		}
+
+		res = &desc.GetChatMessagesResponse{
+			Messages: []*desc.Message{
+				{Id: beforeID - 2, From: username, Text: firstText, CreatedAt: timestamppb.New(firstAt)},
+				{Id: beforeID - 1, From: username, Text: secondText, CreatedAt: timestamppb.New(secondAt)},
+			},
+			HasMore:  true,
+			OldestId: beforeID - 2,
+			NewestId: beforeID - 1,
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.GetChatMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetChatMessagesMock.Expect(ctx, filter).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "success empty page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &desc.GetChatMessagesResponse{
+				Messages: []*desc.Message{},
+			},
+			err: nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetChatMessagesMock.Expect(ctx, filter).Return(&model.MessagesPage{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetChatMessagesMock.Expect(ctx, filter).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			args: args{
+				ctx: ctx,
+				req: nil,
+			},
+			want: nil,
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewAPI(chatServiceMock, true)
+
+			res, err := api.GetChatMessages(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAddChatMembers(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		usernames = []string{gofakeit.Username(), gofakeit.Username()}
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.AddChatMembersRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.AddChatMembersRequest{Id: id, Usernames: usernames},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.AddChatMembersMock.Expect(ctx, id, actor, usernames).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.AddChatMembersRequest{Id: id, Username: gofakeit.Username(), Usernames: usernames},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.AddChatMembersRequest{Id: id, Usernames: usernames},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.AddChatMembersMock.Expect(ctx, id, actor, usernames).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.AddChatMembers(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRemoveChatMember(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id     = gofakeit.Int64()
+		actor  = gofakeit.Username()
+		member = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.RemoveChatMemberRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.RemoveChatMemberRequest{Id: id, Username: actor, Member: member},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.RemoveChatMemberMock.Expect(ctx, id, actor, member).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.RemoveChatMemberRequest{Id: id, Username: member, Member: member},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.RemoveChatMemberRequest{Id: id, Member: member},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.RemoveChatMemberMock.Expect(ctx, id, actor, member).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.RemoveChatMember(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestLeaveChat(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), username)
+
+		serviceErr = fmt.Errorf("service err")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.LeaveChatRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.LeaveChatRequest{Id: id},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.LeaveChatMock.Expect(ctx, id, username).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error another user can't be made to leave",
+			req:  &desc.LeaveChatRequest{Id: id, Username: gofakeit.Username()},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.LeaveChatRequest{Id: id},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.LeaveChatMock.Expect(ctx, id, username).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.LeaveChat(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
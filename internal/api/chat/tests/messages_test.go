@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		text      = gofakeit.Fruit()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.EditMessageRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.EditMessageRequest{Id: id, MessageId: messageID, Text: text},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(ctx, id, messageID, actor, text).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.EditMessageRequest{Id: id, Username: gofakeit.Username(), MessageId: messageID, Text: text},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.EditMessageRequest{Id: id, MessageId: messageID, Text: text},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(ctx, id, messageID, actor, text).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.EditMessage(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.DeleteMessageRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.DeleteMessageRequest{Id: id, MessageId: messageID},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, id, messageID, actor).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.DeleteMessageRequest{Id: id, Username: gofakeit.Username(), MessageId: messageID},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.DeleteMessageRequest{Id: id, MessageId: messageID},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, id, messageID, actor).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.DeleteMessage(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
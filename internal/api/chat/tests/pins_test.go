@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.PinMessageRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.PinMessageRequest{Id: id, MessageId: messageID},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.PinMessageMock.Expect(ctx, id, messageID, actor).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.PinMessageRequest{Id: id, Username: gofakeit.Username(), MessageId: messageID},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.PinMessageRequest{Id: id, MessageId: messageID},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.PinMessageMock.Expect(ctx, id, messageID, actor).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.PinMessage(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestUnpinMessage(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.UnpinMessageRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.UnpinMessageRequest{Id: id, MessageId: messageID},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.UnpinMessageMock.Expect(ctx, id, messageID, actor).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.UnpinMessageRequest{Id: id, MessageId: messageID},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.UnpinMessageMock.Expect(ctx, id, messageID, actor).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.UnpinMessage(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestListPinnedMessages(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		username  = gofakeit.Username()
+		text      = gofakeit.Fruit()
+		emoji     = gofakeit.Emoji()
+		createdAt = gofakeit.Date()
+
+		ctx = identity.WithUsername(context.Background(), username)
+
+		messages = []*model.Message{
+			{ID: 2, ChatID: id, From: username, Text: text, CreatedAt: createdAt,
+				Reactions: []*model.Reaction{{Emoji: emoji, Count: 1}}},
+		}
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.ListPinnedMessagesRequest
+		want            *desc.ListPinnedMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.ListPinnedMessagesRequest{Id: id},
+			want: &desc.ListPinnedMessagesResponse{
+				Messages: []*desc.Message{{
+					Id:        2,
+					From:      username,
+					Text:      text,
+					CreatedAt: timestamppb.New(createdAt),
+					Reactions: []*desc.Reaction{{Emoji: emoji, Count: 1}},
+				}},
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListPinnedMessagesMock.Expect(ctx, id, username).Return(messages, nil)
+				return mock
+			},
+		},
+		{
+			name: "success no pinned messages",
+			req:  &desc.ListPinnedMessagesRequest{Id: id},
+			want: &desc.ListPinnedMessagesResponse{Messages: []*desc.Message{}},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListPinnedMessagesMock.Expect(ctx, id, username).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.ListPinnedMessagesRequest{Id: id},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListPinnedMessagesMock.Expect(ctx, id, username).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.ListPinnedMessages(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetPresence(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		alice    = gofakeit.Username()
+		bob      = gofakeit.Username()
+		lastSeen = gofakeit.Date()
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.GetPresenceRequest
+		want            *desc.GetPresenceResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.GetPresenceRequest{Usernames: []string{alice, bob}},
+			want: &desc.GetPresenceResponse{
+				Presences: []*desc.Presence{
+					{Username: alice, LastSeenAt: timestamppb.New(lastSeen)},
+					{Username: bob, Online: true},
+				},
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetPresenceMock.Expect(ctx, []string{alice, bob}).Return([]*model.Presence{
+					{Username: alice, LastSeenAt: sql.NullTime{Time: lastSeen, Valid: true}},
+					{Username: bob, Online: true},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.GetPresenceRequest{Usernames: []string{alice}},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetPresenceMock.Expect(ctx, []string{alice}).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.GetPresence(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		emoji     = gofakeit.Emoji()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.AddReactionRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.AddReactionRequest{Id: id, MessageId: messageID, Emoji: emoji},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.AddReactionMock.Expect(ctx, id, messageID, actor, emoji).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.AddReactionRequest{Id: id, Username: gofakeit.Username(), MessageId: messageID, Emoji: emoji},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.AddReactionRequest{Id: id, MessageId: messageID, Emoji: emoji},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.AddReactionMock.Expect(ctx, id, messageID, actor, emoji).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.AddReaction(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestRemoveReaction(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		emoji     = gofakeit.Emoji()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.RemoveReactionRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.RemoveReactionRequest{Id: id, MessageId: messageID, Emoji: emoji},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.RemoveReactionMock.Expect(ctx, id, messageID, actor, emoji).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.RemoveReactionRequest{Id: id, MessageId: messageID, Emoji: emoji},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.RemoveReactionMock.Expect(ctx, id, messageID, actor, emoji).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.RemoveReaction(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.MarkReadRequest
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success actor from authenticated user",
+			req:  &desc.MarkReadRequest{Id: id, UpToMessageId: messageID},
+			want: res,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.MarkReadMock.Expect(ctx, id, actor, messageID).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.MarkReadRequest{Id: id, Username: gofakeit.Username(), UpToMessageId: messageID},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.MarkReadRequest{Id: id, UpToMessageId: messageID},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.MarkReadMock.Expect(ctx, id, actor, messageID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.MarkRead(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/solumD/chat-server/internal/api/chat"
 	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
 	"github.com/solumD/chat-server/internal/logger"
 	"github.com/solumD/chat-server/internal/model"
 	"github.com/solumD/chat-server/internal/service"
@@ -51,6 +52,20 @@ func TestSendMessage(t *testing.T) {
 			Text:   text,
 		}
 
+		replyToID = gofakeit.Int64()
+		replyReq  = &desc.SendMessageRequest{
+			Id:               id,
+			From:             from,
+			Text:             text,
+			ReplyToMessageId: replyToID,
+		}
+		replyInfo = &model.Message{
+			ChatID:           id,
+			From:             from,
+			Text:             text,
+			ReplyToMessageID: replyToID,
+		}
+
 		res = &emptypb.Empty{}
 	)
 	defer t.Cleanup(mc.Finish)
@@ -76,6 +91,20 @@ func TestSendMessage(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "success reply",
+			args: args{
+				ctx: ctx,
+				req: replyReq,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Expect(ctx, replyInfo).Return(&emptypb.Empty{}, nil)
+				return mock
+			},
+		},
 		{
 			name: "error case",
 			args: args{
@@ -113,7 +142,7 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chat.NewAPI(chatServiceMock)
+			api := chat.NewAPI(chatServiceMock, true)
 
 			res, err := api.SendMessage(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -121,3 +150,109 @@ func TestSendMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSendMessageIdentity(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
+		other    = gofakeit.Username() + "other"
+		text     = gofakeit.Fruit()
+
+		authCtx = identity.WithUsername(context.Background(), username)
+
+		req = &desc.SendMessageRequest{
+			Id:   id,
+			From: username,
+			Text: text,
+		}
+
+		otherReq = &desc.SendMessageRequest{
+			Id:   id,
+			From: other,
+			Text: text,
+		}
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		req                 *desc.SendMessageRequest
+		trustClientIdentity bool
+		want                *emptypb.Empty
+		err                 error
+		chatServiceMock     chatServiceMockFunc
+	}{
+		{
+			name:                "success authenticated sender",
+			ctx:                 authCtx,
+			req:                 req,
+			trustClientIdentity: false,
+			want:                res,
+			err:                 nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Expect(authCtx, &model.Message{ChatID: id, From: username, Text: text}).Return(res, nil)
+				return mock
+			},
+		},
+		{
+			name:                "error sender mismatch",
+			ctx:                 authCtx,
+			req:                 otherReq,
+			trustClientIdentity: false,
+			want:                nil,
+			err:                 errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:                "error not authenticated",
+			ctx:                 context.Background(),
+			req:                 req,
+			trustClientIdentity: false,
+			want:                nil,
+			err:                 errors.ErrUnauthenticated,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:                "success trust client sender mismatch",
+			ctx:                 authCtx,
+			req:                 otherReq,
+			trustClientIdentity: true,
+			want:                res,
+			err:                 nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Expect(authCtx, &model.Message{ChatID: id, From: other, Text: text}).Return(res, nil)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), tt.trustClientIdentity)
+
+			res, err := api.SendMessage(tt.ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
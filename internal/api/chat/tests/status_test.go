@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetMessageStatus(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		alice     = gofakeit.Username()
+		bob       = gofakeit.Username()
+		carol     = gofakeit.Username()
+		readAt    = gofakeit.Date()
+
+		ctx = identity.WithUsername(context.Background(), alice)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.GetMessageStatusRequest
+		want            *desc.GetMessageStatusResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.GetMessageStatusRequest{Id: chatID, MessageId: messageID},
+			want: &desc.GetMessageStatusResponse{
+				Recipients: []*desc.RecipientStatus{
+					{Username: bob, Delivered: true, Read: true, ReadAt: timestamppb.New(readAt)},
+					{Username: carol},
+				},
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMessageStatusMock.Expect(ctx, chatID, messageID, alice).Return([]*model.MessageStatus{
+					{Username: bob, Delivered: true, Read: true, ReadAt: sql.NullTime{Time: readAt, Valid: true}},
+					{Username: carol},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.GetMessageStatusRequest{Id: chatID, Username: bob, MessageId: messageID},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.GetMessageStatusRequest{Id: chatID, MessageId: messageID},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMessageStatusMock.Expect(ctx, chatID, messageID, alice).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.GetMessageStatus(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
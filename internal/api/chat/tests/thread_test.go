@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/api/chat"
+	"github.com/solumD/chat-server/internal/api/chat/errors"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/service"
+	serviceMocks "github.com/solumD/chat-server/internal/service/mocks"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetThread(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		text      = gofakeit.Fruit()
+		createdAt = gofakeit.Date()
+
+		ctx = identity.WithUsername(context.Background(), actor)
+
+		serviceErr  = fmt.Errorf("service err")
+		reqIsNilErr = fmt.Errorf("req is nil")
+
+		filter = &model.MessagesFilter{
+			ChatID:           chatID,
+			Username:         actor,
+			BeforeID:         messageID + 10,
+			Limit:            1,
+			ReplyToMessageID: messageID,
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name            string
+		req             *desc.GetThreadRequest
+		want            *desc.GetChatMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success",
+			req:  &desc.GetThreadRequest{Id: chatID, MessageId: messageID, BeforeId: messageID + 10, Limit: 1},
+			want: &desc.GetChatMessagesResponse{
+				Messages: []*desc.Message{
+					{Id: messageID + 1, From: actor, Text: text, CreatedAt: timestamppb.New(createdAt), ReplyToMessageId: messageID},
+				},
+				HasMore:  true,
+				OldestId: messageID + 1,
+				NewestId: messageID + 1,
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetThreadMock.Expect(ctx, filter).Return(&model.MessagesPage{
+					Messages: []*model.Message{
+						{ID: messageID + 1, ChatID: chatID, From: actor, Text: text, CreatedAt: createdAt, ReplyToMessageID: messageID},
+					},
+					HasMore: true,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error identity mismatch",
+			req:  &desc.GetThreadRequest{Id: chatID, Username: gofakeit.Username(), MessageId: messageID},
+			err:  errors.ErrIdentityMismatch,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error",
+			req:  &desc.GetThreadRequest{Id: chatID, MessageId: messageID, BeforeId: messageID + 10, Limit: 1},
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetThreadMock.Expect(ctx, filter).Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "error req is nil",
+			err:  reqIsNilErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chat.NewAPI(tt.chatServiceMock(mc), false)
+
+			res, err := api.GetThread(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solumD/chat-server/internal/converter"
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"go.uber.org/zap"
+)
+
+// GetThread отправляет запрос в сервисный слой на получение ответов на сообщение
+func (i *API) GetThread(ctx context.Context, req *desc.GetThreadRequest) (*desc.GetChatMessagesResponse, error) {
+	filter := converter.ToThreadFilterFromDesc(req)
+	if filter == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, filter.Username)
+	if err != nil {
+		return nil, err
+	}
+	filter.Username = username
+
+	page, err := i.chatService.GetThread(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("got message thread", zap.Int64("chatID", req.GetId()), zap.Int64("messageID", req.GetMessageId()),
+		zap.Int("count", len(page.Messages)))
+
+	return converter.ToDescChatMessagesFromService(page), nil
+}
@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetTyping отправляет запрос в сервисный слой на обновление набора текста
+func (i *API) SetTyping(ctx context.Context, req *desc.SetTypingRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, fmt.Errorf("req is nil")
+	}
+
+	username, err := i.identify(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatService.SetTyping(ctx, req.GetId(), username, req.GetIsTyping())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -57,6 +57,11 @@ func NewApp(ctx context.Context) (*App, error) {
 // Run запускает приложение
 func (a *App) Run() error {
 	defer func() {
+		// при закрытии накопитель сохраняет оставшиеся доставки в БД, а closer
+		// закрывает все одновременно, в том числе клиент БД, поэтому накопитель
+		// закрывается первым
+		a.closeDeliveryRecorder()
+
 		closer.CloseAll()
 		closer.Wait()
 	}()
@@ -104,6 +109,9 @@ func (a *App) initDeps(ctx context.Context) error {
 
 	a.initServiceProvider()
 	a.initGRPCServer(ctx)
+	a.initOutboxRelay(ctx)
+	a.initPresenceAnnouncer(ctx)
+	a.initDeliveryRecorder(ctx)
 
 	err = a.initHTTPServer(ctx)
 	if err != nil {
@@ -139,12 +147,32 @@ func (a *App) initGRPCServer(ctx context.Context) {
 
 	logger.Init(logger.GetCore(logger.GetAtomicLevel(a.serviceProvider.LoggerConfig().Level())))
 
+	// ошибки переводятся в gRPC статусы после всех остальных интерцепторов,
+	// но до логгирования, чтобы в логи попадал итоговый код ответа
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptor.LogInterceptor,
+		interceptor.ErrorsInterceptor,
+		interceptor.ValidateInterceptor,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptor.LogStreamInterceptor,
+		interceptor.ErrorsStreamInterceptor,
+		interceptor.ValidateStreamInterceptor,
+	}
+
+	// unary и stream запросы аутентифицируются одним и тем же клиентом auth
+	if a.serviceProvider.AuthConfig().Enabled() {
+		authInterceptor := interceptor.NewAuthInterceptor(a.serviceProvider.AuthClient(ctx))
+		unaryInterceptors = append(unaryInterceptors, authInterceptor.Get())
+		streamInterceptors = append(streamInterceptors, authInterceptor.GetStream())
+	}
+
 	a.grpcServer = grpc.NewServer(
 		grpc.UnaryInterceptor(
-			grpcMW.ChainUnaryServer(
-				interceptor.LogInterceptor,
-				interceptor.ValidateInterceptor),
-			//interceptor.NewAuthInterceptor(a.serviceProvider.AuthClient(ctx)).Get()),
+			grpcMW.ChainUnaryServer(unaryInterceptors...),
+		),
+		grpc.StreamInterceptor(
+			grpcMW.ChainStreamServer(streamInterceptors...),
 		),
 		grpc.Creds(creds),
 	)
@@ -155,6 +183,29 @@ func (a *App) initGRPCServer(ctx context.Context) {
 
 }
 
+func (a *App) initOutboxRelay(ctx context.Context) {
+	a.serviceProvider.OutboxRelay(ctx).Start()
+}
+
+func (a *App) initPresenceAnnouncer(ctx context.Context) {
+	a.serviceProvider.PresenceAnnouncer(ctx).Start()
+}
+
+func (a *App) initDeliveryRecorder(ctx context.Context) {
+	a.serviceProvider.DeliveryRecorder(ctx).Start()
+}
+
+func (a *App) closeDeliveryRecorder() {
+	if a.serviceProvider.deliveryRecorder == nil {
+		return
+	}
+
+	err := a.serviceProvider.deliveryRecorder.Close()
+	if err != nil {
+		log.Printf("failed to close delivery recorder: %v", err)
+	}
+}
+
 func (a *App) initHTTPServer(ctx context.Context) error {
 	mux := runtime.NewServeMux()
 
@@ -12,10 +12,19 @@ import (
 	"github.com/solumD/chat-server/internal/client/db/transaction"
 	"github.com/solumD/chat-server/internal/closer"
 	"github.com/solumD/chat-server/internal/config"
+	"github.com/solumD/chat-server/internal/delivery"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/outbox"
+	"github.com/solumD/chat-server/internal/presence"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	pgPubSub "github.com/solumD/chat-server/internal/pubsub/pg"
 	"github.com/solumD/chat-server/internal/repository"
 	chatRepo "github.com/solumD/chat-server/internal/repository/chat"
+	outboxRepo "github.com/solumD/chat-server/internal/repository/outbox"
 	"github.com/solumD/chat-server/internal/service"
 	chatSrv "github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/internal/typing"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -23,20 +32,39 @@ import (
 
 // Структура приложения со всеми зависимостями
 type serviceProvider struct {
-	pgConfig      config.PGConfig
-	grpcConfig    config.GRPCConfig
-	httpConfig    config.HTTPConfig
-	swaggerConfig config.SwaggerConfig
-	authConfig    config.AuthConfig
-	loggerConfig  config.LoggerConfig
+	pgConfig        config.PGConfig
+	grpcConfig      config.GRPCConfig
+	httpConfig      config.HTTPConfig
+	swaggerConfig   config.SwaggerConfig
+	authConfig      config.AuthConfig
+	authCacheConfig config.AuthCacheConfig
+	loggerConfig    config.LoggerConfig
+	hubConfig       config.HubConfig
+	pubSubConfig    config.PubSubConfig
+	outboxConfig    config.OutboxConfig
+	typingConfig    config.TypingConfig
+	presenceConfig  config.PresenceConfig
+	deliveryConfig  config.DeliveryConfig
 
 	dbClient   db.Client
 	txManager  db.TxManager
 	authClient auth.Client
+	chatHub    *hub.Hub
+	pubSub     pubsub.PubSub
+	relay      *outbox.Relay
 
-	chatRepository repository.ChatRepository
-	chatService    service.ChatService
-	chatImpl       *api.API
+	typingTracker *typing.Tracker
+	typingLimiter *typing.Limiter
+
+	presenceRegistry  *presence.Registry
+	presenceAnnouncer *presence.Announcer
+
+	deliveryRecorder *delivery.Recorder
+
+	chatRepository   repository.ChatRepository
+	outboxRepository repository.OutboxRepository
+	chatService      service.ChatService
+	chatImpl         *api.API
 }
 
 // NewServiceProvider возвращает новый объект API слоя
@@ -128,6 +156,62 @@ func (s *serviceProvider) AuthConfig() config.AuthConfig {
 	return s.authConfig
 }
 
+// AuthCacheConfig инициализирует конфиг кэша проверок доступа
+func (s *serviceProvider) AuthCacheConfig() config.AuthCacheConfig {
+	if s.authCacheConfig == nil {
+		cfg, err := config.NewAuthCacheConfig()
+		if err != nil {
+			log.Fatalf("failed to get auth cache config: %v", err)
+		}
+
+		s.authCacheConfig = cfg
+	}
+
+	return s.authCacheConfig
+}
+
+// HubConfig инициализирует конфиг hub'а подписчиков чатов
+func (s *serviceProvider) HubConfig() config.HubConfig {
+	if s.hubConfig == nil {
+		cfg, err := config.NewHubConfig()
+		if err != nil {
+			log.Fatalf("failed to get hub config: %v", err)
+		}
+
+		s.hubConfig = cfg
+	}
+
+	return s.hubConfig
+}
+
+// PubSubConfig инициализирует конфиг доставки сообщений между экземплярами сервера
+func (s *serviceProvider) PubSubConfig() config.PubSubConfig {
+	if s.pubSubConfig == nil {
+		cfg, err := config.NewPubSubConfig()
+		if err != nil {
+			log.Fatalf("failed to get pubsub config: %v", err)
+		}
+
+		s.pubSubConfig = cfg
+	}
+
+	return s.pubSubConfig
+}
+
+// OutboxConfig инициализирует конфиг обработчика outbox
+func (s *serviceProvider) OutboxConfig() config.OutboxConfig {
+	if s.outboxConfig == nil {
+		cfg, err := config.NewOutboxConfig()
+		if err != nil {
+			log.Fatalf("failed to get outbox config: %v", err)
+		}
+
+		s.outboxConfig = cfg
+	}
+
+	return s.outboxConfig
+}
+
 // DBClient инициализирует клиент базы данных
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
@@ -163,7 +247,14 @@ func (s *serviceProvider) AuthClient(ctx context.Context) auth.Client {
 		closer.Add(conn.Close)
 
 		client := access_v1.NewAccessV1Client(conn)
-		s.authClient = auth.New(client)
+		s.authClient = auth.NewCachedClient(auth.New(client), auth.CacheConfig{
+			TTL:              s.AuthCacheConfig().TTL(),
+			NegativeTTL:      s.AuthCacheConfig().NegativeTTL(),
+			FailureThreshold: s.AuthCacheConfig().FailureThreshold(),
+			OpenTimeout:      s.AuthCacheConfig().OpenTimeout(),
+			FailOpen:         s.AuthCacheConfig().FailOpen(),
+			CheckTimeout:     s.AuthCacheConfig().CheckTimeout(),
+		})
 	}
 
 	return s.authClient
@@ -178,6 +269,107 @@ func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	return s.txManager
 }
 
+// ChatHub инициализирует hub подписчиков чатов
+func (s *serviceProvider) ChatHub() *hub.Hub {
+	if s.chatHub == nil {
+		policy, err := hub.ParsePolicy(s.HubConfig().SlowConsumerPolicy())
+		if err != nil {
+			log.Fatalf("failed to get hub policy: %v", err)
+		}
+
+		s.chatHub = hub.New(s.HubConfig().QueueSize(), policy)
+	}
+
+	return s.chatHub
+}
+
+// PubSub инициализирует доставку сообщений между экземплярами сервера
+func (s *serviceProvider) PubSub(ctx context.Context) pubsub.PubSub {
+	if s.pubSub == nil {
+		switch s.PubSubConfig().Driver() {
+		case config.PubSubDriverPG:
+			s.pubSub = pgPubSub.New(s.DBClient(ctx), s.PubSubConfig().Channel())
+		default:
+			s.pubSub = memory.New()
+		}
+
+		closer.Add(s.pubSub.Close)
+	}
+
+	return s.pubSub
+}
+
+// TypingConfig инициализирует конфиг индикаторов набора текста
+func (s *serviceProvider) TypingConfig() config.TypingConfig {
+	if s.typingConfig == nil {
+		cfg, err := config.NewTypingConfig()
+		if err != nil {
+			log.Fatalf("failed to get typing config: %v", err)
+		}
+
+		s.typingConfig = cfg
+	}
+
+	return s.typingConfig
+}
+
+// TypingTracker инициализирует трекер набора текста
+func (s *serviceProvider) TypingTracker() *typing.Tracker {
+	if s.typingTracker == nil {
+		s.typingTracker = typing.New(s.TypingConfig().TTL())
+	}
+
+	return s.typingTracker
+}
+
+// TypingLimiter инициализирует ограничитель частоты обновлений набора текста
+func (s *serviceProvider) TypingLimiter() *typing.Limiter {
+	if s.typingLimiter == nil {
+		s.typingLimiter = typing.NewLimiter(s.TypingConfig().RateLimit(), s.TypingConfig().RateWindow())
+	}
+
+	return s.typingLimiter
+}
+
+// PresenceConfig инициализирует конфиг подключенных пользователей
+func (s *serviceProvider) PresenceConfig() config.PresenceConfig {
+	if s.presenceConfig == nil {
+		cfg, err := config.NewPresenceConfig()
+		if err != nil {
+			log.Fatalf("failed to get presence config: %v", err)
+		}
+
+		s.presenceConfig = cfg
+	}
+
+	return s.presenceConfig
+}
+
+// PresenceRegistry инициализирует реестр подключенных пользователей
+func (s *serviceProvider) PresenceRegistry() *presence.Registry {
+	if s.presenceRegistry == nil {
+		s.presenceRegistry = presence.New(s.PresenceConfig().TTL())
+	}
+
+	return s.presenceRegistry
+}
+
+// PresenceAnnouncer инициализирует объявление подключенных к экземпляру пользователей
+func (s *serviceProvider) PresenceAnnouncer(ctx context.Context) *presence.Announcer {
+	if s.presenceAnnouncer == nil {
+		s.presenceAnnouncer = presence.NewAnnouncer(
+			presence.NewInstanceID(),
+			s.PubSub(ctx),
+			s.ChatHub().OnlineUsers,
+			s.PresenceConfig().HeartbeatInterval(),
+		)
+
+		closer.Add(s.presenceAnnouncer.Close)
+	}
+
+	return s.presenceAnnouncer
+}
+
 // ChatRepository инициализирует репо слой
 func (s *serviceProvider) ChatReposistory(ctx context.Context) repository.ChatRepository {
 	if s.chatRepository == nil {
@@ -187,10 +379,76 @@ func (s *serviceProvider) ChatReposistory(ctx context.Context) repository.ChatRe
 	return s.chatRepository
 }
 
+// OutboxRepository инициализирует репо слой outbox
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = outboxRepo.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+// OutboxRelay инициализирует обработчик outbox
+func (s *serviceProvider) OutboxRelay(ctx context.Context) *outbox.Relay {
+	if s.relay == nil {
+		s.relay = outbox.NewRelay(
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			s.PubSub(ctx),
+			s.OutboxConfig().PollInterval(),
+			s.OutboxConfig().BatchSize(),
+		)
+
+		closer.Add(s.relay.Close)
+	}
+
+	return s.relay
+}
+
+// DeliveryConfig инициализирует конфиг сохранения статусов доставки сообщений
+func (s *serviceProvider) DeliveryConfig() config.DeliveryConfig {
+	if s.deliveryConfig == nil {
+		cfg, err := config.NewDeliveryConfig()
+		if err != nil {
+			log.Fatalf("failed to get delivery config: %v", err)
+		}
+
+		s.deliveryConfig = cfg
+	}
+
+	return s.deliveryConfig
+}
+
+// DeliveryRecorder инициализирует накопитель доставок сообщений. Накопитель
+// не добавляется в closer: он закрывается в App.Run до клиента БД
+func (s *serviceProvider) DeliveryRecorder(ctx context.Context) *delivery.Recorder {
+	if s.deliveryRecorder == nil {
+		s.deliveryRecorder = delivery.NewRecorder(
+			s.ChatReposistory(ctx),
+			s.TxManager(ctx),
+			s.DeliveryConfig().FlushInterval(),
+			s.DeliveryConfig().BatchSize(),
+		)
+	}
+
+	return s.deliveryRecorder
+}
+
 // ChatService иницилизирует сервисный слой
 func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
-		s.chatService = chatSrv.NewService(s.ChatReposistory(ctx), s.TxManager(ctx))
+		s.chatService = chatSrv.NewService(
+			s.ChatReposistory(ctx),
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			s.ChatHub(),
+			s.PubSub(ctx),
+			s.TypingTracker(),
+			s.TypingLimiter(),
+			s.PresenceRegistry(),
+			s.PresenceAnnouncer(ctx),
+			s.DeliveryRecorder(ctx),
+		)
 	}
 
 	return s.chatService
@@ -199,7 +457,7 @@ func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 // ChatAPI инициализирует api слой
 func (s *serviceProvider) ChatAPI(ctx context.Context) *api.API {
 	if s.chatImpl == nil {
-		s.chatImpl = api.NewAPI(s.ChatService(ctx))
+		s.chatImpl = api.NewAPI(s.ChatService(ctx), s.AuthConfig().TrustClientIdentity())
 	}
 
 	return s.chatImpl
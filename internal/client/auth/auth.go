@@ -9,7 +9,7 @@ import (
 
 // Client интерфейс клиента auth
 type Client interface {
-	Check(ctx context.Context, endpoint string) error
+	Check(ctx context.Context, endpoint string) (string, error)
 }
 
 type client struct {
@@ -23,15 +23,21 @@ func New(accessClient access_v1.AccessV1Client) Client {
 	}
 }
 
-// Check отправляет запрос в сервис auth на проверкку доступа
-func (c *client) Check(ctx context.Context, endpoint string) error {
+// Check отправляет запрос в сервис auth на проверкку доступа и возвращает
+// имя пользователя, которому принадлежит проверенный токен
+func (c *client) Check(ctx context.Context, endpoint string) (string, error) {
 	req := &access_v1.CheckRequest{
 		EndpointAddress: endpoint,
 	}
 
 	if _, err := c.accessClient.Check(ctx, req); err != nil {
-		return fmt.Errorf("access check error: %v", err)
+		return "", fmt.Errorf("access check error: %w", err)
 	}
 
-	return nil
+	token, err := accessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return usernameFromToken(token)
 }
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed   breakerState = iota // запросы проходят
+	breakerOpen                         // запросы не отправляются до истечения openTimeout
+	breakerHalfOpen                     // пропускается один пробный запрос
+)
+
+// breaker circuit breaker запросов к сервису auth. После failureThreshold
+// ошибок подряд размыкается на openTimeout, затем пропускает один пробный
+// запрос и по его результату замыкается или снова размыкается
+type breaker struct {
+	failureThreshold int
+	openTimeout      time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func newBreaker(failureThreshold int, openTimeout time.Duration) *breaker {
+	return &breaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+	}
+}
+
+// allow сообщает, можно ли отправить запрос
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return false
+		}
+
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		// пробный запрос уже отправлен
+		return false
+	default:
+		return true
+	}
+}
+
+// success фиксирует успешный запрос
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+}
+
+// failure фиксирует недоступность сервиса
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.failureThreshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/logger"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxCacheEntries максимальное количество записей в кэше проверок и в кэше токенов
+	maxCacheEntries = 10000
+	// evictInterval как часто из кэша удаляются истекшие записи
+	evictInterval = time.Minute
+	// defaultCheckTimeout сколько ждать ответа сервиса auth, если таймаут не задан
+	defaultCheckTimeout = 5 * time.Second
+)
+
+// ErrUnavailable сервис auth недоступен, а клиент работает в режиме fail-closed
+var ErrUnavailable = status.Error(codes.Unavailable, "auth service is unavailable")
+
+// CacheConfig параметры кэширующего клиента auth
+type CacheConfig struct {
+	TTL              time.Duration // время жизни разрешенной проверки
+	NegativeTTL      time.Duration // время жизни запрещенной проверки
+	FailureThreshold int           // ошибок подряд, после которых размыкается circuit breaker
+	OpenTimeout      time.Duration // на сколько размыкается circuit breaker
+	FailOpen         bool          // пропускать ли запросы с проверенными ранее токенами, пока сервис auth недоступен
+	CheckTimeout     time.Duration // сколько ждать ответа сервиса auth, по умолчанию defaultCheckTimeout
+}
+
+type cacheEntry struct {
+	username  string
+	err       error
+	expiresAt time.Time
+}
+
+// cachedClient кэширует результаты проверок доступа по токену и эндпоинту,
+// объединяет одновременные одинаковые проверки и не нагружает недоступный
+// сервис auth благодаря circuit breaker
+type cachedClient struct {
+	client  Client
+	cfg     CacheConfig
+	breaker *breaker
+	group   singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	// tokens токены, которые сервис auth уже признал подлинными, и их владельцы
+	tokens    map[string]cacheEntry
+	evictedAt time.Time
+}
+
+// NewCachedClient возвращает кэширующий декоратор клиента auth
+func NewCachedClient(client Client, cfg CacheConfig) Client {
+	if cfg.CheckTimeout <= 0 {
+		cfg.CheckTimeout = defaultCheckTimeout
+	}
+
+	return &cachedClient{
+		client:  client,
+		cfg:     cfg,
+		breaker: newBreaker(cfg.FailureThreshold, cfg.OpenTimeout),
+		entries: make(map[string]cacheEntry),
+		tokens:  make(map[string]cacheEntry),
+	}
+}
+
+// Check возвращает закэшированный результат проверки или проверяет доступ в сервисе auth
+func (c *cachedClient) Check(ctx context.Context, endpoint string) (string, error) {
+	token, _ := accessToken(ctx)
+	key := token + " " + endpoint
+
+	if entry, ok := c.get(key); ok {
+		return entry.username, entry.err
+	}
+
+	resCh := c.group.DoChan(key, func() (interface{}, error) {
+		// результат разделяют все ожидающие запросы, поэтому отмена
+		// контекста первого из них не должна прерывать проверку. Зависший
+		// сервис auth прерывается таймаутом и считается недоступным
+		checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.CheckTimeout)
+		defer cancel()
+
+		return c.check(checkCtx, key, token, endpoint), nil
+	})
+
+	select {
+	case res := <-resCh:
+		entry := res.Val.(cacheEntry)
+		return entry.username, entry.err
+	case <-ctx.Done():
+		return "", status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (c *cachedClient) check(ctx context.Context, key, token, endpoint string) cacheEntry {
+	if !c.breaker.allow() {
+		return c.unavailable(token, nil)
+	}
+
+	username, err := c.client.Check(ctx, endpoint)
+	if isUnavailable(err) {
+		c.breaker.failure()
+		return c.unavailable(token, err)
+	}
+	c.breaker.success()
+
+	entry := cacheEntry{username: username, err: err}
+	if err != nil {
+		entry.expiresAt = time.Now().Add(c.cfg.NegativeTTL)
+		c.set(key, entry)
+
+		return entry
+	}
+
+	entry.expiresAt = time.Now().Add(c.cfg.TTL)
+
+	// разрешение не должно пережить сам токен
+	exp, errExp := expiresAtFromToken(token)
+	if errExp == nil && exp.Before(entry.expiresAt) {
+		entry.expiresAt = exp
+	}
+	c.set(key, entry)
+
+	// подлинный токен запоминается до его истечения для режима fail-open
+	verified := entry
+	if errExp == nil {
+		verified.expiresAt = exp
+	}
+	c.setToken(token, verified)
+
+	return entry
+}
+
+// unavailable возвращает результат проверки, когда сервис auth недоступен
+func (c *cachedClient) unavailable(token string, err error) cacheEntry {
+	if !c.cfg.FailOpen {
+		logger.Warn("auth service is unavailable, denying request", zap.Error(err))
+		return cacheEntry{err: ErrUnavailable}
+	}
+
+	// подпись токена без сервиса auth не проверить, поэтому claims незнакомого
+	// токена могут быть подделаны. Пропускаются только токены, которые сервис
+	// auth уже признал подлинными
+	verified, ok := c.getToken(token)
+	if !ok {
+		logger.Warn("auth service is unavailable, denying request with unverified token", zap.Error(err))
+		return cacheEntry{err: ErrUnavailable}
+	}
+
+	logger.Warn("auth service is unavailable, allowing request", zap.String("username", verified.username),
+		zap.Error(err))
+
+	return cacheEntry{username: verified.username}
+}
+
+func (c *cachedClient) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *cachedClient) set(key string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evict()
+	c.entries[key] = entry
+}
+
+func (c *cachedClient) getToken(token string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.tokens[token]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *cachedClient) setToken(token string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evict()
+	c.tokens[token] = entry
+}
+
+// evict удаляет истекшие записи из кэшей раз в evictInterval или сразу, если
+// один из кэшей заполнен. Вызывается под c.mu
+func (c *cachedClient) evict() {
+	now := time.Now()
+	full := len(c.entries) >= maxCacheEntries || len(c.tokens) >= maxCacheEntries
+	if !full && now.Sub(c.evictedAt) < evictInterval {
+		return
+	}
+
+	evictExpired(c.entries, now)
+	evictExpired(c.tokens, now)
+	c.evictedAt = now
+}
+
+// evictExpired удаляет истекшие записи. Если их не хватило, чтобы освободить
+// место, удаляются произвольные: кэш не должен расти без ограничений
+func evictExpired(entries map[string]cacheEntry, now time.Time) {
+	for k, e := range entries {
+		if now.After(e.expiresAt) {
+			delete(entries, k)
+		}
+	}
+
+	for k := range entries {
+		if len(entries) < maxCacheEntries {
+			return
+		}
+		delete(entries, k)
+	}
+}
+
+// isUnavailable отличает недоступность сервиса auth от отказа в доступе
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
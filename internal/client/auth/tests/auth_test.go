@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/auth/pkg/access_v1"
+	"github.com/solumD/chat-server/internal/client/auth"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// accessClientFake заглушка клиента сервиса access, возвращающая заданную ошибку
+type accessClientFake struct {
+	err error
+}
+
+func (c *accessClientFake) Check(_ context.Context, _ *access_v1.CheckRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// token собирает jwt-токен с указанным payload (подпись не проверяется)
+func token(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		enc.EncodeToString([]byte(payload)) + "." +
+		enc.EncodeToString([]byte("signature"))
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		username = gofakeit.Username()
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+
+		accessErr = fmt.Errorf("access denied")
+	)
+
+	tests := []struct {
+		name      string
+		header    string
+		accessErr error
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:   "success username from token",
+			header: "Bearer " + token(fmt.Sprintf(`{"exp":1,"username":%q,"role":1}`, username)),
+			want:   username,
+		},
+		{
+			name:      "error access denied",
+			header:    "Bearer " + token(fmt.Sprintf(`{"username":%q}`, username)),
+			accessErr: accessErr,
+			wantErr:   true,
+		},
+		{
+			name:    "error no authorization header",
+			header:  "",
+			wantErr: true,
+		},
+		{
+			name:    "error malformed token",
+			header:  "Bearer not-a-token",
+			wantErr: true,
+		},
+		{
+			name:    "error token without username",
+			header:  "Bearer " + token(`{"role":1}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if len(tt.header) != 0 {
+				ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			client := auth.New(&accessClientFake{err: tt.accessErr})
+
+			got, err := client.Check(ctx, endpoint)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/auth"
+	"github.com/solumD/chat-server/internal/logger"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authClientFake заглушка клиента auth, считающая обращения к нему
+type authClientFake struct {
+	calls    atomic.Int64
+	username string
+
+	mu      sync.Mutex
+	err     error
+	release chan struct{}
+}
+
+func (c *authClientFake) Check(ctx context.Context, _ string) (string, error) {
+	c.calls.Add(1)
+
+	c.mu.Lock()
+	err, release := c.err, c.release
+	c.mu.Unlock()
+
+	// как и клиент gRPC, заглушка перестает ждать ответа по истечении контекста
+	if release != nil {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return "", status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return c.username, nil
+}
+
+func (c *authClientFake) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+}
+
+func tokenContext(payload string) context.Context {
+	return metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token(payload)))
+}
+
+func cacheConfig() auth.CacheConfig {
+	return auth.CacheConfig{
+		TTL:              time.Minute,
+		NegativeTTL:      time.Minute,
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+	}
+}
+
+func TestCachedCheck(t *testing.T) {
+	t.Parallel()
+	logger.MockInit()
+
+	var (
+		username = gofakeit.Username()
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+		payload  = fmt.Sprintf(`{"username":%q}`, username)
+	)
+
+	t.Run("allowed check is cached per endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &authClientFake{username: username}
+		client := auth.NewCachedClient(fake, cacheConfig())
+		ctx := tokenContext(payload)
+
+		for i := 0; i < 3; i++ {
+			got, err := client.Check(ctx, endpoint)
+			require.NoError(t, err)
+			require.Equal(t, username, got)
+		}
+		require.Equal(t, int64(1), fake.calls.Load())
+
+		_, err := client.Check(ctx, "/chat_v1.ChatV1/GetUserChats")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), fake.calls.Load())
+
+		_, err = client.Check(tokenContext(fmt.Sprintf(`{"username":%q}`, gofakeit.Username())), endpoint)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), fake.calls.Load())
+	})
+
+	t.Run("denied check is cached", func(t *testing.T) {
+		t.Parallel()
+
+		deniedErr := status.Error(codes.PermissionDenied, "access denied")
+		fake := &authClientFake{err: deniedErr}
+		client := auth.NewCachedClient(fake, cacheConfig())
+		ctx := tokenContext(payload)
+
+		for i := 0; i < 3; i++ {
+			_, err := client.Check(ctx, endpoint)
+			require.ErrorIs(t, err, deniedErr)
+		}
+		require.Equal(t, int64(1), fake.calls.Load())
+	})
+
+	t.Run("expired entry is checked again", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := cacheConfig()
+		cfg.TTL = 10 * time.Millisecond
+		fake := &authClientFake{username: username}
+		client := auth.NewCachedClient(fake, cfg)
+		ctx := tokenContext(payload)
+
+		_, err := client.Check(ctx, endpoint)
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+
+		_, err = client.Check(ctx, endpoint)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), fake.calls.Load())
+	})
+
+	t.Run("entry does not outlive token", func(t *testing.T) {
+		t.Parallel()
+
+		exp := time.Now().Add(-time.Second).Unix()
+		fake := &authClientFake{username: username}
+		client := auth.NewCachedClient(fake, cacheConfig())
+		ctx := tokenContext(fmt.Sprintf(`{"exp":%d,"username":%q}`, exp, username))
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Check(ctx, endpoint)
+			require.NoError(t, err)
+		}
+		require.Equal(t, int64(2), fake.calls.Load())
+	})
+
+	t.Run("concurrent identical checks are deduplicated", func(t *testing.T) {
+		t.Parallel()
+
+		const callers = 10
+
+		fake := &authClientFake{username: username, release: make(chan struct{})}
+		client := auth.NewCachedClient(fake, cacheConfig())
+		ctx := tokenContext(payload)
+
+		var wg sync.WaitGroup
+		results := make(chan string, callers)
+		errs := make(chan error, callers)
+		for i := 0; i < callers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				got, err := client.Check(ctx, endpoint)
+				results <- got
+				errs <- err
+			}()
+		}
+
+		require.Eventually(t, func() bool {
+			return fake.calls.Load() == 1
+		}, time.Second, time.Millisecond)
+		// даем остальным запросам присоединиться к уже идущей проверке
+		time.Sleep(20 * time.Millisecond)
+		close(fake.release)
+		wg.Wait()
+		close(results)
+		close(errs)
+
+		for got := range results {
+			require.Equal(t, username, got)
+		}
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		require.Equal(t, int64(1), fake.calls.Load())
+	})
+
+	t.Run("caller stops waiting when its context is done", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &authClientFake{username: username, release: make(chan struct{})}
+		client := auth.NewCachedClient(fake, cacheConfig())
+
+		ctx, cancel := context.WithCancel(tokenContext(payload))
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := client.Check(ctx, endpoint)
+			errCh <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			return fake.calls.Load() == 1
+		}, time.Second, time.Millisecond)
+		cancel()
+		require.Equal(t, codes.Canceled, status.Code(<-errCh))
+
+		// начатая проверка не прерывается и попадает в кэш
+		close(fake.release)
+		require.Eventually(t, func() bool {
+			got, err := client.Check(tokenContext(payload), endpoint)
+			return err == nil && got == username
+		}, time.Second, time.Millisecond)
+		require.Equal(t, int64(1), fake.calls.Load())
+	})
+}
+
+func TestCachedCheckBreaker(t *testing.T) {
+	t.Parallel()
+	logger.MockInit()
+
+	var (
+		username = gofakeit.Username()
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+		payload  = fmt.Sprintf(`{"username":%q}`, username)
+
+		unavailableErr = status.Error(codes.Unavailable, "connection refused")
+	)
+
+	t.Run("fail closed", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &authClientFake{err: unavailableErr}
+		client := auth.NewCachedClient(fake, cacheConfig())
+		ctx := tokenContext(payload)
+
+		for i := 0; i < 5; i++ {
+			_, err := client.Check(ctx, endpoint)
+			require.ErrorIs(t, err, auth.ErrUnavailable)
+		}
+		// после двух ошибок подряд circuit breaker размыкается
+		require.Equal(t, int64(2), fake.calls.Load())
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := cacheConfig()
+		cfg.FailOpen = true
+		fake := &authClientFake{username: username}
+		client := auth.NewCachedClient(fake, cfg)
+
+		_, err := client.Check(tokenContext(payload), endpoint)
+		require.NoError(t, err)
+
+		fake.setErr(unavailableErr)
+
+		// токен, уже проверенный сервисом auth, пропускается и на других эндпоинтах
+		for _, e := range []string{"/chat_v1.ChatV1/GetUserChats", "/chat_v1.ChatV1/CreateChat"} {
+			got, err := client.Check(tokenContext(payload), e)
+			require.NoError(t, err)
+			require.Equal(t, username, got)
+		}
+
+		// claims незнакомого токена могут быть подделаны
+		forged := fmt.Sprintf(`{"username":%q}`, gofakeit.Username())
+		_, err = client.Check(tokenContext(forged), endpoint)
+		require.ErrorIs(t, err, auth.ErrUnavailable)
+		require.Equal(t, int64(3), fake.calls.Load())
+	})
+
+	t.Run("fail open does not outlive token", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := cacheConfig()
+		cfg.FailOpen = true
+		cfg.TTL = 10 * time.Millisecond
+		fake := &authClientFake{username: username}
+		client := auth.NewCachedClient(fake, cfg)
+		ctx := tokenContext(fmt.Sprintf(`{"exp":%d,"username":%q}`, time.Now().Add(-time.Second).Unix(), username))
+
+		_, err := client.Check(ctx, endpoint)
+		require.NoError(t, err)
+
+		fake.setErr(unavailableErr)
+
+		_, err = client.Check(ctx, endpoint)
+		require.ErrorIs(t, err, auth.ErrUnavailable)
+	})
+
+	t.Run("hung auth service times out and opens breaker", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := cacheConfig()
+		cfg.CheckTimeout = 10 * time.Millisecond
+		fake := &authClientFake{username: username, release: make(chan struct{})}
+		client := auth.NewCachedClient(fake, cfg)
+		ctx := tokenContext(payload)
+
+		for i := 0; i < 3; i++ {
+			_, err := client.Check(ctx, endpoint)
+			require.ErrorIs(t, err, auth.ErrUnavailable)
+		}
+		// после двух таймаутов подряд circuit breaker размыкается
+		require.Equal(t, int64(2), fake.calls.Load())
+	})
+
+	t.Run("half open probe closes breaker", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := cacheConfig()
+		cfg.OpenTimeout = 10 * time.Millisecond
+		fake := &authClientFake{username: username, err: unavailableErr}
+		client := auth.NewCachedClient(fake, cfg)
+		ctx := tokenContext(payload)
+
+		for i := 0; i < 3; i++ {
+			_, err := client.Check(ctx, endpoint)
+			require.ErrorIs(t, err, auth.ErrUnavailable)
+		}
+		require.Equal(t, int64(2), fake.calls.Load())
+
+		fake.setErr(nil)
+		time.Sleep(20 * time.Millisecond)
+
+		got, err := client.Check(ctx, endpoint)
+		require.NoError(t, err)
+		require.Equal(t, username, got)
+		require.Equal(t, int64(3), fake.calls.Load())
+	})
+
+	t.Run("denial does not open breaker", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &authClientFake{err: status.Error(codes.PermissionDenied, "access denied")}
+		client := auth.NewCachedClient(fake, cacheConfig())
+
+		for i := 0; i < 3; i++ {
+			// разные токены, чтобы не попадать в кэш
+			ctx := tokenContext(fmt.Sprintf(`{"username":%q}`, gofakeit.Username()))
+			_, err := client.Check(ctx, endpoint)
+			require.Error(t, err)
+			require.NotErrorIs(t, err, auth.ErrUnavailable)
+		}
+		require.Equal(t, int64(3), fake.calls.Load())
+	})
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authHeader = "authorization"
+	authPrefix = "Bearer "
+)
+
+// tokenClaims claims access-токена сервиса auth, которые нужны чату
+type tokenClaims struct {
+	Username  string `json:"username"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// accessToken достает access-токен из метаданных исходящего запроса
+func accessToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("metadata is not provided")
+	}
+
+	header := md.Get(authHeader)
+	if len(header) == 0 || !strings.HasPrefix(header[0], authPrefix) {
+		return "", fmt.Errorf("authorization header is not provided")
+	}
+
+	return strings.TrimPrefix(header[0], authPrefix), nil
+}
+
+// usernameFromToken достает имя пользователя из payload jwt-токена. Подпись
+// токена не проверяется: это делает сервис auth при проверке доступа
+func usernameFromToken(token string) (string, error) {
+	claims, err := parseClaims(token)
+	if err != nil {
+		return "", err
+	}
+
+	if len(claims.Username) == 0 {
+		return "", fmt.Errorf("access token has no username")
+	}
+
+	return claims.Username, nil
+}
+
+// expiresAtFromToken возвращает время истечения jwt-токена
+func expiresAtFromToken(token string) (time.Time, error) {
+	claims, err := parseClaims(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if claims.ExpiresAt == 0 {
+		return time.Time{}, fmt.Errorf("access token has no expiration")
+	}
+
+	return time.Unix(claims.ExpiresAt, 0), nil
+}
+
+func parseClaims(token string) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid access token format")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token payload: %v", err)
+	}
+
+	claims := &tokenClaims{}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("invalid access token claims: %v", err)
+	}
+
+	return claims, nil
+}
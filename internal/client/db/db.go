@@ -57,10 +57,18 @@ type Pinger interface {
 	Ping(ctx context.Context) error
 }
 
+// Listener интерфейс для получения уведомлений postgres (LISTEN/NOTIFY)
+type Listener interface {
+	// Listen подписывается на канал и передает payload каждого уведомления в handler.
+	// ready вызывается после успешной подписки. Блокируется до отмены ctx или обрыва соединения
+	Listen(ctx context.Context, channel string, ready func(), handler func(payload string)) error
+}
+
 // DB интерфейс для работы с БД
 type DB interface {
 	SQLExecer
 	Transactor
 	Pinger
+	Listener
 	Close()
 }
@@ -102,6 +102,38 @@ func (p *pg) Ping(ctx context.Context) error {
 	return p.dbc.Ping(ctx)
 }
 
+// Listen подписывается на канал уведомлений на отдельном соединении из пула
+func (p *pg) Listen(ctx context.Context, channel string, ready func(), handler func(payload string)) error {
+	conn, err := p.dbc.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// соединение возвращается в пул, поэтому снимаем с него подписку
+		_, errUnlisten := conn.Exec(context.Background(), "UNLISTEN *")
+		if errUnlisten != nil {
+			logger.Warn("failed to unlisten channel", zap.String("channel", channel), zap.Error(errUnlisten))
+		}
+	}()
+
+	ready()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		handler(notification.Payload)
+	}
+}
+
 // Close обертка над методом
 func (p *pg) Close() {
 	p.dbc.Close()
@@ -2,20 +2,27 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"os"
+	"strconv"
 )
 
 const (
 	authGrpcHostEnvName = "AUTH_GRPC_HOST"
 	authGrpcPortEnvName = "AUTH_GRPC_PORT"
 	authCertPathEnvName = "CERT_PATH"
+
+	authEnabledEnvName             = "AUTH_ENABLED"
+	authTrustClientIdentityEnvName = "AUTH_TRUST_CLIENT_IDENTITY"
 )
 
 type authConfig struct {
-	host     string
-	port     string
-	certPath string
+	host                string
+	port                string
+	certPath            string
+	enabled             bool
+	trustClientIdentity bool
 }
 
 // NewAuthConfig returns new auth client config
@@ -35,10 +42,37 @@ func NewAuthConfig() (AuthConfig, error) {
 		return nil, errors.New("cert path not found")
 	}
 
+	enabledStr := os.Getenv(authEnabledEnvName)
+	if len(enabledStr) == 0 {
+		return nil, errors.New("auth enabled flag not found")
+	}
+
+	enabled, err := strconv.ParseBool(enabledStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth enabled flag: %s", enabledStr)
+	}
+
+	trustStr := os.Getenv(authTrustClientIdentityEnvName)
+	if len(trustStr) == 0 {
+		return nil, errors.New("auth trust client identity flag not found")
+	}
+
+	trust, err := strconv.ParseBool(trustStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth trust client identity flag: %s", trustStr)
+	}
+
+	// без аутентификации проверять имена пользователей не с чем
+	if !enabled && !trust {
+		return nil, errors.New("client identity can't be checked with auth disabled")
+	}
+
 	return &authConfig{
-		host:     host,
-		port:     port,
-		certPath: certPath,
+		host:                host,
+		port:                port,
+		certPath:            certPath,
+		enabled:             enabled,
+		trustClientIdentity: trust,
 	}, nil
 }
 
@@ -50,3 +84,14 @@ func (cfg *authConfig) Address() string {
 func (cfg *authConfig) CertPath() string {
 	return cfg.certPath
 }
+
+// Enabled returns true if unary and stream requests are checked by auth service
+func (cfg *authConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+// TrustClientIdentity returns true if usernames sent by clients are used as is,
+// without checking them against the authenticated user
+func (cfg *authConfig) TrustClientIdentity() bool {
+	return cfg.trustClientIdentity
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	authCacheTTLEnvName                = "AUTH_CACHE_TTL"
+	authCacheNegativeTTLEnvName        = "AUTH_CACHE_NEGATIVE_TTL"
+	authBreakerFailureThresholdEnvName = "AUTH_BREAKER_FAILURE_THRESHOLD"
+	authBreakerOpenTimeoutEnvName      = "AUTH_BREAKER_OPEN_TIMEOUT"
+	authBreakerFailOpenEnvName         = "AUTH_BREAKER_FAIL_OPEN"
+	authCheckTimeoutEnvName            = "AUTH_CHECK_TIMEOUT"
+)
+
+type authCacheConfig struct {
+	ttl              time.Duration
+	negativeTTL      time.Duration
+	failureThreshold int
+	openTimeout      time.Duration
+	failOpen         bool
+	checkTimeout     time.Duration
+}
+
+// NewAuthCacheConfig returns new config of auth access checks cache and circuit breaker
+func NewAuthCacheConfig() (AuthCacheConfig, error) {
+	ttl, err := getDuration(authCacheTTLEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	negativeTTL, err := getDuration(authCacheNegativeTTLEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholdStr := os.Getenv(authBreakerFailureThresholdEnvName)
+	if len(thresholdStr) == 0 {
+		return nil, errors.New("auth breaker failure threshold not found")
+	}
+
+	threshold, err := strconv.Atoi(thresholdStr)
+	if err != nil || threshold <= 0 {
+		return nil, fmt.Errorf("invalid auth breaker failure threshold: %s", thresholdStr)
+	}
+
+	openTimeout, err := getDuration(authBreakerOpenTimeoutEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	failOpenStr := os.Getenv(authBreakerFailOpenEnvName)
+	if len(failOpenStr) == 0 {
+		return nil, errors.New("auth breaker fail open flag not found")
+	}
+
+	failOpen, err := strconv.ParseBool(failOpenStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth breaker fail open flag: %s", failOpenStr)
+	}
+
+	checkTimeout, err := getDuration(authCheckTimeoutEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authCacheConfig{
+		ttl:              ttl,
+		negativeTTL:      negativeTTL,
+		failureThreshold: threshold,
+		openTimeout:      openTimeout,
+		failOpen:         failOpen,
+		checkTimeout:     checkTimeout,
+	}, nil
+}
+
+// TTL returns lifetime of a cached allowed access check
+func (cfg *authCacheConfig) TTL() time.Duration {
+	return cfg.ttl
+}
+
+// NegativeTTL returns lifetime of a cached denied access check
+func (cfg *authCacheConfig) NegativeTTL() time.Duration {
+	return cfg.negativeTTL
+}
+
+// FailureThreshold returns number of consecutive auth service failures that open the circuit breaker
+func (cfg *authCacheConfig) FailureThreshold() int {
+	return cfg.failureThreshold
+}
+
+// OpenTimeout returns how long the circuit breaker stays open
+func (cfg *authCacheConfig) OpenTimeout() time.Duration {
+	return cfg.openTimeout
+}
+
+// FailOpen returns true if requests with previously verified tokens are allowed while auth service is unavailable
+func (cfg *authCacheConfig) FailOpen() bool {
+	return cfg.failOpen
+}
+
+// CheckTimeout returns how long to wait for an auth service response
+func (cfg *authCacheConfig) CheckTimeout() time.Duration {
+	return cfg.checkTimeout
+}
+
+func getDuration(envName string) (time.Duration, error) {
+	valueStr := os.Getenv(envName)
+	if len(valueStr) == 0 {
+		return 0, fmt.Errorf("%s not found", envName)
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid %s: %s", envName, valueStr)
+	}
+
+	return value, nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/joho/godotenv"
 )
 
@@ -33,6 +35,55 @@ type SwaggerConfig interface {
 type AuthConfig interface {
 	Address() string
 	CertPath() string
+	Enabled() bool
+	TrustClientIdentity() bool
+}
+
+// AuthCacheConfig интерфейс конфига кэша проверок доступа и circuit breaker'а клиента auth
+type AuthCacheConfig interface {
+	TTL() time.Duration
+	NegativeTTL() time.Duration
+	FailureThreshold() int
+	OpenTimeout() time.Duration
+	FailOpen() bool
+	CheckTimeout() time.Duration
+}
+
+// HubConfig интерфейс конфига hub'а подписчиков чатов
+type HubConfig interface {
+	QueueSize() int
+	SlowConsumerPolicy() string
+}
+
+// PubSubConfig интерфейс конфига доставки сообщений между экземплярами сервера
+type PubSubConfig interface {
+	Driver() string
+	Channel() string
+}
+
+// OutboxConfig интерфейс конфига обработчика outbox
+type OutboxConfig interface {
+	PollInterval() time.Duration
+	BatchSize() uint64
+}
+
+// TypingConfig интерфейс конфига индикаторов набора текста
+type TypingConfig interface {
+	TTL() time.Duration
+	RateLimit() int
+	RateWindow() time.Duration
+}
+
+// PresenceConfig интерфейс конфига подключенных пользователей
+type PresenceConfig interface {
+	TTL() time.Duration
+	HeartbeatInterval() time.Duration
+}
+
+// DeliveryConfig интерфейс конфига сохранения статусов доставки сообщений
+type DeliveryConfig interface {
+	FlushInterval() time.Duration
+	BatchSize() int
 }
 
 // Load reads ,env file from path and loads
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	deliveryFlushIntervalEnvName = "DELIVERY_FLUSH_INTERVAL"
+	deliveryBatchSizeEnvName     = "DELIVERY_BATCH_SIZE"
+)
+
+type deliveryConfig struct {
+	flushInterval time.Duration
+	batchSize     int
+}
+
+// NewDeliveryConfig returns new delivery status recorder config
+func NewDeliveryConfig() (DeliveryConfig, error) {
+	flushInterval, err := getDuration(deliveryFlushIntervalEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSizeStr := os.Getenv(deliveryBatchSizeEnvName)
+	if len(batchSizeStr) == 0 {
+		return nil, errors.New("delivery batch size not found")
+	}
+
+	batchSize, err := strconv.Atoi(batchSizeStr)
+	if err != nil || batchSize <= 0 {
+		return nil, fmt.Errorf("invalid delivery batch size: %s", batchSizeStr)
+	}
+
+	return &deliveryConfig{
+		flushInterval: flushInterval,
+		batchSize:     batchSize,
+	}, nil
+}
+
+// FlushInterval returns interval between saves of recorded deliveries
+func (cfg *deliveryConfig) FlushInterval() time.Duration {
+	return cfg.flushInterval
+}
+
+// BatchSize returns max number of deliveries saved in one query
+func (cfg *deliveryConfig) BatchSize() int {
+	return cfg.batchSize
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	hubQueueSizeEnvName          = "HUB_QUEUE_SIZE"
+	hubSlowConsumerPolicyEnvName = "HUB_SLOW_CONSUMER_POLICY"
+)
+
+type hubConfig struct {
+	queueSize          int
+	slowConsumerPolicy string
+}
+
+// NewHubConfig returns new chat hub config
+func NewHubConfig() (HubConfig, error) {
+	queueSizeStr := os.Getenv(hubQueueSizeEnvName)
+	if len(queueSizeStr) == 0 {
+		return nil, errors.New("hub queue size not found")
+	}
+
+	queueSize, err := strconv.Atoi(queueSizeStr)
+	if err != nil || queueSize <= 0 {
+		return nil, fmt.Errorf("invalid hub queue size: %s", queueSizeStr)
+	}
+
+	policy := os.Getenv(hubSlowConsumerPolicyEnvName)
+	if len(policy) == 0 {
+		return nil, errors.New("hub slow consumer policy not found")
+	}
+
+	return &hubConfig{
+		queueSize:          queueSize,
+		slowConsumerPolicy: policy,
+	}, nil
+}
+
+// QueueSize returns size of a subscriber's outbound queue
+func (cfg *hubConfig) QueueSize() int {
+	return cfg.queueSize
+}
+
+// SlowConsumerPolicy returns name of a policy for subscribers with a full queue
+// (drop_oldest, disconnect or spill)
+func (cfg *hubConfig) SlowConsumerPolicy() string {
+	return cfg.slowConsumerPolicy
+}
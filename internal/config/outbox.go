@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	outboxPollIntervalEnvName = "OUTBOX_POLL_INTERVAL"
+	outboxBatchSizeEnvName    = "OUTBOX_BATCH_SIZE"
+)
+
+type outboxConfig struct {
+	pollInterval time.Duration
+	batchSize    uint64
+}
+
+// NewOutboxConfig returns new outbox relay config
+func NewOutboxConfig() (OutboxConfig, error) {
+	intervalStr := os.Getenv(outboxPollIntervalEnvName)
+	if len(intervalStr) == 0 {
+		return nil, errors.New("outbox poll interval not found")
+	}
+
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid outbox poll interval: %s", intervalStr)
+	}
+
+	batchSizeStr := os.Getenv(outboxBatchSizeEnvName)
+	if len(batchSizeStr) == 0 {
+		return nil, errors.New("outbox batch size not found")
+	}
+
+	batchSize, err := strconv.ParseUint(batchSizeStr, 10, 64)
+	if err != nil || batchSize == 0 {
+		return nil, fmt.Errorf("invalid outbox batch size: %s", batchSizeStr)
+	}
+
+	return &outboxConfig{
+		pollInterval: interval,
+		batchSize:    batchSize,
+	}, nil
+}
+
+// PollInterval returns interval between outbox polls
+func (cfg *outboxConfig) PollInterval() time.Duration {
+	return cfg.pollInterval
+}
+
+// BatchSize returns max number of events relayed in one transaction
+func (cfg *outboxConfig) BatchSize() uint64 {
+	return cfg.batchSize
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	presenceTTLEnvName               = "PRESENCE_TTL"
+	presenceHeartbeatIntervalEnvName = "PRESENCE_HEARTBEAT_INTERVAL"
+)
+
+type presenceConfig struct {
+	ttl               time.Duration
+	heartbeatInterval time.Duration
+}
+
+// NewPresenceConfig returns new presence config
+func NewPresenceConfig() (PresenceConfig, error) {
+	ttl, err := getDuration(presenceTTLEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatInterval, err := getDuration(presenceHeartbeatIntervalEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	// подключение не должно истекать между двумя объявлениями
+	if ttl <= heartbeatInterval {
+		return nil, fmt.Errorf("presence ttl %s must be greater than heartbeat interval %s", ttl, heartbeatInterval)
+	}
+
+	return &presenceConfig{
+		ttl:               ttl,
+		heartbeatInterval: heartbeatInterval,
+	}, nil
+}
+
+// TTL returns time after which a user's connection to a server instance expires without a heartbeat
+func (cfg *presenceConfig) TTL() time.Duration {
+	return cfg.ttl
+}
+
+// HeartbeatInterval returns how often a server instance announces its connected users
+func (cfg *presenceConfig) HeartbeatInterval() time.Duration {
+	return cfg.heartbeatInterval
+}
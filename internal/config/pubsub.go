@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	pubSubDriverEnvName  = "PUBSUB_DRIVER"
+	pubSubChannelEnvName = "PUBSUB_CHANNEL"
+
+	// PubSubDriverMemory доставка сообщений в пределах одного экземпляра сервера
+	PubSubDriverMemory = "memory"
+	// PubSubDriverPG доставка сообщений между экземплярами через postgres LISTEN/NOTIFY
+	PubSubDriverPG = "pg"
+)
+
+type pubSubConfig struct {
+	driver  string
+	channel string
+}
+
+// NewPubSubConfig returns new pub/sub config
+func NewPubSubConfig() (PubSubConfig, error) {
+	driver := os.Getenv(pubSubDriverEnvName)
+	if len(driver) == 0 {
+		return nil, errors.New("pubsub driver not found")
+	}
+
+	if driver != PubSubDriverMemory && driver != PubSubDriverPG {
+		return nil, fmt.Errorf("unknown pubsub driver: %s", driver)
+	}
+
+	channel := os.Getenv(pubSubChannelEnvName)
+	if driver == PubSubDriverPG && len(channel) == 0 {
+		return nil, errors.New("pubsub channel not found")
+	}
+
+	return &pubSubConfig{
+		driver:  driver,
+		channel: channel,
+	}, nil
+}
+
+// Driver returns pub/sub implementation name (memory or pg)
+func (cfg *pubSubConfig) Driver() string {
+	return cfg.driver
+}
+
+// Channel returns postgres notification channel name
+func (cfg *pubSubConfig) Channel() string {
+	return cfg.channel
+}
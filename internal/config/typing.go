@@ -0,0 +1,65 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	typingTTLEnvName        = "TYPING_TTL"
+	typingRateLimitEnvName  = "TYPING_RATE_LIMIT"
+	typingRateWindowEnvName = "TYPING_RATE_WINDOW"
+)
+
+type typingConfig struct {
+	ttl        time.Duration
+	rateLimit  int
+	rateWindow time.Duration
+}
+
+// NewTypingConfig returns new typing indicators config
+func NewTypingConfig() (TypingConfig, error) {
+	ttl, err := getDuration(typingTTLEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitStr := os.Getenv(typingRateLimitEnvName)
+	if len(rateLimitStr) == 0 {
+		return nil, errors.New("typing rate limit not found")
+	}
+
+	rateLimit, err := strconv.Atoi(rateLimitStr)
+	if err != nil || rateLimit <= 0 {
+		return nil, fmt.Errorf("invalid typing rate limit: %s", rateLimitStr)
+	}
+
+	rateWindow, err := getDuration(typingRateWindowEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typingConfig{
+		ttl:        ttl,
+		rateLimit:  rateLimit,
+		rateWindow: rateWindow,
+	}, nil
+}
+
+// TTL returns time after which a typing indicator expires without a refresh
+func (cfg *typingConfig) TTL() time.Duration {
+	return cfg.ttl
+}
+
+// RateLimit returns max number of typing updates from a user per rate window
+func (cfg *typingConfig) RateLimit() int {
+	return cfg.rateLimit
+}
+
+// RateWindow returns window of typing updates rate limiting
+func (cfg *typingConfig) RateWindow() time.Duration {
+	return cfg.rateWindow
+}
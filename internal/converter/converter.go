@@ -4,6 +4,7 @@ import (
 	"github.com/solumD/chat-server/internal/model"
 
 	desc "github.com/solumD/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ToChatFromDesc конвертирует модель для создания чата API слоя в
@@ -16,9 +17,15 @@ func ToChatFromDesc(chat *desc.CreateChatRequest) *model.Chat {
 	return &model.Chat{
 		Name:      chat.Name,
 		Usernames: chat.Usernames,
+		Creator:   chat.Username,
 	}
 }
 
+// ToRoleFromDesc конвертирует роль участника чата API слоя в роль сервисного слоя
+func ToRoleFromDesc(role desc.Role) model.Role {
+	return model.Role(role)
+}
+
 // ToMessageFromDesc конвертирует модель сообщения API слоя в
 // модель сервисного слоя
 func ToMessageFromDesc(message *desc.SendMessageRequest) *model.Message {
@@ -27,9 +34,99 @@ func ToMessageFromDesc(message *desc.SendMessageRequest) *model.Message {
 	}
 
 	return &model.Message{
-		ChatID: message.Id,
-		From:   message.From,
-		Text:   message.Text,
+		ChatID:           message.Id,
+		From:             message.From,
+		Text:             message.Text,
+		ReplyToMessageID: message.ReplyToMessageId,
+	}
+}
+
+// ToDescMessageFromService конвертирует модель сообщения сервисного слоя в
+// модель сообщения API слоя
+func ToDescMessageFromService(message *model.Message) *desc.Message {
+	if message == nil {
+		return nil
+	}
+
+	res := &desc.Message{
+		Id:               message.ID,
+		From:             message.From,
+		Text:             message.Text,
+		CreatedAt:        timestamppb.New(message.CreatedAt),
+		Deleted:          message.Deleted,
+		ReplyToMessageId: message.ReplyToMessageID,
+		ReplyCount:       message.ReplyCount,
+	}
+
+	if message.EditedAt.Valid {
+		res.EditedAt = timestamppb.New(message.EditedAt.Time)
+	}
+
+	for _, r := range message.Reactions {
+		res.Reactions = append(res.Reactions, &desc.Reaction{Emoji: r.Emoji, Count: r.Count})
+	}
+
+	return res
+}
+
+// ToDescChatEventFromService конвертирует событие чата сервисного слоя в
+// событие stream'а API слоя. Для неизвестного типа события возвращается nil
+func ToDescChatEventFromService(event *model.ChatEvent) *desc.ChatEvent {
+	if event == nil {
+		return nil
+	}
+
+	switch event.Type {
+	case model.ChatEventMessage:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_Message{Message: ToDescMessageFromService(event.Message)},
+		}
+	case model.ChatEventMessageEdited:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MessageEdited{MessageEdited: ToDescMessageFromService(event.Message)},
+		}
+	case model.ChatEventReactionsChanged:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_ReactionsChanged{ReactionsChanged: ToDescMessageFromService(event.Message)},
+		}
+	case model.ChatEventMessagePinned:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MessagePinned{MessagePinned: ToDescMessageFromService(event.Message)},
+		}
+	case model.ChatEventMessageUnpinned:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MessageUnpinned{MessageUnpinned: &desc.MessageUnpinned{Id: event.Message.ID}},
+		}
+	case model.ChatEventMessageDeleted:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MessageDeleted{MessageDeleted: &desc.MessageDeleted{Id: event.Message.ID}},
+		}
+	case model.ChatEventMemberAdded:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MemberAdded{MemberAdded: &desc.ChatMember{Username: event.Username}},
+		}
+	case model.ChatEventMemberRemoved:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_MemberRemoved{MemberRemoved: &desc.ChatMember{Username: event.Username}},
+		}
+	case model.ChatEventTyping:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_Typing{Typing: &desc.Typing{Username: event.Username, IsTyping: event.IsTyping}},
+		}
+	case model.ChatEventPresence:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_Presence{Presence: ToDescPresenceFromService(event.Presence)},
+		}
+	case model.ChatEventRead:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_Read{Read: &desc.ReadReceipt{Username: event.Username, UpToMessageId: event.ReadUpTo}},
+		}
+	case model.ChatEventResync:
+		return &desc.ChatEvent{
+			Event: &desc.ChatEvent_Resync{Resync: &desc.Resync{}},
+		}
+	default:
+		return nil
 	}
 }
 
@@ -46,9 +143,142 @@ func ToDescChatInfoFromService(chatsInfo []*model.Chat) []*desc.ChatInfo {
 		ci.Id = c.ID
 		ci.Name = c.Name
 		ci.Usernames = c.Usernames
+		ci.UnreadCount = c.UnreadCount
 
 		descChatsInfo = append(descChatsInfo, ci)
 	}
 
 	return descChatsInfo
 }
+
+// ToMessagesFilterFromDesc конвертирует запрос истории сообщений API слоя в
+// модель фильтра сервисного слоя
+func ToMessagesFilterFromDesc(req *desc.GetChatMessagesRequest) *model.MessagesFilter {
+	if req == nil {
+		return nil
+	}
+
+	return &model.MessagesFilter{
+		ChatID:   req.Id,
+		Username: req.Username,
+		BeforeID: req.BeforeId,
+		AfterID:  req.AfterId,
+		Limit:    uint64(req.Limit),
+	}
+}
+
+// ToThreadFilterFromDesc конвертирует запрос ответов на сообщение API слоя
+// в фильтр сообщений сервисного слоя
+func ToThreadFilterFromDesc(req *desc.GetThreadRequest) *model.MessagesFilter {
+	if req == nil {
+		return nil
+	}
+
+	return &model.MessagesFilter{
+		ChatID:           req.Id,
+		Username:         req.Username,
+		BeforeID:         req.BeforeId,
+		AfterID:          req.AfterId,
+		Limit:            uint64(req.Limit),
+		ReplyToMessageID: req.MessageId,
+	}
+}
+
+// ToDescChatMessagesFromService конвертирует страницу истории сообщений из
+// сервисного слоя в ответ API слоя
+func ToDescChatMessagesFromService(page *model.MessagesPage) *desc.GetChatMessagesResponse {
+	if page == nil {
+		return nil
+	}
+
+	res := &desc.GetChatMessagesResponse{
+		Messages: make([]*desc.Message, 0, len(page.Messages)),
+		HasMore:  page.HasMore,
+	}
+
+	for _, m := range page.Messages {
+		res.Messages = append(res.Messages, ToDescMessageFromService(m))
+	}
+
+	// курсоры для запроса следующей страницы
+	if len(page.Messages) > 0 {
+		res.OldestId = page.Messages[0].ID
+		res.NewestId = page.Messages[len(page.Messages)-1].ID
+	}
+
+	return res
+}
+
+// ToDescPresenceFromService конвертирует модель подключения пользователя
+// сервисного слоя в модель API слоя
+func ToDescPresenceFromService(presence *model.Presence) *desc.Presence {
+	if presence == nil {
+		return nil
+	}
+
+	res := &desc.Presence{
+		Username: presence.Username,
+		Online:   presence.Online,
+	}
+
+	if presence.LastSeenAt.Valid {
+		res.LastSeenAt = timestamppb.New(presence.LastSeenAt.Time)
+	}
+
+	return res
+}
+
+// ToDescGetPresenceFromService конвертирует список подключений пользователей
+// сервисного слоя в ответ API слоя
+func ToDescGetPresenceFromService(presences []*model.Presence) *desc.GetPresenceResponse {
+	res := &desc.GetPresenceResponse{
+		Presences: make([]*desc.Presence, 0, len(presences)),
+	}
+
+	for _, p := range presences {
+		res.Presences = append(res.Presences, ToDescPresenceFromService(p))
+	}
+
+	return res
+}
+
+// ToDescMessageStatusFromService конвертирует статусы сообщения у получателей
+// сервисного слоя в ответ API слоя
+func ToDescMessageStatusFromService(statuses []*model.MessageStatus) *desc.GetMessageStatusResponse {
+	res := &desc.GetMessageStatusResponse{
+		Recipients: make([]*desc.RecipientStatus, 0, len(statuses)),
+	}
+
+	for _, st := range statuses {
+		recipient := &desc.RecipientStatus{
+			Username:  st.Username,
+			Delivered: st.Delivered,
+			Read:      st.Read,
+		}
+
+		if st.DeliveredAt.Valid {
+			recipient.DeliveredAt = timestamppb.New(st.DeliveredAt.Time)
+		}
+		if st.ReadAt.Valid {
+			recipient.ReadAt = timestamppb.New(st.ReadAt.Time)
+		}
+
+		res.Recipients = append(res.Recipients, recipient)
+	}
+
+	return res
+}
+
+// ToDescPinnedMessagesFromService конвертирует закрепленные сообщения чата
+// сервисного слоя в ответ API слоя
+func ToDescPinnedMessagesFromService(messages []*model.Message) *desc.ListPinnedMessagesResponse {
+	res := &desc.ListPinnedMessagesResponse{
+		Messages: make([]*desc.Message, 0, len(messages)),
+	}
+
+	for _, m := range messages {
+		res.Messages = append(res.Messages, ToDescMessageFromService(m))
+	}
+
+	return res
+}
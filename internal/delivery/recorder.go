@@ -0,0 +1,212 @@
+package delivery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultFlushInterval как часто накопленные доставки сохраняются в БД по умолчанию
+	DefaultFlushInterval = time.Second
+	// DefaultBatchSize максимальное количество доставок в одном запросе по умолчанию
+	DefaultBatchSize = 500
+
+	// maxPendingBatches во сколько раз накопленных доставок может быть больше
+	// размера батча, пока БД недоступна. Остальные доставки отбрасываются
+	maxPendingBatches = 100
+	// finalFlushTimeout сколько ждать сохранения оставшихся доставок при остановке
+	finalFlushTimeout = 5 * time.Second
+)
+
+// key доставка сообщения пользователю. Сообщение, доставленное в несколько
+// сессий пользователя, сохраняется один раз
+type key struct {
+	messageID int64
+	username  string
+}
+
+// Recorder накапливает доставки сообщений в живые stream'ы получателей и
+// сохраняет их батчами, чтобы доставка сообщения не добавляла запрос в БД
+// на каждого получателя. Доставки, не сохраненные до падения экземпляра
+// сервера, теряются: статус доставки не влияет на саму доставку
+type Recorder struct {
+	chatRepository repository.ChatRepository
+	txManager      db.TxManager
+
+	interval  time.Duration
+	batchSize int
+
+	mu      sync.Mutex
+	pending map[key]time.Time
+	full    chan struct{}
+
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRecorder возвращает накопитель доставок, который раз в interval или при
+// накоплении batchSize доставок сохраняет их в БД
+func NewRecorder(chatRepository repository.ChatRepository, txManager db.TxManager,
+	interval time.Duration, batchSize int,
+) *Recorder {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	return &Recorder{
+		chatRepository: chatRepository,
+		txManager:      txManager,
+		interval:       interval,
+		batchSize:      batchSize,
+		pending:        make(map[key]time.Time),
+		full:           make(chan struct{}, 1),
+		done:           make(chan struct{}),
+	}
+}
+
+// Record запоминает, что сообщение доставлено пользователю. Не блокируется
+func (r *Recorder) Record(messageID int64, username string) {
+	k := key{messageID: messageID, username: username}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.pending[k]; ok {
+		return
+	}
+
+	if len(r.pending) >= maxPendingBatches*r.batchSize {
+		logger.Warn("too many pending deliveries, dropping delivery",
+			zap.Int64("messageID", messageID), zap.String("username", username))
+		return
+	}
+
+	r.pending[k] = time.Now().UTC()
+
+	if len(r.pending) >= r.batchSize {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start запускает сохранение доставок в отдельной горутине
+func (r *Recorder) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go r.run(ctx)
+}
+
+// Close останавливает сохранение и пытается сохранить оставшиеся доставки,
+// ожидая не дольше finalFlushTimeout
+func (r *Recorder) Close() error {
+	r.stopOnce.Do(func() {
+		if r.cancel == nil {
+			close(r.done)
+			return
+		}
+
+		r.cancel()
+	})
+	<-r.done
+
+	return nil
+}
+
+func (r *Recorder) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// экземпляр останавливается - сохраняем то, что успели накопить,
+			// но не дольше finalFlushTimeout, чтобы не зависнуть на недоступной БД
+			flushCtx, cancel := context.WithTimeout(context.Background(), finalFlushTimeout)
+			r.flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+		case <-r.full:
+		}
+
+		r.flush(ctx)
+	}
+}
+
+// flush сохраняет накопленные доставки батчами. Батч, который не удалось
+// сохранить, возвращается в очередь и сохраняется повторно
+func (r *Recorder) flush(ctx context.Context) {
+	for {
+		batch := r.takeBatch()
+		if len(batch) == 0 {
+			return
+		}
+
+		err := r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			return r.chatRepository.AddDeliveries(ctx, batch)
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("failed to save deliveries", zap.Int("count", len(batch)), zap.Error(err))
+			}
+			r.putBack(batch)
+			return
+		}
+
+		if len(batch) < r.batchSize {
+			return
+		}
+	}
+}
+
+// takeBatch забирает из очереди до batchSize доставок
+func (r *Recorder) takeBatch() []*model.Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	batch := make([]*model.Delivery, 0, min(len(r.pending), r.batchSize))
+	for k, deliveredAt := range r.pending {
+		if len(batch) == r.batchSize {
+			break
+		}
+
+		batch = append(batch, &model.Delivery{
+			MessageID:   k.messageID,
+			Username:    k.username,
+			DeliveredAt: deliveredAt,
+		})
+		delete(r.pending, k)
+	}
+
+	return batch
+}
+
+// putBack возвращает несохраненные доставки в очередь
+func (r *Recorder) putBack(batch []*model.Delivery) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range batch {
+		k := key{messageID: d.MessageID, username: d.Username}
+		if _, ok := r.pending[k]; !ok {
+			r.pending[k] = d.DeliveredAt
+		}
+	}
+}
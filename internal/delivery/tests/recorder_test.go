@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/delivery"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// saved запоминает доставки, сохраненные в репо
+type saved struct {
+	mu      sync.Mutex
+	batches [][]*model.Delivery
+}
+
+func (s *saved) add(batch []*model.Delivery) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batches = append(s.batches, batch)
+}
+
+func (s *saved) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0)
+	for _, batch := range s.batches {
+		for _, d := range batch {
+			keys = append(keys, fmt.Sprintf("%d:%s", d.MessageID, d.Username))
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (s *saved) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.batches)
+}
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := mocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+	return mock
+}
+
+func TestRecorderFlushOnBatchSize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc    = minimock.NewController(t)
+		alice = gofakeit.Username()
+		bob   = gofakeit.Username()
+		s     = &saved{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	repoMock := repoMocks.NewChatRepositoryMock(mc)
+	repoMock.AddDeliveriesMock.Set(func(_ context.Context, deliveries []*model.Delivery) error {
+		s.add(deliveries)
+		return nil
+	})
+
+	logger.MockInit()
+
+	// интервал заведомо больше времени теста: сохранение вызывает заполнение батча
+	r := delivery.NewRecorder(repoMock, txManagerMock(mc), time.Hour, 2)
+	r.Start()
+
+	// повторная доставка в другую сессию того же пользователя не учитывается
+	r.Record(1, alice)
+	r.Record(1, alice)
+	r.Record(1, bob)
+
+	require.Eventually(t, func() bool {
+		return s.count() == 1
+	}, time.Second, time.Millisecond)
+	require.ElementsMatch(t, []string{"1:" + alice, "1:" + bob}, s.keys())
+
+	require.NoError(t, r.Close())
+	require.Equal(t, 1, s.count())
+}
+
+func TestRecorderFlushOnClose(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc    = minimock.NewController(t)
+		alice = gofakeit.Username()
+		s     = &saved{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	repoMock := repoMocks.NewChatRepositoryMock(mc)
+	repoMock.AddDeliveriesMock.Set(func(_ context.Context, deliveries []*model.Delivery) error {
+		s.add(deliveries)
+		return nil
+	})
+
+	logger.MockInit()
+
+	r := delivery.NewRecorder(repoMock, txManagerMock(mc), time.Hour, 10)
+	r.Start()
+
+	r.Record(1, alice)
+	r.Record(2, alice)
+
+	require.NoError(t, r.Close())
+	require.Equal(t, []string{"1:" + alice, "2:" + alice}, s.keys())
+}
+
+func TestRecorderFinalFlushTimeout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc    = minimock.NewController(t)
+		alice = gofakeit.Username()
+
+		hasDeadline bool
+	)
+	defer t.Cleanup(mc.Finish)
+
+	repoMock := repoMocks.NewChatRepositoryMock(mc)
+	repoMock.AddDeliveriesMock.Set(func(ctx context.Context, _ []*model.Delivery) error {
+		_, hasDeadline = ctx.Deadline()
+		return nil
+	})
+
+	logger.MockInit()
+
+	r := delivery.NewRecorder(repoMock, txManagerMock(mc), time.Hour, 10)
+	r.Start()
+
+	r.Record(1, alice)
+
+	// при остановке оставшиеся доставки сохраняются с ограниченным временем,
+	// чтобы недоступная БД не блокировала остановку экземпляра
+	require.NoError(t, r.Close())
+	require.True(t, hasDeadline)
+}
+
+func TestRecorderRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc    = minimock.NewController(t)
+		alice = gofakeit.Username()
+		s     = &saved{}
+
+		mu       sync.Mutex
+		attempts int
+	)
+	defer t.Cleanup(mc.Finish)
+
+	repoMock := repoMocks.NewChatRepositoryMock(mc)
+	repoMock.AddDeliveriesMock.Set(func(_ context.Context, deliveries []*model.Delivery) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+		if attempts == 1 {
+			return fmt.Errorf("repo error")
+		}
+
+		s.add(deliveries)
+		return nil
+	})
+
+	logger.MockInit()
+
+	r := delivery.NewRecorder(repoMock, txManagerMock(mc), 10*time.Millisecond, 10)
+	r.Start()
+
+	r.Record(1, alice)
+
+	// батч, который не удалось сохранить, сохраняется при следующем сбросе
+	require.Eventually(t, func() bool {
+		return s.count() == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []string{"1:" + alice}, s.keys())
+
+	require.NoError(t, r.Close())
+}
@@ -0,0 +1,92 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind вид доменной ошибки
+type Kind int
+
+const (
+	// KindNotFound сущность не найдена
+	KindNotFound Kind = iota + 1
+	// KindPermissionDenied у пользователя нет прав на действие
+	KindPermissionDenied
+	// KindInvalidArgument некорректный аргумент запроса
+	KindInvalidArgument
+	// KindAlreadyExists сущность уже существует
+	KindAlreadyExists
+	// KindFailedPrecondition действие невозможно в текущем состоянии
+	KindFailedPrecondition
+	// KindResourceExhausted превышен лимит запросов
+	KindResourceExhausted
+)
+
+// Error доменная ошибка репо и сервисного слоев. Кроме текста хранит вид
+// ошибки и объект, к которому она относится: тип ресурса для KindNotFound и
+// KindAlreadyExists, поле для KindInvalidArgument, лимит для KindResourceExhausted,
+// причину для остальных
+type Error struct {
+	Kind    Kind
+	Subject string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NotFound возвращает ошибку о том, что ресурс не найден
+func NotFound(resource string, format string, args ...interface{}) *Error {
+	return newError(KindNotFound, resource, format, args...)
+}
+
+// PermissionDenied возвращает ошибку о том, что у пользователя нет прав на действие
+func PermissionDenied(reason string, format string, args ...interface{}) *Error {
+	return newError(KindPermissionDenied, reason, format, args...)
+}
+
+// InvalidArgument возвращает ошибку о некорректном значении поля запроса
+func InvalidArgument(field string, format string, args ...interface{}) *Error {
+	return newError(KindInvalidArgument, field, format, args...)
+}
+
+// AlreadyExists возвращает ошибку о том, что ресурс уже существует
+func AlreadyExists(resource string, format string, args ...interface{}) *Error {
+	return newError(KindAlreadyExists, resource, format, args...)
+}
+
+// FailedPrecondition возвращает ошибку о том, что действие невозможно в текущем состоянии
+func FailedPrecondition(reason string, format string, args ...interface{}) *Error {
+	return newError(KindFailedPrecondition, reason, format, args...)
+}
+
+// ResourceExhausted возвращает ошибку о превышении лимита запросов
+func ResourceExhausted(limit string, format string, args ...interface{}) *Error {
+	return newError(KindResourceExhausted, limit, format, args...)
+}
+
+// As ищет доменную ошибку в цепочке обернутых ошибок
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+
+	return nil, false
+}
+
+// Is проверяет, что в цепочке ошибок есть доменная ошибка указанного вида
+func Is(err error, kind Kind) bool {
+	e, ok := As(err)
+	return ok && e.Kind == kind
+}
+
+func newError(kind Kind, subject string, format string, args ...interface{}) *Error {
+	return &Error{
+		Kind:    kind,
+		Subject: subject,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
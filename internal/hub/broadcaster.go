@@ -0,0 +1,178 @@
+package hub
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// broadcaster раскладывает события одного чата по очередям его подписчиков.
+// События рассылаются одной горутиной, поэтому все подписчики получают их
+// в одном и том же порядке. Ни публикация, ни рассылка не блокируются на
+// медленных подписчиках - переполнение очереди решается политикой hub'а
+type broadcaster struct {
+	policy  Policy
+	metrics *metrics
+
+	mu   sync.RWMutex
+	subs map[int64]*Subscriber // подписчики по id сессии
+
+	inboxMu sync.Mutex
+	inbox   []*model.ChatEvent
+	notify  chan struct{}
+
+	quit     chan struct{}
+	stopOnce sync.Once
+}
+
+func newBroadcaster(policy Policy, m *metrics) *broadcaster {
+	b := &broadcaster{
+		policy:  policy,
+		metrics: m,
+		subs:    make(map[int64]*Subscriber),
+		notify:  make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+	}
+
+	go b.run()
+
+	return b
+}
+
+func (b *broadcaster) run() {
+	for {
+		select {
+		case <-b.notify:
+			for _, event := range b.takeInbox() {
+				b.deliver(event)
+			}
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// takeInbox забирает все накопившиеся входящие события
+func (b *broadcaster) takeInbox() []*model.ChatEvent {
+	b.inboxMu.Lock()
+	defer b.inboxMu.Unlock()
+
+	events := b.inbox
+	b.inbox = nil
+
+	return events
+}
+
+// deliver кладет событие в очередь каждого подписчика
+func (b *broadcaster) deliver(event *model.ChatEvent) {
+	b.mu.RLock()
+	subs := make([]*Subscriber, 0, len(b.subs))
+	for _, sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		if sub.offer(event, b.policy, b.metrics) {
+			continue
+		}
+
+		// подписчик не успевает читать сообщения - отключаем его
+		b.remove(sub)
+		sub.close(ErrSlowConsumer)
+	}
+}
+
+func (b *broadcaster) publish(event *model.ChatEvent) {
+	b.inboxMu.Lock()
+	b.inbox = append(b.inbox, event)
+	b.inboxMu.Unlock()
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (b *broadcaster) add(sub *Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subs[sub.sessionID] = sub
+}
+
+func (b *broadcaster) remove(sub *Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs[sub.sessionID] == sub {
+		delete(b.subs, sub.sessionID)
+	}
+}
+
+// removeUser удаляет все сессии пользователя и возвращает их
+func (b *broadcaster) removeUser(username string) []*Subscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	removed := []*Subscriber{}
+	for id, sub := range b.subs {
+		if sub.username == username {
+			removed = append(removed, sub)
+			delete(b.subs, id)
+		}
+	}
+
+	return removed
+}
+
+func (b *broadcaster) empty() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subs) == 0
+}
+
+func (b *broadcaster) has(username string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subs {
+		if sub.username == username {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *broadcaster) sessions(username string) []int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	sessionIDs := []int64{}
+	for id, sub := range b.subs {
+		if sub.username == username {
+			sessionIDs = append(sessionIDs, id)
+		}
+	}
+	sort.Slice(sessionIDs, func(i, j int) bool { return sessionIDs[i] < sessionIDs[j] })
+
+	return sessionIDs
+}
+
+func (b *broadcaster) resync() {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subs {
+		sub.markLagged()
+	}
+}
+
+func (b *broadcaster) stop() {
+	b.stopOnce.Do(func() {
+		close(b.quit)
+	})
+}
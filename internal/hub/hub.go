@@ -0,0 +1,210 @@
+package hub
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// DefaultQueueSize размер очереди исходящих событий подписчика по умолчанию
+const DefaultQueueSize = 100
+
+// Hub рассылает события подписчикам чатов. На каждый чат, у которого
+// есть подписчики, запускается отдельный broadcaster
+type Hub struct {
+	mu        sync.Mutex
+	chats     map[int64]*broadcaster
+	users     map[string]int // количество сессий пользователя во всех чатах
+	queueSize int
+	policy    Policy
+	metrics   *metrics
+
+	lastSessionID atomic.Int64
+}
+
+// New возвращает новый hub. queueSize - размер очереди исходящих событий
+// каждого подписчика, policy - поведение при ее переполнении
+func New(queueSize int, policy Policy) *Hub {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	return &Hub{
+		chats:     make(map[int64]*broadcaster),
+		users:     make(map[string]int),
+		queueSize: queueSize,
+		policy:    policy,
+		metrics:   &metrics{},
+	}
+}
+
+// Policy возвращает политику обработки медленных подписчиков
+func (h *Hub) Policy() Policy {
+	return h.policy
+}
+
+// Metrics возвращает текущие значения счетчиков медленных подписчиков
+func (h *Hub) Metrics() Metrics {
+	return h.metrics.snapshot()
+}
+
+// Subscribe подписывает пользователя на события чата. Каждый вызов создает
+// новую сессию, поэтому пользователь может подключиться к чату с нескольких устройств
+func (h *Hub) Subscribe(chatID int64, username string) *Subscriber {
+	sub := newSubscriber(h.lastSessionID.Add(1), chatID, username, h.queueSize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	b, ok := h.chats[chatID]
+	if !ok {
+		b = newBroadcaster(h.policy, h.metrics)
+		h.chats[chatID] = b
+	}
+	b.add(sub)
+	h.users[username]++
+
+	return sub
+}
+
+// Unsubscribe завершает сессию подписчика, не затрагивая другие сессии того же
+// пользователя. Когда у чата не остается подписчиков, его broadcaster останавливается
+func (h *Hub) Unsubscribe(sub *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub.close(nil)
+
+	// сессия учитывается до Unsubscribe, даже если ее уже отключили
+	if !sub.unsubscribed {
+		sub.unsubscribed = true
+		h.users[sub.username]--
+		if h.users[sub.username] <= 0 {
+			delete(h.users, sub.username)
+		}
+	}
+
+	b, ok := h.chats[sub.chatID]
+	if !ok {
+		return
+	}
+
+	b.remove(sub)
+	if b.empty() {
+		delete(h.chats, sub.chatID)
+		b.stop()
+	}
+}
+
+// Publish передает событие broadcaster'у чата без блокировки. Если у чата
+// нет подписчиков, событие отбрасывается
+func (h *Hub) Publish(chatID int64, event *model.ChatEvent) {
+	h.mu.Lock()
+	b, ok := h.chats[chatID]
+	h.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	b.publish(event)
+}
+
+// IsSubscribed проверяет, подписан ли пользователь на события чата
+func (h *Hub) IsSubscribed(chatID int64, username string) bool {
+	h.mu.Lock()
+	b, ok := h.chats[chatID]
+	h.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	return b.has(username)
+}
+
+// HasSubscribers проверяет, есть ли у чата подписчики
+func (h *Hub) HasSubscribers(chatID int64) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, ok := h.chats[chatID]
+	return ok
+}
+
+// IsOnline проверяет, есть ли у пользователя сессии хотя бы в одном чате
+func (h *Hub) IsOnline(username string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.users[username] > 0
+}
+
+// OnlineUsers возвращает отсортированный список пользователей, у которых
+// есть сессии хотя бы в одном чате
+func (h *Hub) OnlineUsers() []string {
+	h.mu.Lock()
+	usernames := make([]string, 0, len(h.users))
+	for username := range h.users {
+		usernames = append(usernames, username)
+	}
+	h.mu.Unlock()
+
+	sort.Strings(usernames)
+
+	return usernames
+}
+
+// Resync сигнализирует всем подписчикам через Lagged, что часть сообщений
+// могла быть не доставлена и их нужно догрузить из истории
+func (h *Hub) Resync() {
+	h.mu.Lock()
+	chats := make([]*broadcaster, 0, len(h.chats))
+	for _, b := range h.chats {
+		chats = append(chats, b)
+	}
+	h.mu.Unlock()
+
+	for _, b := range chats {
+		b.resync()
+	}
+}
+
+// Sessions возвращает id активных сессий пользователя в чате
+func (h *Hub) Sessions(chatID int64, username string) []int64 {
+	h.mu.Lock()
+	b, ok := h.chats[chatID]
+	h.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return b.sessions(username)
+}
+
+// Disconnect завершает все сессии пользователя в чате с указанной причиной
+// и возвращает их количество
+func (h *Hub) Disconnect(chatID int64, username string, reason error) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	b, ok := h.chats[chatID]
+	if !ok {
+		return 0
+	}
+
+	subs := b.removeUser(username)
+	for _, sub := range subs {
+		sub.close(reason)
+	}
+
+	if b.empty() {
+		delete(h.chats, chatID)
+		b.stop()
+	}
+
+	return len(subs)
+}
@@ -0,0 +1,24 @@
+package hub
+
+import "sync/atomic"
+
+// Metrics счетчики событий, связанных с медленными подписчиками
+type Metrics struct {
+	DroppedMessages         uint64 // сообщения, вытесненные из очередей или отброшенные
+	DisconnectedSubscribers uint64 // подписчики, отключенные из-за переполнения очереди
+	SpilledSubscribers      uint64 // переполнения, после которых подписчик догружает историю
+}
+
+type metrics struct {
+	dropped      atomic.Uint64
+	disconnected atomic.Uint64
+	spilled      atomic.Uint64
+}
+
+func (m *metrics) snapshot() Metrics {
+	return Metrics{
+		DroppedMessages:         m.dropped.Load(),
+		DisconnectedSubscribers: m.disconnected.Load(),
+		SpilledSubscribers:      m.spilled.Load(),
+	}
+}
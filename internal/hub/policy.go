@@ -0,0 +1,60 @@
+package hub
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Policy поведение hub'а, когда очередь подписчика переполнена
+type Policy int
+
+const (
+	// PolicyDropOldest вытесняет из очереди самое старое сообщение
+	PolicyDropOldest Policy = iota
+	// PolicyDisconnect отключает подписчика с ошибкой ErrSlowConsumer
+	PolicyDisconnect
+	// PolicySpill отбрасывает сообщение и сигнализирует подписчику, что
+	// пропущенное нужно догрузить из истории
+	PolicySpill
+)
+
+// названия политик в конфиге
+const (
+	policyDropOldestName = "drop_oldest"
+	policyDisconnectName = "disconnect"
+	policySpillName      = "spill"
+)
+
+// ErrSlowConsumer причина отключения подписчика, не успевающего читать сообщения
+var ErrSlowConsumer = errors.New("subscriber is too slow to receive messages")
+
+// ErrRemovedFromChat причина отключения подписчика, которого удалили из чата
+var ErrRemovedFromChat = errors.New("user was removed from chat")
+
+// ParsePolicy возвращает политику по ее названию из конфига
+func ParsePolicy(name string) (Policy, error) {
+	switch name {
+	case policyDropOldestName:
+		return PolicyDropOldest, nil
+	case policyDisconnectName:
+		return PolicyDisconnect, nil
+	case policySpillName:
+		return PolicySpill, nil
+	}
+
+	return 0, fmt.Errorf("unknown slow consumer policy %q", name)
+}
+
+// String возвращает название политики
+func (p Policy) String() string {
+	switch p {
+	case PolicyDropOldest:
+		return policyDropOldestName
+	case PolicyDisconnect:
+		return policyDisconnectName
+	case PolicySpill:
+		return policySpillName
+	}
+
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
@@ -0,0 +1,135 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// Subscriber подписка пользователя на события чата (одна сессия подключения).
+// У одного пользователя может быть несколько сессий в одном чате. События из
+// очереди подписки должен читать и отправлять в stream только один писатель
+type Subscriber struct {
+	sessionID int64
+	chatID    int64
+	username  string
+
+	queue  chan *model.ChatEvent
+	lagged chan struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+
+	// unsubscribed изменяется только под mu hub'а
+	unsubscribed bool
+}
+
+func newSubscriber(sessionID int64, chatID int64, username string, queueSize int) *Subscriber {
+	return &Subscriber{
+		sessionID: sessionID,
+		chatID:    chatID,
+		username:  username,
+		queue:     make(chan *model.ChatEvent, queueSize),
+		lagged:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+// SessionID возвращает уникальный id сессии подключения
+func (s *Subscriber) SessionID() int64 {
+	return s.sessionID
+}
+
+// ChatID возвращает id чата подписки
+func (s *Subscriber) ChatID() int64 {
+	return s.chatID
+}
+
+// Username возвращает имя подписанного пользователя
+func (s *Subscriber) Username() string {
+	return s.username
+}
+
+// Events возвращает очередь событий подписчика
+func (s *Subscriber) Events() <-chan *model.ChatEvent {
+	return s.queue
+}
+
+// Lagged возвращает канал, в который приходит сигнал, если часть сообщений нужно
+// догрузить из истории: при политике PolicySpill подписчику не хватило места
+// в очереди или hub'у был вызван Resync
+func (s *Subscriber) Lagged() <-chan struct{} {
+	return s.lagged
+}
+
+// Done возвращает канал, который закрывается после отписки
+func (s *Subscriber) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err возвращает причину отключения подписчика (nil при обычной отписке).
+// Значение имеет смысл после закрытия канала Done
+func (s *Subscriber) Err() error {
+	<-s.done
+	return s.err
+}
+
+// close завершает подписку с указанной причиной (только первый вызов имеет эффект)
+func (s *Subscriber) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// markLagged сигнализирует подписчику, что часть сообщений нужно догрузить
+// из истории. Возвращает false, если сигнал уже ожидает обработки
+func (s *Subscriber) markLagged() bool {
+	select {
+	case s.lagged <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// offer кладет событие в очередь подписчика, не блокируясь, и при
+// переполнении очереди применяет политику. Возвращает false, если подписчика
+// нужно отключить
+func (s *Subscriber) offer(event *model.ChatEvent, policy Policy, m *metrics) bool {
+	select {
+	case s.queue <- event:
+		return true
+	default:
+	}
+
+	switch policy {
+	case PolicyDisconnect:
+		m.disconnected.Add(1)
+		return false
+
+	case PolicySpill:
+		m.dropped.Add(1)
+		if s.markLagged() {
+			m.spilled.Add(1)
+		}
+		return true
+
+	default:
+		// вытесняем самые старые события, пока новое не поместится
+		for {
+			select {
+			case <-s.queue:
+				m.dropped.Add(1)
+			default:
+			}
+
+			select {
+			case s.queue <- event:
+				return true
+			default:
+			}
+		}
+	}
+}
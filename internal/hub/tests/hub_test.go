@@ -0,0 +1,457 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/model"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = 5 * time.Second
+
+// messageEvent возвращает событие о новом сообщении
+func messageEvent(id int64) *model.ChatEvent {
+	return &model.ChatEvent{
+		Type:    model.ChatEventMessage,
+		Message: &model.Message{ID: id, Text: gofakeit.Fruit()},
+	}
+}
+
+// receive читает из очереди подписчика n событий
+func receive(t *testing.T, sub *hub.Subscriber, n int) []*model.ChatEvent {
+	t.Helper()
+
+	msgs := make([]*model.ChatEvent, 0, n)
+	timeout := time.After(waitTimeout)
+	for len(msgs) < n {
+		select {
+		case msg := <-sub.Events():
+			msgs = append(msgs, msg)
+		case <-timeout:
+			t.Fatalf("received %d of %d messages", len(msgs), n)
+		}
+	}
+
+	return msgs
+}
+
+func TestHubDelivery(t *testing.T) {
+	t.Parallel()
+
+	// очередь вмещает весь трафик, поэтому ни одна политика не срабатывает
+	tests := []struct {
+		name        string
+		subscribers int
+		messages    int
+	}{
+		{
+			name:        "single subscriber",
+			subscribers: 1,
+			messages:    50,
+		},
+		{
+			name:        "many subscribers",
+			subscribers: 20,
+			messages:    200,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := hub.New(tt.messages, hub.PolicyDisconnect)
+			chatID := gofakeit.Int64()
+
+			subs := make([]*hub.Subscriber, 0, tt.subscribers)
+			for i := 0; i < tt.subscribers; i++ {
+				subs = append(subs, h.Subscribe(chatID, gofakeit.Username()))
+			}
+
+			want := make([]*model.ChatEvent, 0, tt.messages)
+			for i := 1; i <= tt.messages; i++ {
+				want = append(want, messageEvent(int64(i)))
+			}
+
+			go func() {
+				for _, msg := range want {
+					h.Publish(chatID, msg)
+				}
+			}()
+
+			wg := sync.WaitGroup{}
+			for _, sub := range subs {
+				wg.Add(1)
+				go func(sub *hub.Subscriber) {
+					defer wg.Done()
+					require.Equal(t, want, receive(t, sub, tt.messages))
+				}(sub)
+			}
+			wg.Wait()
+
+			for _, sub := range subs {
+				h.Unsubscribe(sub)
+			}
+		})
+	}
+}
+
+func TestHubConcurrentLoad(t *testing.T) {
+	t.Parallel()
+
+	const (
+		stableSubs    = 10
+		churningSubs  = 10
+		publishers    = 5
+		perPublisher  = 200
+		totalMessages = publishers * perPublisher
+	)
+
+	// очередь вмещает весь трафик, поэтому ни одна политика не срабатывает
+	h := hub.New(totalMessages, hub.PolicyDisconnect)
+	chatID := gofakeit.Int64()
+
+	stable := make([]*hub.Subscriber, 0, stableSubs)
+	for i := 0; i < stableSubs; i++ {
+		stable = append(stable, h.Subscribe(chatID, gofakeit.Username()))
+	}
+
+	stop := make(chan struct{})
+	churnWG := sync.WaitGroup{}
+
+	// подписчики, которые постоянно подключаются, читают часть сообщений и отключаются
+	for i := 0; i < churningSubs; i++ {
+		churnWG.Add(1)
+		go func() {
+			defer churnWG.Done()
+
+			username := gofakeit.Username()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				sub := h.Subscribe(chatID, username)
+				require.True(t, h.IsSubscribed(chatID, username))
+
+				select {
+				case <-sub.Events():
+				case <-time.After(time.Millisecond):
+				}
+
+				h.Unsubscribe(sub)
+				h.Unsubscribe(sub) // повторная отписка ничего не ломает
+
+				select {
+				case <-sub.Done():
+				default:
+					t.Error("subscriber is not done after unsubscribe")
+				}
+			}
+		}()
+	}
+
+	pubWG := sync.WaitGroup{}
+	for p := 0; p < publishers; p++ {
+		pubWG.Add(1)
+		go func(p int) {
+			defer pubWG.Done()
+
+			for i := 0; i < perPublisher; i++ {
+				h.Publish(chatID, messageEvent(int64(p*perPublisher+i)))
+			}
+		}(p)
+	}
+
+	recvWG := sync.WaitGroup{}
+	for _, sub := range stable {
+		recvWG.Add(1)
+		go func(sub *hub.Subscriber) {
+			defer recvWG.Done()
+
+			msgs := receive(t, sub, totalMessages)
+
+			// каждое сообщение получено ровно один раз, порядок сообщений
+			// одного отправителя сохраняется
+			seen := make(map[int64]struct{}, totalMessages)
+			last := make(map[int64]int64, publishers)
+			for _, msg := range msgs {
+				_, dup := seen[msg.Message.ID]
+				require.False(t, dup, "duplicate message %d", msg.Message.ID)
+				seen[msg.Message.ID] = struct{}{}
+
+				p := msg.Message.ID / perPublisher
+				if prev, ok := last[p]; ok {
+					require.Less(t, prev, msg.Message.ID)
+				}
+				last[p] = msg.Message.ID
+			}
+			require.Len(t, seen, totalMessages)
+		}(sub)
+	}
+
+	pubWG.Wait()
+	recvWG.Wait()
+	close(stop)
+	churnWG.Wait()
+
+	for _, sub := range stable {
+		h.Unsubscribe(sub)
+	}
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(hub.DefaultQueueSize, hub.PolicyDropOldest)
+	chatID := gofakeit.Int64()
+	username := gofakeit.Username()
+
+	require.False(t, h.IsSubscribed(chatID, username))
+
+	// второе подключение того же пользователя - отдельная сессия
+	first := h.Subscribe(chatID, username)
+	second := h.Subscribe(chatID, username)
+	require.NotEqual(t, first.SessionID(), second.SessionID())
+	require.True(t, h.IsSubscribed(chatID, username))
+	require.Equal(t, []int64{first.SessionID(), second.SessionID()}, h.Sessions(chatID, username))
+
+	msg := messageEvent(gofakeit.Int64())
+	h.Publish(chatID, msg)
+	require.Equal(t, []*model.ChatEvent{msg}, receive(t, first, 1))
+	require.Equal(t, []*model.ChatEvent{msg}, receive(t, second, 1))
+
+	// отключение одной сессии не затрагивает другую
+	h.Unsubscribe(first)
+	require.True(t, h.IsSubscribed(chatID, username))
+	require.Equal(t, []int64{second.SessionID()}, h.Sessions(chatID, username))
+
+	select {
+	case <-second.Done():
+		t.Fatal("second session is closed after first unsubscribed")
+	default:
+	}
+
+	msg = messageEvent(gofakeit.Int64())
+	h.Publish(chatID, msg)
+	require.Equal(t, []*model.ChatEvent{msg}, receive(t, second, 1))
+
+	h.Unsubscribe(second)
+	require.False(t, h.IsSubscribed(chatID, username))
+	require.Empty(t, h.Sessions(chatID, username))
+
+	// у чата нет подписчиков - сообщение отбрасывается без блокировки
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10*hub.DefaultQueueSize; i++ {
+			h.Publish(chatID, messageEvent(int64(i)))
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(waitTimeout):
+		t.Fatal("publish to chat without subscribers blocked")
+	}
+}
+
+func TestHubDisconnect(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(hub.DefaultQueueSize, hub.PolicyDropOldest)
+	chatID := gofakeit.Int64()
+	removed := gofakeit.Username()
+	other := gofakeit.Username()
+
+	phone := h.Subscribe(chatID, removed)
+	laptop := h.Subscribe(chatID, removed)
+	sub := h.Subscribe(chatID, other)
+
+	// отключаются все сессии пользователя, но не других подписчиков
+	require.Equal(t, 2, h.Disconnect(chatID, removed, hub.ErrRemovedFromChat))
+	require.ErrorIs(t, phone.Err(), hub.ErrRemovedFromChat)
+	require.ErrorIs(t, laptop.Err(), hub.ErrRemovedFromChat)
+	require.False(t, h.IsSubscribed(chatID, removed))
+	require.True(t, h.IsSubscribed(chatID, other))
+
+	// повторное отключение ничего не делает, а отписка закрытой сессии безопасна
+	require.Zero(t, h.Disconnect(chatID, removed, hub.ErrRemovedFromChat))
+	h.Unsubscribe(phone)
+
+	msg := messageEvent(gofakeit.Int64())
+	h.Publish(chatID, msg)
+	require.Equal(t, []*model.ChatEvent{msg}, receive(t, sub, 1))
+
+	// после отключения последнего подписчика broadcaster чата останавливается
+	require.Equal(t, 1, h.Disconnect(chatID, other, hub.ErrRemovedFromChat))
+	require.False(t, h.HasSubscribers(chatID))
+}
+
+func TestHubOnlineUsers(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(hub.DefaultQueueSize, hub.PolicyDropOldest)
+	firstChat, secondChat := gofakeit.Int64(), gofakeit.Int64()
+	alice, bob := "alice", "bob"
+
+	require.False(t, h.IsOnline(alice))
+	require.Empty(t, h.OnlineUsers())
+
+	// пользователь в сети, пока у него есть сессия хотя бы в одном чате
+	first := h.Subscribe(firstChat, alice)
+	second := h.Subscribe(secondChat, alice)
+	bobSub := h.Subscribe(firstChat, bob)
+	require.True(t, h.IsOnline(alice))
+	require.Equal(t, []string{alice, bob}, h.OnlineUsers())
+
+	h.Unsubscribe(first)
+	require.True(t, h.IsOnline(alice))
+
+	// отключенная сессия учитывается до отписки, повторная отписка ничего не меняет
+	require.Equal(t, 1, h.Disconnect(secondChat, alice, hub.ErrRemovedFromChat))
+	require.True(t, h.IsOnline(alice))
+	h.Unsubscribe(second)
+	h.Unsubscribe(second)
+	require.False(t, h.IsOnline(alice))
+	require.Equal(t, []string{bob}, h.OnlineUsers())
+
+	h.Unsubscribe(bobSub)
+	require.Empty(t, h.OnlineUsers())
+}
+
+func TestHubSlowConsumerPolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		queueSize = 4
+		messages  = 10
+	)
+
+	tests := []struct {
+		name        string
+		policy      hub.Policy
+		wantQueue   []int64
+		wantErr     error
+		wantLagged  bool
+		wantMetrics hub.Metrics
+	}{
+		{
+			name:      "drop oldest",
+			policy:    hub.PolicyDropOldest,
+			wantQueue: []int64{7, 8, 9, 10},
+			wantErr:   nil,
+			wantMetrics: hub.Metrics{
+				DroppedMessages: messages - queueSize,
+			},
+		},
+		{
+			name:      "disconnect",
+			policy:    hub.PolicyDisconnect,
+			wantQueue: []int64{1, 2, 3, 4},
+			wantErr:   hub.ErrSlowConsumer,
+			wantMetrics: hub.Metrics{
+				DisconnectedSubscribers: 1,
+			},
+		},
+		{
+			name:       "spill",
+			policy:     hub.PolicySpill,
+			wantQueue:  []int64{1, 2, 3, 4},
+			wantErr:    nil,
+			wantLagged: true,
+			wantMetrics: hub.Metrics{
+				DroppedMessages:    messages - queueSize,
+				SpilledSubscribers: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := hub.New(queueSize, tt.policy)
+			require.Equal(t, tt.policy, h.Policy())
+
+			chatID := gofakeit.Int64()
+			slow := h.Subscribe(chatID, gofakeit.Username())
+			fast := h.Subscribe(chatID, gofakeit.Username())
+
+			// публикация не блокируется, даже если один из подписчиков не читает
+			// сообщения, а быстрый подписчик получает все сообщения
+			for i := 1; i <= messages; i++ {
+				msg := messageEvent(int64(i))
+				h.Publish(chatID, msg)
+				require.Equal(t, []*model.ChatEvent{msg}, receive(t, fast, 1))
+			}
+
+			require.Eventually(t, func() bool {
+				return h.Metrics() == tt.wantMetrics
+			}, waitTimeout, time.Millisecond)
+
+			got := make([]int64, 0, queueSize)
+			for _, msg := range receive(t, slow, len(tt.wantQueue)) {
+				got = append(got, msg.Message.ID)
+			}
+			require.Equal(t, tt.wantQueue, got)
+
+			select {
+			case <-slow.Lagged():
+				require.True(t, tt.wantLagged)
+			default:
+				require.False(t, tt.wantLagged)
+			}
+
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, slow.Err())
+			}
+
+			h.Unsubscribe(slow)
+			h.Unsubscribe(fast)
+			require.Nil(t, fast.Err())
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    hub.Policy
+		wantErr bool
+	}{
+		{name: "drop oldest", value: "drop_oldest", want: hub.PolicyDropOldest},
+		{name: "disconnect", value: "disconnect", want: hub.PolicyDisconnect},
+		{name: "spill", value: "spill", want: hub.PolicySpill},
+		{name: "unknown", value: "block", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := hub.ParsePolicy(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, policy)
+			require.Equal(t, tt.value, policy.String())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package identity
+
+import "context"
+
+type key string
+
+const usernameKey key = "username"
+
+// WithUsername возвращает контекст с именем аутентифицированного пользователя
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey, username)
+}
+
+// UsernameFromContext возвращает имя аутентифицированного пользователя из контекста
+func UsernameFromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameKey).(string)
+	if !ok || len(username) == 0 {
+		return "", false
+	}
+
+	return username, true
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/solumD/chat-server/internal/client/auth"
+	"github.com/solumD/chat-server/internal/identity"
+
+	grpcMW "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -19,18 +22,47 @@ func NewAuthInterceptor(authClient auth.Client) *authInterceptor {
 	}
 }
 
-// Get возвращает интерцептор, который делает запрос к сервису auth
+// Get возвращает интерцептор, который делает запрос к сервису auth и
+// кладет имя аутентифицированного пользователя в контекст запроса
 func (i *authInterceptor) Get() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			ctx = metadata.NewOutgoingContext(ctx, md)
-		}
-
-		if err = i.authClient.Check(ctx, info.FullMethod); err != nil {
+		ctx, err = i.check(ctx, info.FullMethod)
+		if err != nil {
 			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
+
+// GetStream возвращает stream-интерцептор, который проверяет доступ при открытии
+// stream'а и кладет имя аутентифицированного пользователя в его контекст
+func (i *authInterceptor) GetStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.check(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpcMW.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// check проверяет доступ к методу в сервисе auth и возвращает контекст
+// с именем аутентифицированного пользователя
+func (i *authInterceptor) check(ctx context.Context, method string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	username, err := i.authClient.Check(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity.WithUsername(ctx, username), nil
+}
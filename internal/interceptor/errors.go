@@ -0,0 +1,110 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/solumD/chat-server/internal/errs"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain домен ошибок сервиса в errdetails.ErrorInfo
+const errorDomain = "chat-server"
+
+// fieldError ошибка валидации, которую генерирует protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// ErrorsInterceptor переводит доменные ошибки и ошибки валидации в gRPC статусы
+func ErrorsInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return res, nil
+}
+
+// ErrorsStreamInterceptor переводит ошибки stream'а в gRPC статусы
+func ErrorsStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(handler(srv, ss))
+}
+
+// toStatus возвращает gRPC статус с подробностями для доменной ошибки или ошибки
+// валидации. Остальные ошибки возвращаются без изменений
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := errs.As(err); ok {
+		return domainStatus(e)
+	}
+
+	var fe fieldError
+	if errors.As(err, &fe) {
+		return withDetails(status.New(codes.InvalidArgument, err.Error()), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fe.Field(), Description: fe.Reason()},
+			},
+		})
+	}
+
+	return err
+}
+
+func domainStatus(e *errs.Error) error {
+	switch e.Kind {
+	case errs.KindNotFound:
+		return withDetails(status.New(codes.NotFound, e.Message), &errdetails.ResourceInfo{
+			ResourceType: e.Subject,
+			Description:  e.Message,
+		})
+	case errs.KindAlreadyExists:
+		return withDetails(status.New(codes.AlreadyExists, e.Message), &errdetails.ResourceInfo{
+			ResourceType: e.Subject,
+			Description:  e.Message,
+		})
+	case errs.KindInvalidArgument:
+		return withDetails(status.New(codes.InvalidArgument, e.Message), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: e.Subject, Description: e.Message},
+			},
+		})
+	case errs.KindPermissionDenied:
+		return withDetails(status.New(codes.PermissionDenied, e.Message), &errdetails.ErrorInfo{
+			Reason: e.Subject,
+			Domain: errorDomain,
+		})
+	case errs.KindFailedPrecondition:
+		return withDetails(status.New(codes.FailedPrecondition, e.Message), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: e.Subject, Description: e.Message},
+			},
+		})
+	case errs.KindResourceExhausted:
+		return withDetails(status.New(codes.ResourceExhausted, e.Message), &errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{
+				{Subject: e.Subject, Description: e.Message},
+			},
+		})
+	default:
+		return status.Error(codes.Unknown, e.Message)
+	}
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
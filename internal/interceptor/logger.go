@@ -2,24 +2,95 @@ package interceptor
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/solumD/chat-server/internal/logger"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
-// LogInterceptor логирует содержание запроса
+// LogInterceptor логирует содержание запроса. Код ошибки логируется таким,
+// каким его вернет ErrorsInterceptor
 func LogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	now := time.Now()
 
 	res, err := handler(ctx, req)
 	if err != nil {
-		logger.Error(err.Error(), zap.String("method", info.FullMethod), zap.Any("req", req))
+		logger.Error(err.Error(),
+			zap.String("method", info.FullMethod),
+			zap.Any("req", req),
+			zap.String("status", status.Code(toStatus(err)).String()),
+		)
 	}
 
 	logger.Info("request", zap.String("method", info.FullMethod), zap.Any("req", req), zap.Any("res", res), zap.Duration("duration", time.Since(now)))
 
 	return res, err
 }
+
+// loggedServerStream считает сообщения stream'а и запоминает начальный запрос
+type loggedServerStream struct {
+	grpc.ServerStream
+
+	mu       sync.Mutex
+	req      interface{}
+	sent     int
+	received int
+}
+
+func (s *loggedServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		s.sent++
+		s.mu.Unlock()
+	}
+
+	return err
+}
+
+func (s *loggedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		if s.received == 0 {
+			s.req = m
+		}
+		s.received++
+		s.mu.Unlock()
+	}
+
+	return err
+}
+
+// LogStreamInterceptor логирует открытие и закрытие stream'а, его длительность,
+// количество отправленных сообщений и статус закрытия. Статус логируется таким,
+// каким его вернет ErrorsStreamInterceptor
+func LogStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	now := time.Now()
+	stream := &loggedServerStream{ServerStream: ss}
+
+	logger.Info("stream connected", zap.String("method", info.FullMethod))
+
+	err := handler(srv, stream)
+	if err != nil {
+		logger.Error(err.Error(), zap.String("method", info.FullMethod), zap.Any("req", stream.req))
+	}
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	logger.Info("stream disconnected",
+		zap.String("method", info.FullMethod),
+		zap.Any("req", stream.req),
+		zap.Int("sent", stream.sent),
+		zap.Int("received", stream.received),
+		zap.String("status", status.Code(toStatus(err)).String()),
+		zap.Duration("duration", time.Since(now)),
+	)
+
+	return err
+}
@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/solumD/auth/pkg/access_v1"
+	"github.com/solumD/chat-server/internal/client/auth"
+	"github.com/solumD/chat-server/internal/identity"
+	"github.com/solumD/chat-server/internal/interceptor"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const connectChatMethod = "/chat_v1.ChatV1/ConnectChat"
+
+// accessClientFake заглушка клиента сервиса access. Пропускает только запросы
+// с разрешенным токеном и запоминает проверенные эндпоинты
+type accessClientFake struct {
+	allowedHeader string
+
+	mu        sync.Mutex
+	endpoints []string
+}
+
+func (c *accessClientFake) Check(ctx context.Context, req *access_v1.CheckRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.mu.Lock()
+	c.endpoints = append(c.endpoints, req.GetEndpointAddress())
+	c.mu.Unlock()
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	header := md.Get("authorization")
+	if len(header) == 0 || header[0] != c.allowedHeader {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *accessClientFake) checked() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.endpoints...)
+}
+
+// serverStreamFake заглушка server stream'а с заданным контекстом
+type serverStreamFake struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStreamFake) Context() context.Context {
+	return s.ctx
+}
+
+// bearer собирает заголовок авторизации с jwt-токеном пользователя (подпись не проверяется)
+func bearer(username string) string {
+	enc := base64.RawURLEncoding
+	return "Bearer " + enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		enc.EncodeToString([]byte(fmt.Sprintf(`{"username":%q,"role":1}`, username))) + "." +
+		enc.EncodeToString([]byte("signature"))
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		username = gofakeit.Username()
+		allowed  = bearer(username)
+	)
+
+	tests := []struct {
+		name        string
+		header      string
+		wantCalled  bool
+		wantErr     bool
+		wantChecked []string
+	}{
+		{
+			name:        "success stream opened by authenticated user",
+			header:      allowed,
+			wantCalled:  true,
+			wantChecked: []string{connectChatMethod},
+		},
+		{
+			name:        "error access denied",
+			header:      bearer(gofakeit.Username()),
+			wantErr:     true,
+			wantChecked: []string{connectChatMethod},
+		},
+		{
+			name:        "error no metadata",
+			header:      "",
+			wantErr:     true,
+			wantChecked: []string{connectChatMethod},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if len(tt.header) != 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			accessClient := &accessClientFake{allowedHeader: allowed}
+			streamInterceptor := interceptor.NewAuthInterceptor(auth.New(accessClient)).GetStream()
+
+			called := false
+			handler := func(_ any, stream grpc.ServerStream) error {
+				called = true
+
+				got, ok := identity.UsernameFromContext(stream.Context())
+				require.True(t, ok)
+				require.Equal(t, username, got)
+
+				return nil
+			}
+
+			err := streamInterceptor(nil, &serverStreamFake{ctx: ctx},
+				&grpc.StreamServerInfo{FullMethod: connectChatMethod, IsServerStream: true}, handler)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantCalled, called)
+			require.Equal(t, tt.wantChecked, accessClient.checked())
+		})
+	}
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const sendMessageMethod = "/chat_v1.ChatV1/SendMessage"
+
+	var (
+		username = gofakeit.Username()
+		allowed  = bearer(username)
+	)
+
+	tests := []struct {
+		name    string
+		header  string
+		want    any
+		wantErr bool
+	}{
+		{
+			name:   "success authenticated user",
+			header: allowed,
+			want:   username,
+		},
+		{
+			name:    "error access denied",
+			header:  bearer(gofakeit.Username()),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", tt.header))
+
+			accessClient := &accessClientFake{allowedHeader: allowed}
+			unaryInterceptor := interceptor.NewAuthInterceptor(auth.New(accessClient)).Get()
+
+			// обработчик возвращает имя пользователя из контекста
+			handler := func(ctx context.Context, _ any) (any, error) {
+				got, _ := identity.UsernameFromContext(ctx)
+				return got, nil
+			}
+
+			res, err := unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: sendMessageMethod}, handler)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, res)
+			}
+
+			require.Equal(t, []string{sendMessageMethod}, accessClient.checked())
+		})
+	}
+}
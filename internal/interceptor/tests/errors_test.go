@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/interceptor"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestErrorsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		notFoundErr  = errs.NotFound("chat", "chat %d doesn't exist", chatID)
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s not in chat %d", username, chatID)
+		plainErr     = fmt.Errorf("db is down")
+		statusErr    = status.Error(codes.Unauthenticated, "user is not authenticated")
+	)
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+		wantDetails []proto.Message
+		wantErr     error
+	}{
+		{
+			name:        "not found",
+			err:         notFoundErr,
+			wantCode:    codes.NotFound,
+			wantMessage: notFoundErr.Message,
+			wantDetails: []proto.Message{&errdetails.ResourceInfo{ResourceType: "chat", Description: notFoundErr.Message}},
+		},
+		{
+			name:        "permission denied wrapped by transaction",
+			err:         errors.Wrap(notMemberErr, "failed to execute code inside transaction"),
+			wantCode:    codes.PermissionDenied,
+			wantMessage: notMemberErr.Message,
+			wantDetails: []proto.Message{&errdetails.ErrorInfo{Reason: "NOT_CHAT_MEMBER", Domain: "chat-server"}},
+		},
+		{
+			name:        "invalid argument",
+			err:         errs.InvalidArgument("text", "message's text can't be empty"),
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "message's text can't be empty",
+			wantDetails: []proto.Message{&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "text", Description: "message's text can't be empty"},
+			}}},
+		},
+		{
+			name:        "already exists",
+			err:         errs.AlreadyExists("user", "user %s already exists", username),
+			wantCode:    codes.AlreadyExists,
+			wantMessage: fmt.Sprintf("user %s already exists", username),
+			wantDetails: []proto.Message{&errdetails.ResourceInfo{
+				ResourceType: "user",
+				Description:  fmt.Sprintf("user %s already exists", username),
+			}},
+		},
+		{
+			name:        "failed precondition",
+			err:         errs.FailedPrecondition("CHAT_DELETED", "chat %d is deleted", chatID),
+			wantCode:    codes.FailedPrecondition,
+			wantMessage: fmt.Sprintf("chat %d is deleted", chatID),
+			wantDetails: []proto.Message{&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: "CHAT_DELETED", Description: fmt.Sprintf("chat %d is deleted", chatID)},
+			}}},
+		},
+		{
+			name:        "resource exhausted",
+			err:         errs.ResourceExhausted("typing", "too many typing updates from user %s", username),
+			wantCode:    codes.ResourceExhausted,
+			wantMessage: fmt.Sprintf("too many typing updates from user %s", username),
+			wantDetails: []proto.Message{&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{
+				{Subject: "typing", Description: fmt.Sprintf("too many typing updates from user %s", username)},
+			}}},
+		},
+		{
+			name:     "validation error",
+			err:      (&desc.ConnectChatRequest{SinceMessageId: -1}).Validate(),
+			wantCode: codes.InvalidArgument,
+			wantMessage: "invalid ConnectChatRequest.SinceMessageId: " +
+				"value must be greater than or equal to 0",
+			wantDetails: []proto.Message{&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "SinceMessageId", Description: "value must be greater than or equal to 0"},
+			}}},
+		},
+		{
+			name:    "status error is not changed",
+			err:     statusErr,
+			wantErr: statusErr,
+		},
+		{
+			name:    "unknown error is not changed",
+			err:     plainErr,
+			wantErr: plainErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, tt.err
+			}
+
+			res, err := interceptor.ErrorsInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+			require.Nil(t, res)
+
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMessage, st.Message())
+
+			details := st.Details()
+			require.Len(t, details, len(tt.wantDetails))
+			for i, want := range tt.wantDetails {
+				require.True(t, proto.Equal(want, details[i].(proto.Message)))
+			}
+		})
+	}
+}
+
+func TestErrorsStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	chatID := gofakeit.Int64()
+	info := &grpc.StreamServerInfo{FullMethod: connectChatMethod, IsServerStream: true}
+
+	err := interceptor.ErrorsStreamInterceptor(nil, &connectStreamFake{}, info, func(_ any, _ grpc.ServerStream) error {
+		return errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	err = interceptor.ErrorsStreamInterceptor(nil, &connectStreamFake{}, info, func(_ any, _ grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/interceptor"
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+)
+
+// observeLogs подменяет глобальный логгер и возвращает записанные им логи.
+// Тесты, которые его используют, не запускаются параллельно
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	core, logs := observer.New(zap.InfoLevel)
+	logger.Init(core)
+	t.Cleanup(logger.MockInit)
+
+	return logs
+}
+
+// loggedStatus возвращает статус из последней записи лога с сообщением msg
+func loggedStatus(t *testing.T, logs *observer.ObservedLogs, msg string) string {
+	entries := logs.FilterMessage(msg).All()
+	require.NotEmpty(t, entries)
+
+	return entries[len(entries)-1].ContextMap()["status"].(string)
+}
+
+func TestLogInterceptorsStatus(t *testing.T) {
+	var (
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+	)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus string
+	}{
+		{
+			name:       "domain error is logged with its code",
+			err:        errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s not in chat %d", username, chatID),
+			wantStatus: "PermissionDenied",
+		},
+		{
+			name:       "not found error is logged with its code",
+			err:        errs.NotFound("chat", "chat %d doesn't exist", chatID),
+			wantStatus: "NotFound",
+		},
+		{
+			name:       "plain error is logged as unknown",
+			err:        fmt.Errorf("db is down"),
+			wantStatus: "Unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := observeLogs(t)
+
+			_, err := interceptor.LogInterceptor(context.Background(), &desc.SendMessageRequest{},
+				&grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/SendMessage"},
+				func(_ context.Context, _ interface{}) (interface{}, error) {
+					return nil, tt.err
+				})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.wantStatus, loggedStatus(t, logs, tt.err.Error()))
+
+			err = interceptor.LogStreamInterceptor(nil, &connectStreamFake{req: &desc.ConnectChatRequest{}},
+				&grpc.StreamServerInfo{FullMethod: connectChatMethod, IsServerStream: true},
+				func(_ any, _ grpc.ServerStream) error {
+					return tt.err
+				})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.wantStatus, loggedStatus(t, logs, "stream disconnected"))
+		})
+	}
+}
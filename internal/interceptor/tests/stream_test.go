@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/interceptor"
+	"github.com/solumD/chat-server/internal/logger"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// connectStreamFake заглушка stream'а ConnectChat: отдает заданный начальный
+// запрос и запоминает отправленные сообщения
+type connectStreamFake struct {
+	grpc.ServerStream
+	req  *desc.ConnectChatRequest
+	sent []interface{}
+}
+
+func (s *connectStreamFake) Context() context.Context {
+	return context.Background()
+}
+
+func (s *connectStreamFake) RecvMsg(m interface{}) error {
+	proto.Merge(m.(*desc.ConnectChatRequest), s.req)
+	return nil
+}
+
+func (s *connectStreamFake) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// connectHandler обработчик server-streaming метода, который читает начальный
+// запрос и отправляет в ответ заданное количество сообщений
+func connectHandler(called *bool, messages int) grpc.StreamHandler {
+	return func(_ any, stream grpc.ServerStream) error {
+		req := &desc.ConnectChatRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		*called = true
+
+		for i := 0; i < messages; i++ {
+			if err := stream.SendMsg(&desc.Message{From: gofakeit.Username()}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestValidateStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		req        *desc.ConnectChatRequest
+		wantCalled bool
+		wantErr    bool
+	}{
+		{
+			name:       "success valid request",
+			req:        &desc.ConnectChatRequest{Id: gofakeit.Int64(), Username: gofakeit.Username()},
+			wantCalled: true,
+		},
+		{
+			name:    "error invalid request",
+			req:     &desc.ConnectChatRequest{Id: gofakeit.Int64(), SinceMessageId: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			err := interceptor.ValidateStreamInterceptor(nil, &connectStreamFake{req: tt.req},
+				&grpc.StreamServerInfo{FullMethod: connectChatMethod, IsServerStream: true}, connectHandler(&called, 0))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
+
+func TestLogStreamInterceptor(t *testing.T) {
+	t.Parallel()
+	logger.MockInit()
+
+	var (
+		req        = &desc.ConnectChatRequest{Id: gofakeit.Int64(), Username: gofakeit.Username()}
+		handlerErr = fmt.Errorf("stream failed")
+	)
+
+	tests := []struct {
+		name     string
+		messages int
+		handler  func(called *bool) grpc.StreamHandler
+		wantErr  error
+	}{
+		{
+			name:     "success messages are passed through",
+			messages: 3,
+			handler: func(called *bool) grpc.StreamHandler {
+				return connectHandler(called, 3)
+			},
+		},
+		{
+			name: "error from handler is returned",
+			handler: func(called *bool) grpc.StreamHandler {
+				return func(_ any, _ grpc.ServerStream) error {
+					*called = true
+					return handlerErr
+				}
+			},
+			wantErr: handlerErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &connectStreamFake{req: req}
+			called := false
+
+			err := interceptor.LogStreamInterceptor(nil, stream,
+				&grpc.StreamServerInfo{FullMethod: connectChatMethod, IsServerStream: true}, tt.handler(&called))
+			require.Equal(t, tt.wantErr, err)
+			require.True(t, called)
+			require.Len(t, stream.sent, tt.messages)
+		})
+	}
+}
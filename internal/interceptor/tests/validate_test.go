@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/interceptor"
+	desc "github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestValidateInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		req        *desc.SendMessageRequest
+		wantCalled bool
+		wantErr    bool
+	}{
+		{
+			name:       "success sender is set",
+			req:        &desc.SendMessageRequest{Id: gofakeit.Int64(), From: "user1", Text: gofakeit.Fruit()},
+			wantCalled: true,
+		},
+		{
+			// пустой отправитель означает аутентифицированного пользователя
+			name:       "success sender is empty",
+			req:        &desc.SendMessageRequest{Id: gofakeit.Int64(), Text: gofakeit.Fruit()},
+			wantCalled: true,
+		},
+		{
+			name:    "error invalid sender",
+			req:     &desc.SendMessageRequest{Id: gofakeit.Int64(), From: "user 1", Text: gofakeit.Fruit()},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			_, err := interceptor.ValidateInterceptor(context.Background(), tt.req,
+				&grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/SendMessage"},
+				func(_ context.Context, _ interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
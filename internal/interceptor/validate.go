@@ -20,3 +20,26 @@ func ValidateInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServ
 
 	return handler(ctx, req)
 }
+
+// validatedServerStream валидирует каждое полученное из stream'а сообщение
+type validatedServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if val, ok := m.(validator); ok {
+		return val.Validate()
+	}
+
+	return nil
+}
+
+// ValidateStreamInterceptor проводит базовую валидацию запросов stream'а,
+// в том числе начального запроса server-streaming методов
+func ValidateStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatedServerStream{ServerStream: ss})
+}
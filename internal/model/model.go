@@ -1,15 +1,168 @@
 package model
 
-// Chat модель чата в сервисном слое
+import (
+	"database/sql"
+	"time"
+)
+
+// Chat модель чата в сервисном слое. Creator - пользователь, создающий
+// чат, он становится его владельцем. UnreadCount - количество непрочитанных
+// пользователем сообщений в списке его чатов
 type Chat struct {
-	ID        int64
-	Name      string
-	Usernames []string
+	ID          int64
+	Name        string
+	Usernames   []string
+	Creator     string
+	UnreadCount int64
 }
 
-// Message модель сообщения в сервисном слое
+// Role роль участника чата. Чем меньше значение, тем больше прав
+type Role int
+
+const (
+	// RoleOwner владелец чата, у чата он ровно один
+	RoleOwner Role = 1
+	// RoleAdmin администратор чата
+	RoleAdmin Role = 2
+	// RoleMember обычный участник чата
+	RoleMember Role = 3
+)
+
+// Message модель сообщения в сервисном слое. Текст удаленного сообщения пустой
 type Message struct {
-	ChatID int64
-	From   string
-	Text   string
+	ID        int64
+	ChatID    int64
+	From      string
+	Text      string
+	CreatedAt time.Time
+	EditedAt  sql.NullTime
+	Deleted   bool
+	// ReplyToMessageID id сообщения, на которое отвечает сообщение, 0 - если сообщение не ответ
+	ReplyToMessageID int64
+	// ReplyCount количество неудаленных ответов на сообщение
+	ReplyCount int64
+	// Reactions реакции на сообщение в порядке их первого появления
+	Reactions []*Reaction
+}
+
+// Reaction реакция на сообщение и количество поставивших ее пользователей
+type Reaction struct {
+	Emoji string
+	Count int64
+}
+
+// ChatEventType тип события чата
+type ChatEventType int
+
+const (
+	// ChatEventMessage новое сообщение
+	ChatEventMessage ChatEventType = iota + 1
+	// ChatEventMessageEdited сообщение изменено
+	ChatEventMessageEdited
+	// ChatEventMessageDeleted сообщение удалено
+	ChatEventMessageDeleted
+	// ChatEventMemberAdded пользователь добавлен в чат
+	ChatEventMemberAdded
+	// ChatEventMemberRemoved пользователь удален из чата или покинул его
+	ChatEventMemberRemoved
+	// ChatEventTyping пользователь начал или закончил набирать текст
+	ChatEventTyping
+	// ChatEventPresence участник чата подключился или отключился от всех чатов
+	ChatEventPresence
+	// ChatEventRead участник чата прочитал сообщения
+	ChatEventRead
+	// ChatEventReactionsChanged изменились реакции на сообщение
+	ChatEventReactionsChanged
+	// ChatEventMessagePinned сообщение закреплено
+	ChatEventMessagePinned
+	// ChatEventMessageUnpinned сообщение откреплено
+	ChatEventMessageUnpinned
+	// ChatEventResync события об изменении сообщений могли быть потеряны
+	ChatEventResync
+)
+
+// ChatEvent событие чата, рассылаемое его подписчикам. Message задано
+// у событий о сообщениях, Username - у событий об участниках чата,
+// о наборе текста, о подключении и о прочтении, IsTyping - у событий о наборе
+// текста, Presence - у событий о подключении, ReadUpTo - у событий о прочтении
+type ChatEvent struct {
+	Type     ChatEventType
+	ChatID   int64
+	Message  *Message
+	Username string
+	IsTyping bool
+	Presence *Presence
+	ReadUpTo int64
+}
+
+// Presence модель подключения пользователя в сервисном слое. LastSeenAt -
+// время последнего отключения от всех чатов
+type Presence struct {
+	Username   string
+	Online     bool
+	LastSeenAt sql.NullTime
+}
+
+// Delivery доставка сообщения в живой stream получателя
+type Delivery struct {
+	MessageID   int64
+	Username    string
+	DeliveredAt time.Time
+}
+
+// MessageStatus статус сообщения у одного получателя. Сообщения, прочитанные
+// до появления отметок о прочтении, прочитаны без времени прочтения
+type MessageStatus struct {
+	Username    string
+	Delivered   bool
+	DeliveredAt sql.NullTime
+	Read        bool
+	ReadAt      sql.NullTime
+}
+
+// MessagesFilter параметры выборки истории сообщений чата.
+// BeforeID и AfterID - курсоры (id сообщений), задается не больше одного из них
+type MessagesFilter struct {
+	ChatID   int64
+	Username string
+	BeforeID int64
+	AfterID  int64
+	Limit    uint64
+	// ReplyToMessageID если указан, выбираются только ответы на это сообщение
+	ReplyToMessageID int64
+}
+
+// MessagesPage страница истории сообщений чата
+// (сообщения отсортированы по возрастанию id)
+type MessagesPage struct {
+	Messages []*Message
+	HasMore  bool
+}
+
+// OutboxEventType тип события outbox
+type OutboxEventType string
+
+const (
+	// OutboxEventMessage новое сообщение
+	OutboxEventMessage OutboxEventType = "message"
+	// OutboxEventMessageEdited сообщение изменено
+	OutboxEventMessageEdited OutboxEventType = "message_edited"
+	// OutboxEventMessageDeleted сообщение удалено
+	OutboxEventMessageDeleted OutboxEventType = "message_deleted"
+	// OutboxEventReactionsChanged изменились реакции на сообщение
+	OutboxEventReactionsChanged OutboxEventType = "reactions_changed"
+	// OutboxEventMessagePinned сообщение закреплено
+	OutboxEventMessagePinned OutboxEventType = "message_pinned"
+	// OutboxEventMessageUnpinned сообщение откреплено
+	OutboxEventMessageUnpinned OutboxEventType = "message_unpinned"
+)
+
+// OutboxEvent событие о сообщении, сохраненное в outbox в одной транзакции
+// с изменением сообщения и ожидающее отправки в pub/sub
+type OutboxEvent struct {
+	ID        int64
+	Type      OutboxEventType
+	ChatID    int64
+	MessageID int64
+	CreatedAt time.Time
 }
@@ -0,0 +1,155 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// Relay фоновый обработчик outbox: публикует сохраненные события в pub/sub
+// и помечает их обработанными. Доставка at-least-once: если пометить
+// события не удалось, они будут опубликованы повторно
+type Relay struct {
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	pubSub           pubsub.PubSub
+
+	interval  time.Duration
+	batchSize uint64
+
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRelay возвращает обработчик outbox, который раз в interval
+// публикует до batchSize событий за транзакцию
+func NewRelay(outboxRepository repository.OutboxRepository, txManager db.TxManager,
+	pubSub pubsub.PubSub, interval time.Duration, batchSize uint64,
+) *Relay {
+	return &Relay{
+		outboxRepository: outboxRepository,
+		txManager:        txManager,
+		pubSub:           pubSub,
+		interval:         interval,
+		batchSize:        batchSize,
+		done:             make(chan struct{}),
+	}
+}
+
+// Start запускает обработку outbox в отдельной горутине
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go r.run(ctx)
+}
+
+// Close останавливает обработку и дожидается завершения текущей транзакции
+func (r *Relay) Close() error {
+	r.stopOnce.Do(func() {
+		if r.cancel == nil {
+			close(r.done)
+			return
+		}
+
+		r.cancel()
+	})
+	<-r.done
+
+	return nil
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// разбираем накопившиеся события, пока батчи заполнены целиком
+		for {
+			n, err := r.relayBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("failed to relay outbox events", zap.Error(err))
+				}
+				break
+			}
+
+			if uint64(n) < r.batchSize {
+				break
+			}
+		}
+	}
+}
+
+// relayBatch публикует батч событий и помечает их обработанными в одной транзакции.
+// Возвращает количество опубликованных событий
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	var events []*model.OutboxEvent
+	err := r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		events, errTx = r.outboxRepository.GetPendingEvents(ctx, r.batchSize)
+		if errTx != nil {
+			return errTx
+		}
+
+		ids := make([]int64, 0, len(events))
+		for _, event := range events {
+			errTx = r.pubSub.Publish(ctx, &pubsub.Event{
+				Type:      toEventType(event.Type),
+				ChatID:    event.ChatID,
+				MessageID: event.MessageID,
+			})
+			if errTx != nil {
+				return errTx
+			}
+
+			ids = append(ids, event.ID)
+		}
+
+		return r.outboxRepository.MarkEventsProcessed(ctx, ids)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// toEventType возвращает тип события pub/sub для события outbox. Неизвестный
+// тип публикуется как есть: его обработают экземпляры сервера, которые его знают
+func toEventType(eventType model.OutboxEventType) pubsub.EventType {
+	switch eventType {
+	case model.OutboxEventMessage, "":
+		return pubsub.EventTypeMessage
+	case model.OutboxEventMessageEdited:
+		return pubsub.EventTypeMessageEdited
+	case model.OutboxEventMessageDeleted:
+		return pubsub.EventTypeMessageDeleted
+	case model.OutboxEventReactionsChanged:
+		return pubsub.EventTypeReactionsChanged
+	case model.OutboxEventMessagePinned:
+		return pubsub.EventTypeMessagePinned
+	case model.OutboxEventMessageUnpinned:
+		return pubsub.EventTypeMessageUnpinned
+	default:
+		return pubsub.EventType(eventType)
+	}
+}
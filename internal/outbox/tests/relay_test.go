@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/outbox"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPubSub pub/sub, публикация в который всегда завершается ошибкой
+type failingPubSub struct {
+	pubsub.PubSub
+	err error
+}
+
+func (p *failingPubSub) Publish(_ context.Context, _ *pubsub.Event) error {
+	return p.err
+}
+
+// recorder запоминает события, полученные из pub/sub
+type recorder struct {
+	mu     sync.Mutex
+	events []*pubsub.Event
+}
+
+func (r *recorder) handle(_ context.Context, event *pubsub.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) received() []*pubsub.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*pubsub.Event(nil), r.events...)
+}
+
+func TestRelay(t *testing.T) {
+	t.Parallel()
+	type outboxRepositoryMockFunc func(mc *minimock.Controller, marked chan []int64) repository.OutboxRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+
+		repoErr    = fmt.Errorf("repo error")
+		publishErr = fmt.Errorf("publish error")
+
+		events = []*model.OutboxEvent{
+			{ID: 1, ChatID: chatID, MessageID: gofakeit.Int64(), CreatedAt: gofakeit.Date()},
+			{ID: 2, Type: model.OutboxEventMessageEdited, ChatID: chatID, MessageID: gofakeit.Int64(), CreatedAt: gofakeit.Date()},
+			// событие нового типа, сохраненное более новым экземпляром сервера
+			{ID: 3, Type: "message_forwarded", ChatID: chatID, MessageID: gofakeit.Int64(), CreatedAt: gofakeit.Date()},
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		publishErr         error
+		want               []*pubsub.Event
+		wantMarked         []int64
+		outboxRepoMockFunc outboxRepositoryMockFunc
+	}{
+		{
+			name: "success publish and mark processed",
+			want: []*pubsub.Event{
+				{Type: pubsub.EventTypeMessage, ChatID: chatID, MessageID: events[0].MessageID},
+				{Type: pubsub.EventTypeMessageEdited, ChatID: chatID, MessageID: events[1].MessageID},
+				{Type: "message_forwarded", ChatID: chatID, MessageID: events[2].MessageID},
+			},
+			wantMarked: []int64{1, 2, 3},
+			outboxRepoMockFunc: func(mc *minimock.Controller, marked chan []int64) repository.OutboxRepository {
+				var (
+					mu   sync.Mutex
+					done bool
+				)
+
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Set(func(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error) {
+					mu.Lock()
+					defer mu.Unlock()
+
+					if done {
+						return []*model.OutboxEvent{}, nil
+					}
+					return events, nil
+				})
+				mock.MarkEventsProcessedMock.Set(func(ctx context.Context, ids []int64) error {
+					mu.Lock()
+					defer mu.Unlock()
+
+					if !done {
+						done = true
+						marked <- ids
+					}
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:       "error publish keeps events pending",
+			publishErr: publishErr,
+			outboxRepoMockFunc: func(mc *minimock.Controller, _ chan []int64) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Return(events, nil)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			outboxRepoMockFunc: func(mc *minimock.Controller, _ chan []int64) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			marked := make(chan []int64, 1)
+			outboxRepoMock := tt.outboxRepoMockFunc(mc, marked)
+
+			txManagerMock := mocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			rec := &recorder{}
+			var ps pubsub.PubSub = memory.New()
+			if tt.publishErr != nil {
+				ps = &failingPubSub{PubSub: ps, err: tt.publishErr}
+			}
+			ps.Subscribe(rec.handle, nil)
+
+			relay := outbox.NewRelay(outboxRepoMock, txManagerMock, ps, time.Millisecond, 10)
+			relay.Start()
+
+			if tt.wantMarked != nil {
+				select {
+				case ids := <-marked:
+					require.Equal(t, tt.wantMarked, ids)
+				case <-time.After(5 * time.Second):
+					t.Fatal("events were not marked processed")
+				}
+			} else {
+				// даем обработчику несколько раз попытаться разобрать outbox
+				require.Eventually(t, func() bool {
+					return outboxRepoMock.(*repoMocks.OutboxRepositoryMock).GetPendingEventsAfterCounter() >= 3
+				}, 5*time.Second, time.Millisecond)
+			}
+
+			require.NoError(t, relay.Close())
+			require.Equal(t, tt.want, rec.received())
+		})
+	}
+}
+
+func TestRelayCloseWithoutStart(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	defer t.Cleanup(mc.Finish)
+
+	relay := outbox.NewRelay(repoMocks.NewOutboxRepositoryMock(mc), mocks.NewTxManagerMock(mc),
+		memory.New(), time.Millisecond, 10)
+
+	require.NoError(t, relay.Close())
+	require.NoError(t, relay.Close())
+}
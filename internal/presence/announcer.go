@@ -0,0 +1,137 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultHeartbeatInterval как часто экземпляр сервера повторяет
+	// объявление подключенных к нему пользователей по умолчанию
+	DefaultHeartbeatInterval = 10 * time.Second
+
+	// maxAnnouncePayload максимальный суммарный размер имен пользователей в
+	// одном событии в байтах. Размер события pub/sub ограничен (NOTIFY в postgres -
+	// 8000 байт), часть оставлена под остальные поля события
+	maxAnnouncePayload = 7000
+)
+
+// UsersFunc возвращает пользователей, подключенных к экземпляру сервера
+type UsersFunc func() []string
+
+// Announcer объявляет через pub/sub, какие пользователи подключены к
+// экземпляру сервера, и периодически повторяет объявление для реестров
+// остальных экземпляров
+type Announcer struct {
+	instanceID string
+	pubSub     pubsub.PubSub
+	users      UsersFunc
+	interval   time.Duration
+
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewAnnouncer возвращает объявитель подключений экземпляра instanceID,
+// который раз в interval повторяет объявление пользователей из users
+func NewAnnouncer(instanceID string, pubSub pubsub.PubSub, users UsersFunc, interval time.Duration) *Announcer {
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+
+	return &Announcer{
+		instanceID: instanceID,
+		pubSub:     pubSub,
+		users:      users,
+		interval:   interval,
+		done:       make(chan struct{}),
+	}
+}
+
+// Announce объявляет, что пользователи подключились к экземпляру сервера или отключились от него
+func (a *Announcer) Announce(ctx context.Context, usernames []string, online bool) error {
+	for len(usernames) != 0 {
+		batch := usernames[:announceBatchSize(usernames)]
+		usernames = usernames[len(batch):]
+
+		err := a.pubSub.Publish(ctx, &pubsub.Event{
+			Type:       pubsub.EventTypePresence,
+			InstanceID: a.instanceID,
+			Usernames:  batch,
+			IsOnline:   online,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// announceBatchSize возвращает, сколько первых пользователей помещается в одно
+// событие. Имя считается в том виде, в котором оно попадет в payload события
+func announceBatchSize(usernames []string) int {
+	size := 0
+	for i, username := range usernames {
+		encoded, _ := json.Marshal(username)
+		size += len(encoded) + 1 // имена разделяются запятой
+
+		// имя пользователя короче лимита, поэтому в событие попадает хотя бы одно
+		if size > maxAnnouncePayload && i > 0 {
+			return i
+		}
+	}
+
+	return len(usernames)
+}
+
+// Start запускает периодическое объявление в отдельной горутине
+func (a *Announcer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+
+	go a.run(ctx)
+}
+
+// Close останавливает периодическое объявление
+func (a *Announcer) Close() error {
+	a.stopOnce.Do(func() {
+		if a.cancel == nil {
+			close(a.done)
+			return
+		}
+
+		a.cancel()
+	})
+	<-a.done
+
+	return nil
+}
+
+func (a *Announcer) run(ctx context.Context) {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := a.Announce(ctx, a.users(), true)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to announce online users", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package presence
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// DefaultTTL через сколько после последнего объявления подключение
+// пользователя к экземпляру сервера считается завершенным по умолчанию
+const DefaultTTL = 30 * time.Second
+
+// ChangeHandler вызывается, когда пользователь подключился к первому
+// экземпляру сервера или отключился от последнего
+type ChangeHandler func(username string, online bool)
+
+// entry подключение пользователя к экземпляру сервера. Таймер завершает
+// подключение, если экземпляр не повторил объявление за ttl
+type entry struct {
+	timer *time.Timer
+}
+
+// Registry хранит в памяти, к каким экземплярам сервера подключены
+// пользователи. Экземпляры объявляют подключения через pub/sub и периодически
+// повторяют их, поэтому пользователи упавшего экземпляра отключаются сами
+type Registry struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	online   map[string]map[string]*entry // username -> instanceID -> подключение
+	onChange ChangeHandler
+
+	// обработчик вызывается под notifyMu, чтобы изменения одного
+	// пользователя не обгоняли друг друга
+	notifyMu sync.Mutex
+}
+
+// New возвращает новый реестр подключенных пользователей
+func New(ttl time.Duration) *Registry {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Registry{
+		ttl:    ttl,
+		online: make(map[string]map[string]*entry),
+	}
+}
+
+// NewInstanceID возвращает случайный id экземпляра сервера
+func NewInstanceID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// OnChange задает обработчик подключения и отключения пользователей
+func (r *Registry) OnChange(handler ChangeHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.onChange = handler
+}
+
+// Set отмечает, что пользователь подключен к экземпляру сервера или отключен
+// от него. Повторное подключение только продлевает его на ttl
+func (r *Registry) Set(instanceID string, username string, online bool) {
+	r.mu.Lock()
+
+	instances := r.online[username]
+	wasOnline := len(instances) != 0
+
+	if prev, ok := instances[instanceID]; ok {
+		prev.timer.Stop()
+		delete(instances, instanceID)
+	}
+
+	if online {
+		if instances == nil {
+			instances = make(map[string]*entry)
+			r.online[username] = instances
+		}
+
+		// у каждого объявления свой таймер, поэтому сработавший старый
+		// таймер не завершит продленное подключение
+		e := &entry{}
+		e.timer = time.AfterFunc(r.ttl, func() {
+			r.expire(instanceID, username, e)
+		})
+		instances[instanceID] = e
+	}
+
+	r.release(username, wasOnline)
+}
+
+// IsOnline проверяет, подключен ли пользователь хотя бы к одному экземпляру сервера
+func (r *Registry) IsOnline(username string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.online[username]) != 0
+}
+
+func (r *Registry) expire(instanceID string, username string, e *entry) {
+	r.mu.Lock()
+	if r.online[username][instanceID] != e {
+		r.mu.Unlock()
+		return
+	}
+
+	delete(r.online[username], instanceID)
+	r.release(username, true)
+}
+
+// release отпускает mu и, если состояние пользователя изменилось, вызывает обработчик
+func (r *Registry) release(username string, wasOnline bool) {
+	isOnline := len(r.online[username]) != 0
+	if !isOnline {
+		delete(r.online, username)
+	}
+
+	handler := r.onChange
+	if handler == nil || isOnline == wasOnline {
+		r.mu.Unlock()
+		return
+	}
+
+	r.notifyMu.Lock()
+	r.mu.Unlock()
+	defer r.notifyMu.Unlock()
+
+	handler(username, isOnline)
+}
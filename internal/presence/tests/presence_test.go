@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/presence"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = 5 * time.Second
+
+// change изменение состояния пользователя, переданное обработчику реестра
+type change struct {
+	username string
+	online   bool
+}
+
+func TestRegistrySet(t *testing.T) {
+	t.Parallel()
+
+	const (
+		instanceA = "a"
+		instanceB = "b"
+	)
+
+	type update struct {
+		instanceID string
+		online     bool
+		want       bool // состояние пользователя после обновления
+	}
+
+	tests := []struct {
+		name    string
+		updates []update
+		changes []bool
+	}{
+		{
+			name:    "connect",
+			updates: []update{{instanceID: instanceA, online: true, want: true}},
+			changes: []bool{true},
+		},
+		{
+			name: "refresh doesn't change state",
+			updates: []update{
+				{instanceID: instanceA, online: true, want: true},
+				{instanceID: instanceA, online: true, want: true},
+			},
+			changes: []bool{true},
+		},
+		{
+			name: "disconnect",
+			updates: []update{
+				{instanceID: instanceA, online: true, want: true},
+				{instanceID: instanceA, online: false, want: false},
+			},
+			changes: []bool{true, false},
+		},
+		{
+			name: "online while connected to another instance",
+			updates: []update{
+				{instanceID: instanceA, online: true, want: true},
+				{instanceID: instanceB, online: true, want: true},
+				{instanceID: instanceA, online: false, want: true},
+				{instanceID: instanceB, online: false, want: false},
+			},
+			changes: []bool{true, false},
+		},
+		{
+			name:    "disconnect without connect doesn't change state",
+			updates: []update{{instanceID: instanceA, online: false, want: false}},
+			changes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry := presence.New(time.Minute)
+			username := gofakeit.Username()
+
+			var changes []bool
+			registry.OnChange(func(changed string, online bool) {
+				require.Equal(t, username, changed)
+				changes = append(changes, online)
+			})
+
+			for _, u := range tt.updates {
+				registry.Set(u.instanceID, username, u.online)
+				require.Equal(t, u.want, registry.IsOnline(username))
+			}
+
+			require.Equal(t, tt.changes, changes)
+
+			// состояние других пользователей не меняется
+			require.False(t, registry.IsOnline(username+"2"))
+		})
+	}
+}
+
+func TestRegistryExpire(t *testing.T) {
+	t.Parallel()
+
+	const ttl = 50 * time.Millisecond
+
+	registry := presence.New(ttl)
+	changes := make(chan change, 2)
+	registry.OnChange(func(username string, online bool) {
+		changes <- change{username: username, online: online}
+	})
+
+	username := gofakeit.Username()
+	started := time.Now()
+	registry.Set("a", username, true)
+	require.Equal(t, change{username: username, online: true}, <-changes)
+
+	// повторные объявления продлевают подключение дольше ttl
+	for i := 0; i < 4; i++ {
+		time.Sleep(ttl / 2)
+		registry.Set("a", username, true)
+	}
+
+	select {
+	case c := <-changes:
+		require.Equal(t, change{username: username, online: false}, c)
+		require.GreaterOrEqual(t, time.Since(started), 2*ttl)
+	case <-time.After(waitTimeout):
+		t.Fatal("presence didn't expire")
+	}
+	require.False(t, registry.IsOnline(username))
+
+	// явное отключение не истекает повторно
+	registry.Set("a", username, true)
+	registry.Set("a", username, false)
+	require.Equal(t, change{username: username, online: true}, <-changes)
+	require.Equal(t, change{username: username, online: false}, <-changes)
+
+	select {
+	case c := <-changes:
+		t.Fatalf("unexpected change: %v", c)
+	case <-time.After(2 * ttl):
+	}
+}
+
+func TestAnnouncer(t *testing.T) {
+	t.Parallel()
+
+	logger.MockInit()
+
+	var (
+		mu     sync.Mutex
+		events []*pubsub.Event
+	)
+	ps := memory.New()
+	ps.Subscribe(func(_ context.Context, event *pubsub.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		events = append(events, event)
+	}, nil)
+
+	// пользователей больше, чем помещается в одно событие
+	online := make([]string, 0, 100)
+	for i := 0; i < cap(online); i++ {
+		online = append(online, fmt.Sprintf("user%03d%s", i, strings.Repeat("x", 249)))
+	}
+
+	announcer := presence.NewAnnouncer("a", ps, func() []string { return online }, 10*time.Millisecond)
+
+	err := announcer.Announce(context.Background(), online[:1], false)
+	require.NoError(t, err)
+	require.Equal(t, []*pubsub.Event{{
+		Type:       pubsub.EventTypePresence,
+		InstanceID: "a",
+		Usernames:  online[:1],
+	}}, events)
+
+	// периодическое объявление разбивается на события, каждое из которых
+	// помещается в NOTIFY postgres
+	announcer.Start()
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		announced := 0
+		for _, event := range events[1:] {
+			announced += len(event.Usernames)
+		}
+
+		return announced >= len(online)
+	}, waitTimeout, time.Millisecond)
+	require.NoError(t, announcer.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	var announced []string
+	for _, event := range events[1:] {
+		if len(announced) == len(online) {
+			break
+		}
+
+		payload, err := json.Marshal(event)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(payload), 8000)
+		require.True(t, event.IsOnline)
+
+		announced = append(announced, event.Usernames...)
+	}
+	require.Greater(t, len(events), 2)
+	require.Equal(t, online, announced)
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/solumD/chat-server/internal/pubsub"
+)
+
+// memoryPubSub доставляет события подписчикам в пределах одного процесса
+type memoryPubSub struct {
+	mu       sync.RWMutex
+	handlers []pubsub.Handler
+}
+
+// New возвращает in-process реализацию pub/sub. Подходит для одного
+// экземпляра сервера и для тестов
+func New() pubsub.PubSub {
+	return &memoryPubSub{}
+}
+
+// Publish синхронно передает событие всем подписчикам
+func (p *memoryPubSub) Publish(ctx context.Context, event *pubsub.Event) error {
+	p.mu.RLock()
+	handlers := p.handlers
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+
+	return nil
+}
+
+// Subscribe регистрирует обработчик событий. События в пределах процесса
+// не теряются, поэтому resync никогда не вызывается
+func (p *memoryPubSub) Subscribe(handler pubsub.Handler, _ pubsub.ResyncHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlers = append(p.handlers, handler)
+}
+
+// Close ничего не делает
+func (p *memoryPubSub) Close() error {
+	return nil
+}
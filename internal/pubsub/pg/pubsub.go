@@ -0,0 +1,147 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+)
+
+// reconnectDelay пауза перед повторной подпиской после обрыва соединения
+const reconnectDelay = time.Second
+
+type subscription struct {
+	handler pubsub.Handler
+	resync  pubsub.ResyncHandler
+}
+
+// pgPubSub доставляет события между экземплярами сервера через postgres LISTEN/NOTIFY
+type pgPubSub struct {
+	db      db.Client
+	channel string
+
+	mu   sync.RWMutex
+	subs []subscription
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New возвращает реализацию pub/sub на postgres LISTEN/NOTIFY и запускает
+// прослушивание канала. Остановить его можно через Close
+func New(dbClient db.Client, channel string) pubsub.PubSub {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := &pgPubSub{
+		db:      dbClient,
+		channel: channel,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	go p.listen(ctx)
+
+	return p
+}
+
+// Publish отправляет уведомление в канал. Если в ctx есть транзакция,
+// уведомление будет доставлено только после ее коммита
+func (p *pgPubSub) Publish(ctx context.Context, event *pubsub.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "pubsub.Publish",
+		QueryRaw: "SELECT pg_notify($1, $2)",
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, p.channel, string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Subscribe регистрирует обработчик событий
+func (p *pgPubSub) Subscribe(handler pubsub.Handler, resync pubsub.ResyncHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subs = append(p.subs, subscription{handler: handler, resync: resync})
+}
+
+// Close останавливает прослушивание канала
+func (p *pgPubSub) Close() error {
+	p.cancel()
+	<-p.done
+
+	return nil
+}
+
+// listen слушает канал, переподключаясь при обрыве соединения. После
+// переподключения подписчики получают resync, так как уведомления,
+// отправленные во время обрыва, потеряны
+func (p *pgPubSub) listen(ctx context.Context) {
+	defer close(p.done)
+
+	reconnect := false
+	for {
+		err := p.db.DB().Listen(ctx, p.channel, func() {
+			if reconnect {
+				p.resync(ctx)
+			}
+		}, func(payload string) {
+			p.dispatch(ctx, payload)
+		})
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Error("pubsub listener failed, reconnecting", zap.String("channel", p.channel), zap.Error(err))
+		reconnect = true
+
+		select {
+		case <-time.After(reconnectDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *pgPubSub) dispatch(ctx context.Context, payload string) {
+	event := &pubsub.Event{}
+	if err := json.Unmarshal([]byte(payload), event); err != nil {
+		logger.Error("failed to decode pubsub event", zap.String("payload", payload), zap.Error(err))
+		return
+	}
+
+	p.mu.RLock()
+	subs := p.subs
+	p.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.handler(ctx, event)
+	}
+}
+
+func (p *pgPubSub) resync(ctx context.Context) {
+	p.mu.RLock()
+	subs := p.subs
+	p.mu.RUnlock()
+
+	for _, sub := range subs {
+		if sub.resync != nil {
+			sub.resync(ctx)
+		}
+	}
+}
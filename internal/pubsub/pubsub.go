@@ -0,0 +1,63 @@
+package pubsub
+
+import (
+	"context"
+)
+
+// EventType тип события
+type EventType string
+
+const (
+	// EventTypeMessage в чате появилось новое сообщение
+	EventTypeMessage EventType = "message"
+	// EventTypeMessageEdited сообщение в чате изменено
+	EventTypeMessageEdited EventType = "message_edited"
+	// EventTypeMessageDeleted сообщение в чате удалено
+	EventTypeMessageDeleted EventType = "message_deleted"
+	// EventTypeReactionsChanged изменились реакции на сообщение в чате
+	EventTypeReactionsChanged EventType = "reactions_changed"
+	// EventTypeMessagePinned сообщение в чате закреплено
+	EventTypeMessagePinned EventType = "message_pinned"
+	// EventTypeMessageUnpinned сообщение в чате откреплено
+	EventTypeMessageUnpinned EventType = "message_unpinned"
+	// EventTypeMemberAdded пользователь добавлен в чат
+	EventTypeMemberAdded EventType = "member_added"
+	// EventTypeMemberRemoved пользователь удален из чата или покинул его
+	EventTypeMemberRemoved EventType = "member_removed"
+	// EventTypeTyping пользователь начал или закончил набирать текст
+	EventTypeTyping EventType = "typing"
+	// EventTypePresence пользователи подключились к экземпляру сервера или отключились от него
+	EventTypePresence EventType = "presence"
+	// EventTypeRead пользователь прочитал сообщения чата до MessageID включительно
+	EventTypeRead EventType = "read"
+)
+
+// Event событие в чате. Сообщение получатель загружает из БД по его id,
+// поэтому размер события не зависит от текста сообщения. События без
+// типа считаются событиями о новом сообщении
+type Event struct {
+	Type      EventType `json:"type,omitempty"`
+	ChatID    int64     `json:"chat_id"`
+	MessageID int64     `json:"message_id,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	IsTyping  bool      `json:"is_typing,omitempty"`
+
+	// события о подключении пользователей не относятся к чату
+	InstanceID string   `json:"instance_id,omitempty"`
+	Usernames  []string `json:"usernames,omitempty"`
+	IsOnline   bool     `json:"is_online,omitempty"`
+}
+
+// Handler обработчик событий
+type Handler func(ctx context.Context, event *Event)
+
+// ResyncHandler вызывается, когда часть событий могла быть потеряна
+// (например, пока соединение с брокером было разорвано)
+type ResyncHandler func(ctx context.Context)
+
+// PubSub интерфейс доставки событий между экземплярами сервера
+type PubSub interface {
+	Publish(ctx context.Context, event *Event) error
+	Subscribe(handler Handler, resync ResyncHandler)
+	Close() error
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/errs"
 	"github.com/solumD/chat-server/internal/model"
 
 	sq "github.com/Masterminds/squirrel"
@@ -107,11 +108,13 @@ func (r *repo) insertUsers(ctx context.Context, names []string) ([]int64, error)
 	return userIDs, nil
 }
 
-// insertUsersInChats сохраняет id чата и его юзеров
-func (r *repo) insertUsersInChats(ctx context.Context, chatID int64, userIDs []int64) error {
+// insertUsersInChats сохраняет id чата и его юзеров. Юзеры, уже состоящие в чате,
+// пропускаются. Возвращает id добавленных юзеров
+func (r *repo) insertUsersInChats(ctx context.Context, chatID int64, userIDs []int64) ([]int64, error) {
 	builder := sq.Insert(usersInChatsTable).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatIDColumn, userIDColumn)
+		Columns(chatIDColumn, userIDColumn).
+		Suffix("ON CONFLICT (" + chatIDColumn + ", " + userIDColumn + ") DO NOTHING RETURNING " + userIDColumn)
 
 	for _, id := range userIDs {
 		builder = builder.Values(chatID, id)
@@ -120,7 +123,7 @@ func (r *repo) insertUsersInChats(ctx context.Context, chatID int64, userIDs []i
 	query, args, err := builder.ToSql()
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	q := db.Query{
@@ -128,12 +131,26 @@ func (r *repo) insertUsersInChats(ctx context.Context, chatID int64, userIDs []i
 		QueryRaw: query,
 	}
 
-	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer rows.Close()
+
+	inserted := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		inserted = append(inserted, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return inserted, nil
 }
 
 // isChatExist проверяет, существует ли в БД чат с указанным id
@@ -172,6 +189,35 @@ func (r *repo) isChatExist(ctx context.Context, chatID int64) (bool, error) {
 	return true, nil
 }
 
+// isMessageInChat проверяет, существует ли в указанном чате сообщение с указанным id
+func (r *repo) isMessageInChat(ctx context.Context, messageID int64, chatID int64) (bool, error) {
+	query, args, err := sq.Select("1").
+		From(messagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: messageID, chatIDColumn: chatID}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.isMessageInChat",
+		QueryRaw: query,
+	}
+
+	var one int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&one)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 // isUserExistByName проверяет, существует ли в БД пользователь с указанными именем
 func (r *repo) isUserExistByName(ctx context.Context, name string) (bool, error) {
 	query, args, err := sq.Select("1").
@@ -201,7 +247,7 @@ func (r *repo) isUserExistByName(ctx context.Context, name string) (bool, error)
 	return true, nil
 }
 
-// getUserByName получает из БД id юзера с указанными именем
+// getUserIDByName получает из БД id юзера с указанными именем
 func (r *repo) getUserIDByName(ctx context.Context, name string) (int64, error) {
 	query, args, err := sq.Select(idColumn).
 		From(usersTable).
@@ -221,6 +267,9 @@ func (r *repo) getUserIDByName(ctx context.Context, name string) (int64, error)
 	var userID int64
 	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errs.NotFound("user", "user %s doesn't exist", name)
+		}
 		return 0, err
 	}
 
@@ -351,7 +400,7 @@ func (r *repo) getChatUsersIDs(ctx context.Context, chatID int64) ([]int64, erro
 	return userIDs, nil
 }
 
-func (r *repo) getChatsInfo(ctx context.Context, chatIDs []int64) ([]*model.Chat, error) {
+func (r *repo) getChatsInfo(ctx context.Context, userID int64, chatIDs []int64) ([]*model.Chat, error) {
 	chatsInfo := []*model.Chat{}
 
 	for _, id := range chatIDs {
@@ -401,8 +450,148 @@ func (r *repo) getChatsInfo(ctx context.Context, chatIDs []int64) ([]*model.Chat
 
 		chatInfo.Usernames = usernames
 
+		chatInfo.UnreadCount, err = r.countUnread(ctx, id, userID)
+		if err != nil {
+			return nil, err
+		}
+
 		chatsInfo = append(chatsInfo, chatInfo)
 	}
 
 	return chatsInfo, nil
 }
+
+// countUnread считает неудаленные сообщения других участников чата,
+// отправленные после последнего прочитанного юзером сообщения
+func (r *repo) countUnread(ctx context.Context, chatID int64, userID int64) (int64, error) {
+	cursorSubquery := sq.Select(lastReadMessageIDColumn).
+		From(usersInChatsTable).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID}).
+		Limit(1)
+
+	query, args, err := sq.Select("COUNT(*)").
+		From(messagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, isDeletedColumn: 0}).
+		Where(sq.NotEq{userIDColumn: userID}).
+		Where(sq.Expr(idColumn+" > (?)", cursorSubquery)).
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.countUnread",
+		QueryRaw: query,
+	}
+
+	var count int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// selectMessages возвращает запрос сообщений вместе с именами их отправителей.
+// Порядок колонок: id, chat_id, username, message_text, created_at, edited_at, is_deleted,
+// reply_to_message_id (0, если сообщение не ответ), количество неудаленных ответов
+func selectMessages() sq.SelectBuilder {
+	return sq.Select(
+		"m."+idColumn,
+		"m."+chatIDColumn,
+		"u."+usernameColumn,
+		"m."+messageTextColumn,
+		"m."+createdAtColumn,
+		"m."+editedAtColumn,
+		"m."+isDeletedColumn,
+		"COALESCE(m."+replyToMessageIDColumn+", 0)",
+		"(SELECT COUNT(*) FROM "+messagesTable+" AS r WHERE r."+replyToMessageIDColumn+" = m."+idColumn+
+			" AND r."+isDeletedColumn+" = 0)",
+	).
+		From(messagesTable + " AS m").
+		Join(usersTable + " AS u ON u." + idColumn + " = m." + userIDColumn).
+		PlaceholderFormat(sq.Dollar)
+}
+
+// scanner строка результата запроса
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanMessage сканирует сообщение, выбранное запросом selectMessages.
+// Текст удаленного сообщения не возвращается
+func scanMessage(row scanner) (*model.Message, error) {
+	msg := &model.Message{}
+
+	var isDeleted int
+	err := row.Scan(&msg.ID, &msg.ChatID, &msg.From, &msg.Text, &msg.CreatedAt, &msg.EditedAt, &isDeleted,
+		&msg.ReplyToMessageID, &msg.ReplyCount)
+	if err != nil {
+		return nil, err
+	}
+
+	if isDeleted != 0 {
+		msg.Deleted = true
+		msg.Text = ""
+	}
+
+	return msg, nil
+}
+
+// attachReactions загружает одним запросом реакции на сообщения.
+// Реакции на удаленные сообщения не возвращаются
+func (r *repo) attachReactions(ctx context.Context, messages []*model.Message) error {
+	byID := make(map[int64]*model.Message, len(messages))
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		if msg.Deleted {
+			continue
+		}
+
+		byID[msg.ID] = msg
+		ids = append(ids, msg.ID)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.Select(messageIDColumn, emojiColumn, "COUNT(*)").
+		From(messageReactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: ids}).
+		GroupBy(messageIDColumn, emojiColumn).
+		OrderBy("MIN("+createdAtColumn+")", emojiColumn).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.attachReactions",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID int64
+		reaction := &model.Reaction{}
+		if err := rows.Scan(&messageID, &reaction.Emoji, &reaction.Count); err != nil {
+			return err
+		}
+
+		msg := byID[messageID]
+		msg.Reactions = append(msg.Reactions, reaction)
+	}
+
+	return rows.Err()
+}
@@ -2,13 +2,17 @@ package chat
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/errs"
 	"github.com/solumD/chat-server/internal/model"
 	"github.com/solumD/chat-server/internal/repository"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -27,7 +31,24 @@ const (
 	userIDColumn      = "user_id"
 	messageTextColumn = "message_text"
 	createdAtColumn   = "created_at"
+	editedAtColumn    = "edited_at"
 	isDeletedColumn   = "is_deleted"
+	roleColumn        = "role"
+	lastSeenAtColumn  = "last_seen_at"
+
+	lastReadMessageIDColumn = "last_read_message_id"
+	replyToMessageIDColumn  = "reply_to_message_id"
+
+	messageReceiptsTable = "message_receipts"
+	messageIDColumn      = "message_id"
+	deliveredAtColumn    = "delivered_at"
+	readAtColumn         = "read_at"
+
+	messageReactionsTable = "message_reactions"
+	emojiColumn           = "emoji"
+
+	pinnedMessagesTable = "pinned_messages"
+	pinnedAtColumn      = "pinned_at"
 )
 
 // Структура репо с клиентом базы данных (интерфейсом)
@@ -66,11 +87,19 @@ func (r *repo) CreateChat(ctx context.Context, chat *model.Chat) (int64, error)
 	userIDs = append(userIDs, newIDs...)
 
 	// соотносим всех юзеров с id чата
-	err = r.insertUsersInChats(ctx, chatID, userIDs)
+	_, err = r.insertUsersInChats(ctx, chatID, userIDs)
 	if err != nil {
 		return 0, err
 	}
 
+	// создатель чата становится его владельцем
+	if len(chat.Creator) != 0 {
+		err = r.SetMemberRole(ctx, chatID, chat.Creator, model.RoleOwner)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return chatID, nil
 }
 
@@ -83,7 +112,7 @@ func (r *repo) DeleteChat(ctx context.Context, chatID int64) (*emptypb.Empty, er
 	}
 
 	if !exist {
-		return nil, fmt.Errorf("chat %d doesn't exist", chatID)
+		return nil, errs.NotFound("chat", "chat %d doesn't exist", chatID)
 	}
 
 	// удаляем чат (меняем id_deleted на 1)
@@ -117,7 +146,7 @@ func (r *repo) GetUserChats(ctx context.Context, username string) ([]*model.Chat
 		return nil, err
 	}
 	if !exist {
-		return nil, fmt.Errorf("user %s doesn't exist", username) // юзер не найден
+		return nil, errs.NotFound("user", "user %s doesn't exist", username) // юзер не найден
 	}
 
 	userID, err := r.getUserIDByName(ctx, username)
@@ -131,10 +160,10 @@ func (r *repo) GetUserChats(ctx context.Context, username string) ([]*model.Chat
 	}
 
 	if len(chatIDs) == 0 {
-		return nil, fmt.Errorf("user %s is not a member of any chat", username)
+		return nil, errs.NotFound("chat", "user %s is not a member of any chat", username)
 	}
 
-	chatsInfo, err := r.getChatsInfo(ctx, chatIDs)
+	chatsInfo, err := r.getChatsInfo(ctx, userID, chatIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -150,10 +179,14 @@ func (r *repo) CheckChat(ctx context.Context, chatID int64, username string) err
 	}
 
 	if !exist {
-		return fmt.Errorf("chat %d doesn't exist", chatID)
+		return errs.NotFound("chat", "chat %d doesn't exist", chatID)
 	}
 
+	// несуществующий юзер не состоит ни в одном чате
 	userID, err := r.getUserIDByName(ctx, username)
+	if errs.Is(err, errs.KindNotFound) {
+		return errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+	}
 	if err != nil {
 		return err
 	}
@@ -165,21 +198,22 @@ func (r *repo) CheckChat(ctx context.Context, chatID int64, username string) err
 	}
 
 	if !inChat {
-		return fmt.Errorf("user %v not in chat %d", username, chatID)
+		return errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
 	}
 
 	return nil
 }
 
-// SendMessage отправляет (сохраняет) сообщение пользователя в чат
-func (r *repo) SendMessage(ctx context.Context, message *model.Message) (*emptypb.Empty, error) {
+// SendMessage отправляет (сохраняет) сообщение пользователя в чат и
+// возвращает сохраненное сообщение с его id и временем создания
+func (r *repo) SendMessage(ctx context.Context, message *model.Message) (*model.Message, error) {
 	// проверяем, удален ли чат
 	exist, err := r.isChatExist(ctx, message.ChatID)
 	if err != nil {
 		return nil, err
 	}
 	if !exist {
-		return nil, fmt.Errorf("chat %d doesn't exist", message.ChatID)
+		return nil, errs.NotFound("chat", "chat %d doesn't exist", message.ChatID)
 	}
 
 	// проверяем, существует ли юзер
@@ -188,7 +222,7 @@ func (r *repo) SendMessage(ctx context.Context, message *model.Message) (*emptyp
 		return nil, err
 	}
 	if !exist {
-		return nil, fmt.Errorf("user %s doesn't exist", message.From) // юзер не найден
+		return nil, errs.NotFound("user", "user %s doesn't exist", message.From) // юзер не найден
 	}
 
 	userID, err := r.getUserIDByName(ctx, message.From)
@@ -202,14 +236,30 @@ func (r *repo) SendMessage(ctx context.Context, message *model.Message) (*emptyp
 		return nil, err
 	}
 	if !inChat {
-		return nil, fmt.Errorf("user %v not in chat %d", message.From, message.ChatID)
+		return nil, errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", message.From, message.ChatID)
+	}
+
+	// ответить можно только на сообщение того же чата
+	replyTo := sql.NullInt64{}
+	if message.ReplyToMessageID != 0 {
+		inChat, err = r.isMessageInChat(ctx, message.ReplyToMessageID, message.ChatID)
+		if err != nil {
+			return nil, err
+		}
+		if !inChat {
+			return nil, errs.InvalidArgument("reply_to_message_id", "message %d doesn't exist in chat %d",
+				message.ReplyToMessageID, message.ChatID)
+		}
+
+		replyTo = sql.NullInt64{Int64: message.ReplyToMessageID, Valid: true}
 	}
 
 	// после всех проверок сохраняем сообщение юзера
 	query, args, err := sq.Insert(messagesTable).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatIDColumn, userIDColumn, messageTextColumn).
-		Values(message.ChatID, userID, message.Text).
+		Columns(chatIDColumn, userIDColumn, messageTextColumn, replyToMessageIDColumn).
+		Values(message.ChatID, userID, message.Text, replyTo).
+		Suffix("RETURNING " + idColumn + ", " + createdAtColumn).
 		ToSql()
 
 	if err != nil {
@@ -221,10 +271,931 @@ func (r *repo) SendMessage(ctx context.Context, message *model.Message) (*emptyp
 		QueryRaw: query,
 	}
 
-	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	saved := &model.Message{
+		ChatID:           message.ChatID,
+		From:             message.From,
+		Text:             message.Text,
+		ReplyToMessageID: message.ReplyToMessageID,
+	}
+
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&saved.ID, &saved.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return saved, nil
+}
+
+// GetChatMessages выбирает страницу истории сообщений чата. Если указан AfterID, выбираются
+// ближайшие сообщения после него, иначе - ближайшие до BeforeID (или самые последние).
+// Если указан ReplyToMessageID, выбираются только ответы на это сообщение.
+// Сообщения возвращаются отсортированными по возрастанию id
+func (r *repo) GetChatMessages(ctx context.Context, filter *model.MessagesFilter) ([]*model.Message, error) {
+	builder := selectMessages().
+		Where(sq.Eq{"m." + chatIDColumn: filter.ChatID}).
+		Limit(filter.Limit)
+
+	if filter.ReplyToMessageID > 0 {
+		builder = builder.Where(sq.Eq{"m." + replyToMessageIDColumn: filter.ReplyToMessageID})
+	}
+
+	desc := true
+	switch {
+	case filter.AfterID > 0:
+		desc = false
+		builder = builder.Where(sq.Gt{"m." + idColumn: filter.AfterID}).OrderBy("m." + idColumn + " ASC")
+	case filter.BeforeID > 0:
+		builder = builder.Where(sq.Lt{"m." + idColumn: filter.BeforeID}).OrderBy("m." + idColumn + " DESC")
+	default:
+		builder = builder.OrderBy("m." + idColumn + " DESC")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetChatMessages",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*model.Message{}
+	for rows.Next() {
+		msg, errScan := scanMessage(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+		messages = append(messages, msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// при выборке назад сообщения пришли в обратном порядке
+	if desc {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	err = r.attachReactions(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// GetMessage получает из БД сообщение по id
+func (r *repo) GetMessage(ctx context.Context, messageID int64) (*model.Message, error) {
+	query, args, err := selectMessages().
+		Where(sq.Eq{"m." + idColumn: messageID}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMessage",
+		QueryRaw: query,
+	}
+
+	msg, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errs.NotFound("message", "message %d doesn't exist", messageID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.attachReactions(ctx, []*model.Message{msg})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// AddChatMembers добавляет юзеров в чат. Несуществующие юзеры создаются,
+// а уже состоящие в чате пропускаются. Возвращает имена добавленных юзеров
+func (r *repo) AddChatMembers(ctx context.Context, chatID int64, usernames []string) ([]string, error) {
+	exist, err := r.isChatExist(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	}
+
+	userIDs, newUsers, err := r.divideUsers(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	newIDs, err := r.insertUsers(ctx, newUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	// divideUsers сохраняет порядок имен, поэтому id существующих юзеров
+	// соответствуют именам, не попавшим в новые
+	isNew := make(map[string]struct{}, len(newUsers))
+	for _, name := range newUsers {
+		isNew[name] = struct{}{}
+	}
+
+	names := make(map[int64]string, len(usernames))
+	existing := userIDs
+	for _, name := range usernames {
+		if _, ok := isNew[name]; ok {
+			continue
+		}
+		names[existing[0]] = name
+		existing = existing[1:]
+	}
+	for i, id := range newIDs {
+		names[id] = newUsers[i]
+	}
+
+	userIDs = append(userIDs, newIDs...)
+	if len(userIDs) == 0 {
+		return []string{}, nil
+	}
+
+	insertedIDs, err := r.insertUsersInChats(ctx, chatID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	added := make([]string, 0, len(insertedIDs))
+	for _, id := range insertedIDs {
+		added = append(added, names[id])
+	}
+
+	return added, nil
+}
+
+// RemoveChatMember удаляет юзера из чата. Возвращает false, если юзер в чате не состоял
+func (r *repo) RemoveChatMember(ctx context.Context, chatID int64, username string) (bool, error) {
+	exist, err := r.isChatExist(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+
+	if !exist {
+		return false, errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	}
+
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Delete(usersInChatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Expr(userIDColumn+" IN (?)", userSubquery)).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RemoveChatMember",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RenameChat меняет название чата
+func (r *repo) RenameChat(ctx context.Context, chatID int64, name string) error {
+	exist, err := r.isChatExist(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	}
+
+	query, args, err := sq.Update(chatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(chatNameColumn, name).
+		Where(sq.Eq{idColumn: chatID}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RenameChat",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetMemberRole возвращает роль юзера в чате. Строка участника блокируется до
+// конца транзакции, чтобы роль не изменилась до завершения проверки прав
+func (r *repo) GetMemberRole(ctx context.Context, chatID int64, username string) (model.Role, error) {
+	exist, err := r.isChatExist(ctx, chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exist {
+		return 0, errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	}
+
+	query, args, err := sq.Select("c." + roleColumn).
+		From(usersInChatsTable + " AS c").
+		Join(usersTable + " AS u ON u." + idColumn + " = c." + userIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"c." + chatIDColumn: chatID, "u." + usernameColumn: username}).
+		Suffix("FOR UPDATE OF c").
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMemberRole",
+		QueryRaw: query,
+	}
+
+	var role int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errs.NotFound("member", "user %s not in chat %d", username, chatID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return model.Role(role), nil
+}
+
+// SetMemberRole меняет роль юзера в чате
+func (r *repo) SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) error {
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Update(usersInChatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, int(role)).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Expr(userIDColumn+" IN (?)", userSubquery)).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.SetMemberRole",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errs.NotFound("member", "user %s not in chat %d", username, chatID)
+	}
+
+	return nil
+}
+
+// EditMessage меняет текст сообщения и время его изменения. Возвращает false,
+// если сообщение удалено
+func (r *repo) EditMessage(ctx context.Context, messageID int64, text string) (bool, error) {
+	query, args, err := sq.Update(messagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(messageTextColumn, text).
+		Set(editedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: messageID, isDeletedColumn: 0}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.EditMessage",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteMessage помечает сообщение удаленным. Возвращает false, если сообщение
+// уже было удалено
+func (r *repo) DeleteMessage(ctx context.Context, messageID int64) (bool, error) {
+	query, args, err := sq.Update(messagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(isDeletedColumn, 1).
+		Where(sq.Eq{idColumn: messageID, isDeletedColumn: 0}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.DeleteMessage",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// AddReaction ставит реакцию юзера на сообщение. Возвращает false, если
+// юзер уже поставил эту реакцию
+func (r *repo) AddReaction(ctx context.Context, messageID int64, username string, emoji string) (bool, error) {
+	userSelect := sq.Select().
+		Column(sq.Expr("?::int", messageID)).
+		Column(idColumn).
+		Column(sq.Expr("?::text", emoji)).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Insert(messageReactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, userIDColumn, emojiColumn).
+		Select(userSelect).
+		Suffix("ON CONFLICT (" + messageIDColumn + ", " + userIDColumn + ", " + emojiColumn + ") DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.AddReaction",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RemoveReaction убирает реакцию юзера с сообщения. Возвращает false, если
+// юзер не ставил эту реакцию
+func (r *repo) RemoveReaction(ctx context.Context, messageID int64, username string, emoji string) (bool, error) {
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Delete(messageReactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, emojiColumn: emoji}).
+		Where(sq.Expr(userIDColumn+" IN (?)", userSubquery)).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RemoveReaction",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// PinMessage закрепляет сообщение чата от имени юзера. Возвращает false, если
+// сообщение уже закреплено
+func (r *repo) PinMessage(ctx context.Context, chatID int64, messageID int64, username string) (bool, error) {
+	userSelect := sq.Select().
+		Column(sq.Expr("?::int", messageID)).
+		Column(sq.Expr("?::int", chatID)).
+		Column(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Insert(pinnedMessagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, userIDColumn).
+		Select(userSelect).
+		Suffix("ON CONFLICT (" + messageIDColumn + ") DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.PinMessage",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UnpinMessage открепляет сообщение чата. Возвращает false, если сообщение
+// не было закреплено
+func (r *repo) UnpinMessage(ctx context.Context, chatID int64, messageID int64) (bool, error) {
+	query, args, err := sq.Delete(pinnedMessagesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.UnpinMessage",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// CountPinnedMessages возвращает количество закрепленных неудаленных сообщений
+// чата. Строка чата блокируется до конца транзакции, чтобы параллельные
+// закрепления не превысили ограничение на их количество
+func (r *repo) CountPinnedMessages(ctx context.Context, chatID int64) (int, error) {
+	countSubquery := sq.Select("COUNT(*)").
+		From(pinnedMessagesTable + " AS p").
+		Join(messagesTable + " AS m ON m." + idColumn + " = p." + messageIDColumn).
+		Where("p." + chatIDColumn + " = c." + idColumn).
+		Where(sq.Eq{"m." + isDeletedColumn: 0})
+
+	query, args, err := sq.Select().
+		Column(sq.Expr("(?)", countSubquery)).
+		From(chatsTable + " AS c").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"c." + idColumn: chatID}).
+		Suffix("FOR UPDATE OF c").
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.CountPinnedMessages",
+		QueryRaw: query,
+	}
+
+	var count int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errs.NotFound("chat", "chat %d doesn't exist", chatID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetPinnedMessages возвращает закрепленные неудаленные сообщения чата,
+// начиная с закрепленных последними
+func (r *repo) GetPinnedMessages(ctx context.Context, chatID int64) ([]*model.Message, error) {
+	query, args, err := selectMessages().
+		Join(pinnedMessagesTable+" AS p ON p."+messageIDColumn+" = m."+idColumn).
+		Where(sq.Eq{"p." + chatIDColumn: chatID, "m." + isDeletedColumn: 0}).
+		OrderBy("p."+pinnedAtColumn+" DESC", "m."+idColumn+" DESC").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetPinnedMessages",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*model.Message{}
+	for rows.Next() {
+		msg, errScan := scanMessage(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+		messages = append(messages, msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = r.attachReactions(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// UpdateLastSeen запоминает текущее время как время последнего отключения юзера
+func (r *repo) UpdateLastSeen(ctx context.Context, username string) error {
+	query, args, err := sq.Update(usersTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastSeenAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{usernameColumn: username}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.UpdateLastSeen",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLastSeen выбирает время последнего отключения юзеров. Юзеры, которые
+// не существуют или ни разу не отключались, в результат не попадают
+func (r *repo) GetLastSeen(ctx context.Context, usernames []string) (map[string]time.Time, error) {
+	query, args, err := sq.Select(usernameColumn, lastSeenAtColumn).
+		From(usersTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: usernames}).
+		Where(sq.NotEq{lastSeenAtColumn: nil}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetLastSeen",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lastSeen := make(map[string]time.Time, len(usernames))
+	for rows.Next() {
+		var (
+			username string
+			seenAt   time.Time
+		)
+		if err := rows.Scan(&username, &seenAt); err != nil {
+			return nil, err
+		}
+		lastSeen[username] = seenAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lastSeen, nil
+}
+
+// GetUserChatIDs выбирает id неудаленных чатов, в которых состоит юзер
+func (r *repo) GetUserChatIDs(ctx context.Context, username string) ([]int64, error) {
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Select("uic." + chatIDColumn).
+		From(usersInChatsTable + " AS uic").
+		Join(chatsTable + " AS c ON c." + idColumn + " = uic." + chatIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("uic."+userIDColumn+" IN (?)", userSubquery)).
+		Where(sq.Eq{"c." + isDeletedColumn: 0}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetUserChatIDs",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chatIDs := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		chatIDs = append(chatIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return chatIDs, nil
+}
+
+// MarkRead сдвигает курсор прочтения юзера в чате до messageID. Возвращает
+// false, если юзер уже прочитал это сообщение. Курсор не сдвигается назад
+func (r *repo) MarkRead(ctx context.Context, chatID int64, username string, messageID int64) (bool, error) {
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Update(usersInChatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastReadMessageIDColumn, messageID).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Expr(userIDColumn+" IN (?)", userSubquery)).
+		Where(sq.Lt{lastReadMessageIDColumn: messageID}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.MarkRead",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// CountChatMembers возвращает количество участников чата
+func (r *repo) CountChatMembers(ctx context.Context, chatID int64) (int, error) {
+	query, args, err := sq.Select("COUNT(*)").
+		From(usersInChatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.CountChatMembers",
+		QueryRaw: query,
+	}
+
+	var count int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// AddDeliveries сохраняет доставки сообщений одним запросом. Повторные
+// доставки и доставки несуществующим юзерам пропускаются
+func (r *repo) AddDeliveries(ctx context.Context, deliveries []*model.Delivery) error {
+	messageIDs := make([]int64, 0, len(deliveries))
+	usernames := make([]string, 0, len(deliveries))
+	deliveredAt := make([]time.Time, 0, len(deliveries))
+	for _, d := range deliveries {
+		messageIDs = append(messageIDs, d.MessageID)
+		usernames = append(usernames, d.Username)
+		deliveredAt = append(deliveredAt, d.DeliveredAt)
+	}
+
+	deliveriesSelect := sq.Select("d."+messageIDColumn, "u."+idColumn, "d."+deliveredAtColumn).
+		From(usersTable + " AS u").
+		JoinClause(sq.Expr("JOIN unnest(?::int[], ?::text[], ?::timestamp[]) AS d("+
+			messageIDColumn+", "+usernameColumn+", "+deliveredAtColumn+") ON d."+usernameColumn+" = u."+usernameColumn,
+			messageIDs, usernames, deliveredAt))
+
+	query, args, err := sq.Insert(messageReceiptsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, userIDColumn, deliveredAtColumn).
+		Select(deliveriesSelect).
+		Suffix("ON CONFLICT (" + messageIDColumn + ", " + userIDColumn + ") DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.AddDeliveries",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetReadCursor возвращает id последнего прочитанного юзером сообщения чата
+func (r *repo) GetReadCursor(ctx context.Context, chatID int64, username string) (int64, error) {
+	userSubquery := sq.Select(idColumn).
+		From(usersTable).
+		Where(sq.Eq{usernameColumn: username})
+
+	query, args, err := sq.Select(lastReadMessageIDColumn).
+		From(usersInChatsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Expr(userIDColumn+" IN (?)", userSubquery)).
+		Limit(1).
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetReadCursor",
+		QueryRaw: query,
+	}
+
+	var cursor int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&cursor)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errs.NotFound("member", "user %s not in chat %d", username, chatID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return cursor, nil
+}
+
+// AddReadReceipts отмечает прочитанными юзером сообщения других участников чата
+// с id в диапазоне (afterID, upToID] одним запросом. Прочитанное сообщение
+// считается и доставленным, уже сохраненное время не перезаписывается.
+// readAt передается в UTC, как и время доставки, чтобы оба времени брались
+// с одних часов и были в одной зоне
+func (r *repo) AddReadReceipts(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) error {
+	messagesSelect := sq.Select("m."+idColumn, "u."+idColumn).
+		Column("?::timestamp", readAt).
+		Column("?::timestamp", readAt).
+		From(messagesTable+" AS m").
+		Join(usersTable+" AS u ON u."+usernameColumn+" = ?", username).
+		Where(sq.Eq{"m." + chatIDColumn: chatID}).
+		Where(sq.Gt{"m." + idColumn: afterID}).
+		Where(sq.LtOrEq{"m." + idColumn: upToID}).
+		Where("m." + userIDColumn + " <> u." + idColumn)
+
+	query, args, err := sq.Insert(messageReceiptsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, userIDColumn, deliveredAtColumn, readAtColumn).
+		Select(messagesSelect).
+		Suffix("ON CONFLICT (" + messageIDColumn + ", " + userIDColumn + ") DO UPDATE SET " +
+			readAtColumn + " = COALESCE(" + messageReceiptsTable + "." + readAtColumn + ", EXCLUDED." + readAtColumn + "), " +
+			deliveredAtColumn + " = COALESCE(" + messageReceiptsTable + "." + deliveredAtColumn + ", EXCLUDED." + deliveredAtColumn + ")").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.AddReadReceipts",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetMessageStatus возвращает статус сообщения у каждого участника чата,
+// кроме отправителя, отсортированный по имени участника
+func (r *repo) GetMessageStatus(ctx context.Context, messageID int64) ([]*model.MessageStatus, error) {
+	query, args, err := sq.Select(
+		"u."+usernameColumn,
+		"r."+deliveredAtColumn,
+		"r."+readAtColumn,
+		"uic."+lastReadMessageIDColumn+" >= m."+idColumn,
+	).
+		From(messagesTable + " AS m").
+		Join(usersInChatsTable + " AS uic ON uic." + chatIDColumn + " = m." + chatIDColumn).
+		Join(usersTable + " AS u ON u." + idColumn + " = uic." + userIDColumn).
+		LeftJoin(messageReceiptsTable + " AS r ON r." + messageIDColumn + " = m." + idColumn +
+			" AND r." + userIDColumn + " = uic." + userIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"m." + idColumn: messageID}).
+		Where("uic." + userIDColumn + " <> m." + userIDColumn).
+		OrderBy("u." + usernameColumn).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMessageStatus",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := []*model.MessageStatus{}
+	for rows.Next() {
+		status := &model.MessageStatus{}
+
+		var readByCursor bool
+		err := rows.Scan(&status.Username, &status.DeliveredAt, &status.ReadAt, &readByCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Read = status.ReadAt.Valid || readByCursor
+		status.Delivered = status.DeliveredAt.Valid || status.Read
+		statuses = append(statuses, status)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
 }
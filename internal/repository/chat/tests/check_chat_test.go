@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/repository/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// rowFake строка результата запроса с одним значением
+type rowFake struct {
+	value any
+	err   error
+}
+
+func (r rowFake) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	reflect.ValueOf(dest[0]).Elem().Set(reflect.ValueOf(r.value))
+	return nil
+}
+
+// rowsFake результат запроса из нескольких строк с одним значением.
+// err возвращается после чтения всех строк, как при обрыве соединения
+type rowsFake struct {
+	pgx.Rows
+	values []any
+	err    error
+}
+
+func (r *rowsFake) Next() bool {
+	return len(r.values) != 0
+}
+
+func (r *rowsFake) Scan(dest ...interface{}) error {
+	reflect.ValueOf(dest[0]).Elem().Set(reflect.ValueOf(r.values[0]))
+	r.values = r.values[1:]
+	return nil
+}
+
+func (r *rowsFake) Err() error {
+	return r.err
+}
+
+func (r *rowsFake) Close() {}
+
+// dbFake заглушка БД, которая отвечает на запросы по их имени
+type dbFake struct {
+	db.DB
+	rows    map[string]rowFake
+	results map[string]*rowsFake
+	queries []string
+}
+
+func (d *dbFake) QueryContext(_ context.Context, q db.Query, _ ...interface{}) (pgx.Rows, error) {
+	d.queries = append(d.queries, q.QueryRaw)
+
+	rows, ok := d.results[q.Name]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %s", q.Name)
+	}
+
+	return rows, nil
+}
+
+func (d *dbFake) QueryRowContext(_ context.Context, q db.Query, _ ...interface{}) pgx.Row {
+	row, ok := d.rows[q.Name]
+	if !ok {
+		return rowFake{err: fmt.Errorf("unexpected query %s", q.Name)}
+	}
+
+	return row
+}
+
+// clientFake заглушка клиента БД
+type clientFake struct {
+	db *dbFake
+}
+
+func (c *clientFake) DB() db.DB {
+	return c.db
+}
+
+func (c *clientFake) Close() error {
+	return nil
+}
+
+func TestCheckChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		chatID   = gofakeit.Int64()
+		userID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		chatExists = rowFake{value: 0}
+		userFound  = rowFake{value: userID}
+
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+		dbErr        = fmt.Errorf("db error")
+	)
+
+	tests := []struct {
+		name string
+		rows map[string]rowFake
+		err  error
+	}{
+		{
+			name: "success",
+			rows: map[string]rowFake{
+				"chat_repository.isChatExist":     chatExists,
+				"chat_repository.getUserIDByName": userFound,
+				"chat_repository.isUserInChat":    {value: 1},
+			},
+		},
+		{
+			name: "error user not in chat",
+			rows: map[string]rowFake{
+				"chat_repository.isChatExist":     chatExists,
+				"chat_repository.getUserIDByName": userFound,
+				"chat_repository.isUserInChat":    {err: pgx.ErrNoRows},
+			},
+			err: notMemberErr,
+		},
+		{
+			name: "error user doesn't exist",
+			rows: map[string]rowFake{
+				"chat_repository.isChatExist":     chatExists,
+				"chat_repository.getUserIDByName": {err: pgx.ErrNoRows},
+			},
+			err: notMemberErr,
+		},
+		{
+			name: "error chat is deleted",
+			rows: map[string]rowFake{
+				"chat_repository.isChatExist": {value: 1},
+			},
+			err: errs.NotFound("chat", "chat %d doesn't exist", chatID),
+		},
+		{
+			name: "error from db",
+			rows: map[string]rowFake{
+				"chat_repository.isChatExist":     chatExists,
+				"chat_repository.getUserIDByName": {err: dbErr},
+			},
+			err: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := chat.NewRepository(&clientFake{db: &dbFake{rows: tt.rows}})
+
+			err := repo.CheckChat(ctx, chatID, username)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
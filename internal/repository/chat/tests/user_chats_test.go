@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/repository/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserChatIDs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		username = gofakeit.Username()
+		chatIDs  = []int64{gofakeit.Int64(), gofakeit.Int64()}
+
+		rowsErr = fmt.Errorf("conn closed")
+	)
+
+	tests := []struct {
+		name string
+		rows *rowsFake
+		want []int64
+		err  error
+	}{
+		{
+			name: "success",
+			rows: &rowsFake{values: []any{chatIDs[0], chatIDs[1]}},
+			want: chatIDs,
+		},
+		{
+			name: "error reading rows",
+			rows: &rowsFake{values: []any{chatIDs[0]}, err: rowsErr},
+			err:  rowsErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &dbFake{results: map[string]*rowsFake{"chat_repository.GetUserChatIDs": tt.rows}}
+			repo := chat.NewRepository(&clientFake{db: fake})
+
+			got, err := repo.GetUserChatIDs(ctx, username)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			// удаленные чаты не выбираются
+			require.Len(t, fake.queries, 1)
+			require.Contains(t, fake.queries[0], "c.is_deleted = $2")
+		})
+	}
+}
+
+func TestGetLastSeenRowsError(t *testing.T) {
+	t.Parallel()
+
+	rowsErr := fmt.Errorf("conn closed")
+	repo := chat.NewRepository(&clientFake{db: &dbFake{results: map[string]*rowsFake{
+		"chat_repository.GetLastSeen": {err: rowsErr},
+	}}})
+
+	got, err := repo.GetLastSeen(context.Background(), []string{gofakeit.Username()})
+	require.Equal(t, rowsErr, err)
+	require.Nil(t, got)
+}
+
+func TestGetMessageStatusRowsError(t *testing.T) {
+	t.Parallel()
+
+	rowsErr := fmt.Errorf("conn closed")
+	repo := chat.NewRepository(&clientFake{db: &dbFake{results: map[string]*rowsFake{
+		"chat_repository.GetMessageStatus": {err: rowsErr},
+	}}})
+
+	got, err := repo.GetMessageStatus(context.Background(), gofakeit.Int64())
+	require.Equal(t, rowsErr, err)
+	require.Nil(t, got)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -20,6 +21,34 @@ type ChatRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddChatMembers          func(ctx context.Context, chatID int64, usernames []string) (sa1 []string, err error)
+	funcAddChatMembersOrigin    string
+	inspectFuncAddChatMembers   func(ctx context.Context, chatID int64, usernames []string)
+	afterAddChatMembersCounter  uint64
+	beforeAddChatMembersCounter uint64
+	AddChatMembersMock          mChatRepositoryMockAddChatMembers
+
+	funcAddDeliveries          func(ctx context.Context, deliveries []*model.Delivery) (err error)
+	funcAddDeliveriesOrigin    string
+	inspectFuncAddDeliveries   func(ctx context.Context, deliveries []*model.Delivery)
+	afterAddDeliveriesCounter  uint64
+	beforeAddDeliveriesCounter uint64
+	AddDeliveriesMock          mChatRepositoryMockAddDeliveries
+
+	funcAddReaction          func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, messageID int64, username string, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mChatRepositoryMockAddReaction
+
+	funcAddReadReceipts          func(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) (err error)
+	funcAddReadReceiptsOrigin    string
+	inspectFuncAddReadReceipts   func(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time)
+	afterAddReadReceiptsCounter  uint64
+	beforeAddReadReceiptsCounter uint64
+	AddReadReceiptsMock          mChatRepositoryMockAddReadReceipts
+
 	funcCheckChat          func(ctx context.Context, chatID int64, username string) (err error)
 	funcCheckChatOrigin    string
 	inspectFuncCheckChat   func(ctx context.Context, chatID int64, username string)
@@ -27,6 +56,20 @@ type ChatRepositoryMock struct {
 	beforeCheckChatCounter uint64
 	CheckChatMock          mChatRepositoryMockCheckChat
 
+	funcCountChatMembers          func(ctx context.Context, chatID int64) (i1 int, err error)
+	funcCountChatMembersOrigin    string
+	inspectFuncCountChatMembers   func(ctx context.Context, chatID int64)
+	afterCountChatMembersCounter  uint64
+	beforeCountChatMembersCounter uint64
+	CountChatMembersMock          mChatRepositoryMockCountChatMembers
+
+	funcCountPinnedMessages          func(ctx context.Context, chatID int64) (i1 int, err error)
+	funcCountPinnedMessagesOrigin    string
+	inspectFuncCountPinnedMessages   func(ctx context.Context, chatID int64)
+	afterCountPinnedMessagesCounter  uint64
+	beforeCountPinnedMessagesCounter uint64
+	CountPinnedMessagesMock          mChatRepositoryMockCountPinnedMessages
+
 	funcCreateChat          func(ctx context.Context, chat *model.Chat) (i1 int64, err error)
 	funcCreateChatOrigin    string
 	inspectFuncCreateChat   func(ctx context.Context, chat *model.Chat)
@@ -41,6 +84,76 @@ type ChatRepositoryMock struct {
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatRepositoryMockDeleteChat
 
+	funcDeleteMessage          func(ctx context.Context, messageID int64) (b1 bool, err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, messageID int64)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatRepositoryMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, messageID int64, text string) (b1 bool, err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, messageID int64, text string)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatRepositoryMockEditMessage
+
+	funcGetChatMessages          func(ctx context.Context, filter *model.MessagesFilter) (mpa1 []*model.Message, err error)
+	funcGetChatMessagesOrigin    string
+	inspectFuncGetChatMessages   func(ctx context.Context, filter *model.MessagesFilter)
+	afterGetChatMessagesCounter  uint64
+	beforeGetChatMessagesCounter uint64
+	GetChatMessagesMock          mChatRepositoryMockGetChatMessages
+
+	funcGetLastSeen          func(ctx context.Context, usernames []string) (m1 map[string]time.Time, err error)
+	funcGetLastSeenOrigin    string
+	inspectFuncGetLastSeen   func(ctx context.Context, usernames []string)
+	afterGetLastSeenCounter  uint64
+	beforeGetLastSeenCounter uint64
+	GetLastSeenMock          mChatRepositoryMockGetLastSeen
+
+	funcGetMemberRole          func(ctx context.Context, chatID int64, username string) (r1 model.Role, err error)
+	funcGetMemberRoleOrigin    string
+	inspectFuncGetMemberRole   func(ctx context.Context, chatID int64, username string)
+	afterGetMemberRoleCounter  uint64
+	beforeGetMemberRoleCounter uint64
+	GetMemberRoleMock          mChatRepositoryMockGetMemberRole
+
+	funcGetMessage          func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)
+	funcGetMessageOrigin    string
+	inspectFuncGetMessage   func(ctx context.Context, messageID int64)
+	afterGetMessageCounter  uint64
+	beforeGetMessageCounter uint64
+	GetMessageMock          mChatRepositoryMockGetMessage
+
+	funcGetMessageStatus          func(ctx context.Context, messageID int64) (mpa1 []*model.MessageStatus, err error)
+	funcGetMessageStatusOrigin    string
+	inspectFuncGetMessageStatus   func(ctx context.Context, messageID int64)
+	afterGetMessageStatusCounter  uint64
+	beforeGetMessageStatusCounter uint64
+	GetMessageStatusMock          mChatRepositoryMockGetMessageStatus
+
+	funcGetPinnedMessages          func(ctx context.Context, chatID int64) (mpa1 []*model.Message, err error)
+	funcGetPinnedMessagesOrigin    string
+	inspectFuncGetPinnedMessages   func(ctx context.Context, chatID int64)
+	afterGetPinnedMessagesCounter  uint64
+	beforeGetPinnedMessagesCounter uint64
+	GetPinnedMessagesMock          mChatRepositoryMockGetPinnedMessages
+
+	funcGetReadCursor          func(ctx context.Context, chatID int64, username string) (i1 int64, err error)
+	funcGetReadCursorOrigin    string
+	inspectFuncGetReadCursor   func(ctx context.Context, chatID int64, username string)
+	afterGetReadCursorCounter  uint64
+	beforeGetReadCursorCounter uint64
+	GetReadCursorMock          mChatRepositoryMockGetReadCursor
+
+	funcGetUserChatIDs          func(ctx context.Context, username string) (ia1 []int64, err error)
+	funcGetUserChatIDsOrigin    string
+	inspectFuncGetUserChatIDs   func(ctx context.Context, username string)
+	afterGetUserChatIDsCounter  uint64
+	beforeGetUserChatIDsCounter uint64
+	GetUserChatIDsMock          mChatRepositoryMockGetUserChatIDs
+
 	funcGetUserChats          func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)
 	funcGetUserChatsOrigin    string
 	inspectFuncGetUserChats   func(ctx context.Context, username string)
@@ -48,12 +161,68 @@ type ChatRepositoryMock struct {
 	beforeGetUserChatsCounter uint64
 	GetUserChatsMock          mChatRepositoryMockGetUserChats
 
-	funcSendMessage          func(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error)
+	funcMarkRead          func(ctx context.Context, chatID int64, username string, messageID int64) (b1 bool, err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, chatID int64, username string, messageID int64)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatRepositoryMockMarkRead
+
+	funcPinMessage          func(ctx context.Context, chatID int64, messageID int64, username string) (b1 bool, err error)
+	funcPinMessageOrigin    string
+	inspectFuncPinMessage   func(ctx context.Context, chatID int64, messageID int64, username string)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatRepositoryMockPinMessage
+
+	funcRemoveChatMember          func(ctx context.Context, chatID int64, username string) (b1 bool, err error)
+	funcRemoveChatMemberOrigin    string
+	inspectFuncRemoveChatMember   func(ctx context.Context, chatID int64, username string)
+	afterRemoveChatMemberCounter  uint64
+	beforeRemoveChatMemberCounter uint64
+	RemoveChatMemberMock          mChatRepositoryMockRemoveChatMember
+
+	funcRemoveReaction          func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, messageID int64, username string, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mChatRepositoryMockRemoveReaction
+
+	funcRenameChat          func(ctx context.Context, chatID int64, name string) (err error)
+	funcRenameChatOrigin    string
+	inspectFuncRenameChat   func(ctx context.Context, chatID int64, name string)
+	afterRenameChatCounter  uint64
+	beforeRenameChatCounter uint64
+	RenameChatMock          mChatRepositoryMockRenameChat
+
+	funcSendMessage          func(ctx context.Context, message *model.Message) (mp1 *model.Message, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, message *model.Message)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatRepositoryMockSendMessage
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, username string, role model.Role) (err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, username string, role model.Role)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatRepositoryMockSetMemberRole
+
+	funcUnpinMessage          func(ctx context.Context, chatID int64, messageID int64) (b1 bool, err error)
+	funcUnpinMessageOrigin    string
+	inspectFuncUnpinMessage   func(ctx context.Context, chatID int64, messageID int64)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mChatRepositoryMockUnpinMessage
+
+	funcUpdateLastSeen          func(ctx context.Context, username string) (err error)
+	funcUpdateLastSeenOrigin    string
+	inspectFuncUpdateLastSeen   func(ctx context.Context, username string)
+	afterUpdateLastSeenCounter  uint64
+	beforeUpdateLastSeenCounter uint64
+	UpdateLastSeenMock          mChatRepositoryMockUpdateLastSeen
 }
 
 // NewChatRepositoryMock returns a mock for mm_repository.ChatRepository
@@ -64,75 +233,148 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddChatMembersMock = mChatRepositoryMockAddChatMembers{mock: m}
+	m.AddChatMembersMock.callArgs = []*ChatRepositoryMockAddChatMembersParams{}
+
+	m.AddDeliveriesMock = mChatRepositoryMockAddDeliveries{mock: m}
+	m.AddDeliveriesMock.callArgs = []*ChatRepositoryMockAddDeliveriesParams{}
+
+	m.AddReactionMock = mChatRepositoryMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ChatRepositoryMockAddReactionParams{}
+
+	m.AddReadReceiptsMock = mChatRepositoryMockAddReadReceipts{mock: m}
+	m.AddReadReceiptsMock.callArgs = []*ChatRepositoryMockAddReadReceiptsParams{}
+
 	m.CheckChatMock = mChatRepositoryMockCheckChat{mock: m}
 	m.CheckChatMock.callArgs = []*ChatRepositoryMockCheckChatParams{}
 
+	m.CountChatMembersMock = mChatRepositoryMockCountChatMembers{mock: m}
+	m.CountChatMembersMock.callArgs = []*ChatRepositoryMockCountChatMembersParams{}
+
+	m.CountPinnedMessagesMock = mChatRepositoryMockCountPinnedMessages{mock: m}
+	m.CountPinnedMessagesMock.callArgs = []*ChatRepositoryMockCountPinnedMessagesParams{}
+
 	m.CreateChatMock = mChatRepositoryMockCreateChat{mock: m}
 	m.CreateChatMock.callArgs = []*ChatRepositoryMockCreateChatParams{}
 
 	m.DeleteChatMock = mChatRepositoryMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatRepositoryMockDeleteChatParams{}
 
+	m.DeleteMessageMock = mChatRepositoryMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatRepositoryMockDeleteMessageParams{}
+
+	m.EditMessageMock = mChatRepositoryMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatRepositoryMockEditMessageParams{}
+
+	m.GetChatMessagesMock = mChatRepositoryMockGetChatMessages{mock: m}
+	m.GetChatMessagesMock.callArgs = []*ChatRepositoryMockGetChatMessagesParams{}
+
+	m.GetLastSeenMock = mChatRepositoryMockGetLastSeen{mock: m}
+	m.GetLastSeenMock.callArgs = []*ChatRepositoryMockGetLastSeenParams{}
+
+	m.GetMemberRoleMock = mChatRepositoryMockGetMemberRole{mock: m}
+	m.GetMemberRoleMock.callArgs = []*ChatRepositoryMockGetMemberRoleParams{}
+
+	m.GetMessageMock = mChatRepositoryMockGetMessage{mock: m}
+	m.GetMessageMock.callArgs = []*ChatRepositoryMockGetMessageParams{}
+
+	m.GetMessageStatusMock = mChatRepositoryMockGetMessageStatus{mock: m}
+	m.GetMessageStatusMock.callArgs = []*ChatRepositoryMockGetMessageStatusParams{}
+
+	m.GetPinnedMessagesMock = mChatRepositoryMockGetPinnedMessages{mock: m}
+	m.GetPinnedMessagesMock.callArgs = []*ChatRepositoryMockGetPinnedMessagesParams{}
+
+	m.GetReadCursorMock = mChatRepositoryMockGetReadCursor{mock: m}
+	m.GetReadCursorMock.callArgs = []*ChatRepositoryMockGetReadCursorParams{}
+
+	m.GetUserChatIDsMock = mChatRepositoryMockGetUserChatIDs{mock: m}
+	m.GetUserChatIDsMock.callArgs = []*ChatRepositoryMockGetUserChatIDsParams{}
+
 	m.GetUserChatsMock = mChatRepositoryMockGetUserChats{mock: m}
 	m.GetUserChatsMock.callArgs = []*ChatRepositoryMockGetUserChatsParams{}
 
+	m.MarkReadMock = mChatRepositoryMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatRepositoryMockMarkReadParams{}
+
+	m.PinMessageMock = mChatRepositoryMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatRepositoryMockPinMessageParams{}
+
+	m.RemoveChatMemberMock = mChatRepositoryMockRemoveChatMember{mock: m}
+	m.RemoveChatMemberMock.callArgs = []*ChatRepositoryMockRemoveChatMemberParams{}
+
+	m.RemoveReactionMock = mChatRepositoryMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ChatRepositoryMockRemoveReactionParams{}
+
+	m.RenameChatMock = mChatRepositoryMockRenameChat{mock: m}
+	m.RenameChatMock.callArgs = []*ChatRepositoryMockRenameChatParams{}
+
 	m.SendMessageMock = mChatRepositoryMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatRepositoryMockSendMessageParams{}
 
+	m.SetMemberRoleMock = mChatRepositoryMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatRepositoryMockSetMemberRoleParams{}
+
+	m.UnpinMessageMock = mChatRepositoryMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*ChatRepositoryMockUnpinMessageParams{}
+
+	m.UpdateLastSeenMock = mChatRepositoryMockUpdateLastSeen{mock: m}
+	m.UpdateLastSeenMock.callArgs = []*ChatRepositoryMockUpdateLastSeenParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatRepositoryMockCheckChat struct {
+type mChatRepositoryMockAddChatMembers struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockCheckChatExpectation
-	expectations       []*ChatRepositoryMockCheckChatExpectation
+	defaultExpectation *ChatRepositoryMockAddChatMembersExpectation
+	expectations       []*ChatRepositoryMockAddChatMembersExpectation
 
-	callArgs []*ChatRepositoryMockCheckChatParams
+	callArgs []*ChatRepositoryMockAddChatMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockCheckChatExpectation specifies expectation struct of the ChatRepository.CheckChat
-type ChatRepositoryMockCheckChatExpectation struct {
+// ChatRepositoryMockAddChatMembersExpectation specifies expectation struct of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockCheckChatParams
-	paramPtrs          *ChatRepositoryMockCheckChatParamPtrs
-	expectationOrigins ChatRepositoryMockCheckChatExpectationOrigins
-	results            *ChatRepositoryMockCheckChatResults
+	params             *ChatRepositoryMockAddChatMembersParams
+	paramPtrs          *ChatRepositoryMockAddChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockAddChatMembersExpectationOrigins
+	results            *ChatRepositoryMockAddChatMembersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockCheckChatParams contains parameters of the ChatRepository.CheckChat
-type ChatRepositoryMockCheckChatParams struct {
-	ctx      context.Context
-	chatID   int64
-	username string
+// ChatRepositoryMockAddChatMembersParams contains parameters of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersParams struct {
+	ctx       context.Context
+	chatID    int64
+	usernames []string
 }
 
-// ChatRepositoryMockCheckChatParamPtrs contains pointers to parameters of the ChatRepository.CheckChat
-type ChatRepositoryMockCheckChatParamPtrs struct {
-	ctx      *context.Context
-	chatID   *int64
-	username *string
+// ChatRepositoryMockAddChatMembersParamPtrs contains pointers to parameters of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	usernames *[]string
 }
 
-// ChatRepositoryMockCheckChatResults contains results of the ChatRepository.CheckChat
-type ChatRepositoryMockCheckChatResults struct {
+// ChatRepositoryMockAddChatMembersResults contains results of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersResults struct {
+	sa1 []string
 	err error
 }
 
-// ChatRepositoryMockCheckChatOrigins contains origins of expectations of the ChatRepository.CheckChat
-type ChatRepositoryMockCheckChatExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originChatID   string
-	originUsername string
+// ChatRepositoryMockAddChatMembersOrigins contains origins of expectations of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originUsernames string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -140,370 +382,369 @@ type ChatRepositoryMockCheckChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCheckChat *mChatRepositoryMockCheckChat) Optional() *mChatRepositoryMockCheckChat {
-	mmCheckChat.optional = true
-	return mmCheckChat
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Optional() *mChatRepositoryMockAddChatMembers {
+	mmAddChatMembers.optional = true
+	return mmAddChatMembers
 }
 
-// Expect sets up expected params for ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockCheckChat {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+// Expect sets up expected params for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Expect(ctx context.Context, chatID int64, usernames []string) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCheckChat.defaultExpectation == nil {
-		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCheckChat.defaultExpectation.paramPtrs != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by ExpectParams functions")
+	if mmAddChatMembers.defaultExpectation.paramPtrs != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by ExpectParams functions")
 	}
 
-	mmCheckChat.defaultExpectation.params = &ChatRepositoryMockCheckChatParams{ctx, chatID, username}
-	mmCheckChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCheckChat.expectations {
-		if minimock.Equal(e.params, mmCheckChat.defaultExpectation.params) {
-			mmCheckChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckChat.defaultExpectation.params)
+	mmAddChatMembers.defaultExpectation.params = &ChatRepositoryMockAddChatMembersParams{ctx, chatID, usernames}
+	mmAddChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddChatMembers.expectations {
+		if minimock.Equal(e.params, mmAddChatMembers.defaultExpectation.params) {
+			mmAddChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddChatMembers.defaultExpectation.params)
 		}
 	}
 
-	return mmCheckChat
+	return mmAddChatMembers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCheckChat {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCheckChat.defaultExpectation == nil {
-		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCheckChat.defaultExpectation.params != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmCheckChat.defaultExpectation.paramPtrs == nil {
-		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
 	}
-	mmCheckChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCheckChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCheckChat
+	return mmAddChatMembers
 }
 
-// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockCheckChat {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCheckChat.defaultExpectation == nil {
-		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCheckChat.defaultExpectation.params != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmCheckChat.defaultExpectation.paramPtrs == nil {
-		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
 	}
-	mmCheckChat.defaultExpectation.paramPtrs.chatID = &chatID
-	mmCheckChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmCheckChat
+	return mmAddChatMembers
 }
 
-// ExpectUsernameParam3 sets up expected param username for ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectUsernameParam3(username string) *mChatRepositoryMockCheckChat {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+// ExpectUsernamesParam3 sets up expected param usernames for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectUsernamesParam3(usernames []string) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCheckChat.defaultExpectation == nil {
-		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCheckChat.defaultExpectation.params != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmCheckChat.defaultExpectation.paramPtrs == nil {
-		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
 	}
-	mmCheckChat.defaultExpectation.paramPtrs.username = &username
-	mmCheckChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.usernames = &usernames
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
 
-	return mmCheckChat
+	return mmAddChatMembers
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockCheckChat {
-	if mmCheckChat.mock.inspectFuncCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CheckChat")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Inspect(f func(ctx context.Context, chatID int64, usernames []string)) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddChatMembers")
 	}
 
-	mmCheckChat.mock.inspectFuncCheckChat = f
+	mmAddChatMembers.mock.inspectFuncAddChatMembers = f
 
-	return mmCheckChat
+	return mmAddChatMembers
 }
 
-// Return sets up results that will be returned by ChatRepository.CheckChat
-func (mmCheckChat *mChatRepositoryMockCheckChat) Return(err error) *ChatRepositoryMock {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Return(sa1 []string, err error) *ChatRepositoryMock {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCheckChat.defaultExpectation == nil {
-		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{mock: mmCheckChat.mock}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{mock: mmAddChatMembers.mock}
 	}
-	mmCheckChat.defaultExpectation.results = &ChatRepositoryMockCheckChatResults{err}
-	mmCheckChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCheckChat.mock
+	mmAddChatMembers.defaultExpectation.results = &ChatRepositoryMockAddChatMembersResults{sa1, err}
+	mmAddChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// Set uses given function f to mock the ChatRepository.CheckChat method
-func (mmCheckChat *mChatRepositoryMockCheckChat) Set(f func(ctx context.Context, chatID int64, username string) (err error)) *ChatRepositoryMock {
-	if mmCheckChat.defaultExpectation != nil {
-		mmCheckChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CheckChat method")
+// Set uses given function f to mock the ChatRepository.AddChatMembers method
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Set(f func(ctx context.Context, chatID int64, usernames []string) (sa1 []string, err error)) *ChatRepositoryMock {
+	if mmAddChatMembers.defaultExpectation != nil {
+		mmAddChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddChatMembers method")
 	}
 
-	if len(mmCheckChat.expectations) > 0 {
-		mmCheckChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CheckChat method")
+	if len(mmAddChatMembers.expectations) > 0 {
+		mmAddChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddChatMembers method")
 	}
 
-	mmCheckChat.mock.funcCheckChat = f
-	mmCheckChat.mock.funcCheckChatOrigin = minimock.CallerInfo(1)
-	return mmCheckChat.mock
+	mmAddChatMembers.mock.funcAddChatMembers = f
+	mmAddChatMembers.mock.funcAddChatMembersOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// When sets expectation for the ChatRepository.CheckChat which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.AddChatMembers which will trigger the result defined by the following
 // Then helper
-func (mmCheckChat *mChatRepositoryMockCheckChat) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockCheckChatExpectation {
-	if mmCheckChat.mock.funcCheckChat != nil {
-		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) When(ctx context.Context, chatID int64, usernames []string) *ChatRepositoryMockAddChatMembersExpectation {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockCheckChatExpectation{
-		mock:               mmCheckChat.mock,
-		params:             &ChatRepositoryMockCheckChatParams{ctx, chatID, username},
-		expectationOrigins: ChatRepositoryMockCheckChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockAddChatMembersExpectation{
+		mock:               mmAddChatMembers.mock,
+		params:             &ChatRepositoryMockAddChatMembersParams{ctx, chatID, usernames},
+		expectationOrigins: ChatRepositoryMockAddChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCheckChat.expectations = append(mmCheckChat.expectations, expectation)
+	mmAddChatMembers.expectations = append(mmAddChatMembers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.CheckChat return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockCheckChatExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockCheckChatResults{err}
+// Then sets up ChatRepository.AddChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddChatMembersExpectation) Then(sa1 []string, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddChatMembersResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.CheckChat should be invoked
-func (mmCheckChat *mChatRepositoryMockCheckChat) Times(n uint64) *mChatRepositoryMockCheckChat {
+// Times sets number of times ChatRepository.AddChatMembers should be invoked
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Times(n uint64) *mChatRepositoryMockAddChatMembers {
 	if n == 0 {
-		mmCheckChat.mock.t.Fatalf("Times of ChatRepositoryMock.CheckChat mock can not be zero")
+		mmAddChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.AddChatMembers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCheckChat.expectedInvocations, n)
-	mmCheckChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCheckChat
+	mm_atomic.StoreUint64(&mmAddChatMembers.expectedInvocations, n)
+	mmAddChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers
 }
 
-func (mmCheckChat *mChatRepositoryMockCheckChat) invocationsDone() bool {
-	if len(mmCheckChat.expectations) == 0 && mmCheckChat.defaultExpectation == nil && mmCheckChat.mock.funcCheckChat == nil {
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) invocationsDone() bool {
+	if len(mmAddChatMembers.expectations) == 0 && mmAddChatMembers.defaultExpectation == nil && mmAddChatMembers.mock.funcAddChatMembers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCheckChat.mock.afterCheckChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCheckChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.mock.afterAddChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CheckChat implements mm_repository.ChatRepository
-func (mmCheckChat *ChatRepositoryMock) CheckChat(ctx context.Context, chatID int64, username string) (err error) {
-	mm_atomic.AddUint64(&mmCheckChat.beforeCheckChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCheckChat.afterCheckChatCounter, 1)
+// AddChatMembers implements mm_repository.ChatRepository
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembers(ctx context.Context, chatID int64, usernames []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmAddChatMembers.beforeAddChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddChatMembers.afterAddChatMembersCounter, 1)
 
-	mmCheckChat.t.Helper()
+	mmAddChatMembers.t.Helper()
 
-	if mmCheckChat.inspectFuncCheckChat != nil {
-		mmCheckChat.inspectFuncCheckChat(ctx, chatID, username)
+	if mmAddChatMembers.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.inspectFuncAddChatMembers(ctx, chatID, usernames)
 	}
 
-	mm_params := ChatRepositoryMockCheckChatParams{ctx, chatID, username}
+	mm_params := ChatRepositoryMockAddChatMembersParams{ctx, chatID, usernames}
 
 	// Record call args
-	mmCheckChat.CheckChatMock.mutex.Lock()
-	mmCheckChat.CheckChatMock.callArgs = append(mmCheckChat.CheckChatMock.callArgs, &mm_params)
-	mmCheckChat.CheckChatMock.mutex.Unlock()
+	mmAddChatMembers.AddChatMembersMock.mutex.Lock()
+	mmAddChatMembers.AddChatMembersMock.callArgs = append(mmAddChatMembers.AddChatMembersMock.callArgs, &mm_params)
+	mmAddChatMembers.AddChatMembersMock.mutex.Unlock()
 
-	for _, e := range mmCheckChat.CheckChatMock.expectations {
+	for _, e := range mmAddChatMembers.AddChatMembersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmCheckChat.CheckChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCheckChat.CheckChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCheckChat.CheckChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCheckChat.CheckChatMock.defaultExpectation.paramPtrs
+	if mmAddChatMembers.AddChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddChatMembers.AddChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddChatMembers.AddChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddChatMembers.AddChatMembersMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockCheckChatParams{ctx, chatID, username}
+		mm_got := ChatRepositoryMockAddChatMembersParams{ctx, chatID, usernames}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
-				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCheckChat.CheckChatMock.defaultExpectation.results
+		mm_results := mmAddChatMembers.AddChatMembersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCheckChat.t.Fatal("No results are set for the ChatRepositoryMock.CheckChat")
+			mmAddChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.AddChatMembers")
 		}
-		return (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmCheckChat.funcCheckChat != nil {
-		return mmCheckChat.funcCheckChat(ctx, chatID, username)
+	if mmAddChatMembers.funcAddChatMembers != nil {
+		return mmAddChatMembers.funcAddChatMembers(ctx, chatID, usernames)
 	}
-	mmCheckChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CheckChat. %v %v %v", ctx, chatID, username)
+	mmAddChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.AddChatMembers. %v %v %v", ctx, chatID, usernames)
 	return
 }
 
-// CheckChatAfterCounter returns a count of finished ChatRepositoryMock.CheckChat invocations
-func (mmCheckChat *ChatRepositoryMock) CheckChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckChat.afterCheckChatCounter)
+// AddChatMembersAfterCounter returns a count of finished ChatRepositoryMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.afterAddChatMembersCounter)
 }
 
-// CheckChatBeforeCounter returns a count of ChatRepositoryMock.CheckChat invocations
-func (mmCheckChat *ChatRepositoryMock) CheckChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckChat.beforeCheckChatCounter)
+// AddChatMembersBeforeCounter returns a count of ChatRepositoryMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.beforeAddChatMembersCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.CheckChat.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddChatMembers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCheckChat *mChatRepositoryMockCheckChat) Calls() []*ChatRepositoryMockCheckChatParams {
-	mmCheckChat.mutex.RLock()
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Calls() []*ChatRepositoryMockAddChatMembersParams {
+	mmAddChatMembers.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockCheckChatParams, len(mmCheckChat.callArgs))
-	copy(argCopy, mmCheckChat.callArgs)
+	argCopy := make([]*ChatRepositoryMockAddChatMembersParams, len(mmAddChatMembers.callArgs))
+	copy(argCopy, mmAddChatMembers.callArgs)
 
-	mmCheckChat.mutex.RUnlock()
+	mmAddChatMembers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCheckChatDone returns true if the count of the CheckChat invocations corresponds
+// MinimockAddChatMembersDone returns true if the count of the AddChatMembers invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockCheckChatDone() bool {
-	if m.CheckChatMock.optional {
+func (m *ChatRepositoryMock) MinimockAddChatMembersDone() bool {
+	if m.AddChatMembersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CheckChatMock.expectations {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CheckChatMock.invocationsDone()
+	return m.AddChatMembersMock.invocationsDone()
 }
 
-// MinimockCheckChatInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockCheckChatInspect() {
-	for _, e := range m.CheckChatMock.expectations {
+// MinimockAddChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddChatMembersInspect() {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCheckChatCounter := mm_atomic.LoadUint64(&m.afterCheckChatCounter)
+	afterAddChatMembersCounter := mm_atomic.LoadUint64(&m.afterAddChatMembersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CheckChatMock.defaultExpectation != nil && afterCheckChatCounter < 1 {
-		if m.CheckChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s", m.CheckChatMock.defaultExpectation.returnOrigin)
+	if m.AddChatMembersMock.defaultExpectation != nil && afterAddChatMembersCounter < 1 {
+		if m.AddChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s", m.AddChatMembersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s with params: %#v", m.CheckChatMock.defaultExpectation.expectationOrigins.origin, *m.CheckChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s with params: %#v", m.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddChatMembersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCheckChat != nil && afterCheckChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s", m.funcCheckChatOrigin)
+	if m.funcAddChatMembers != nil && afterAddChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s", m.funcAddChatMembersOrigin)
 	}
 
-	if !m.CheckChatMock.invocationsDone() && afterCheckChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CheckChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CheckChatMock.expectedInvocations), m.CheckChatMock.expectedInvocationsOrigin, afterCheckChatCounter)
+	if !m.AddChatMembersMock.invocationsDone() && afterAddChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddChatMembersMock.expectedInvocations), m.AddChatMembersMock.expectedInvocationsOrigin, afterAddChatMembersCounter)
 	}
 }
 
-type mChatRepositoryMockCreateChat struct {
+type mChatRepositoryMockAddDeliveries struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockCreateChatExpectation
-	expectations       []*ChatRepositoryMockCreateChatExpectation
+	defaultExpectation *ChatRepositoryMockAddDeliveriesExpectation
+	expectations       []*ChatRepositoryMockAddDeliveriesExpectation
 
-	callArgs []*ChatRepositoryMockCreateChatParams
+	callArgs []*ChatRepositoryMockAddDeliveriesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockCreateChatExpectation specifies expectation struct of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatExpectation struct {
+// ChatRepositoryMockAddDeliveriesExpectation specifies expectation struct of the ChatRepository.AddDeliveries
+type ChatRepositoryMockAddDeliveriesExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockCreateChatParams
-	paramPtrs          *ChatRepositoryMockCreateChatParamPtrs
-	expectationOrigins ChatRepositoryMockCreateChatExpectationOrigins
-	results            *ChatRepositoryMockCreateChatResults
+	params             *ChatRepositoryMockAddDeliveriesParams
+	paramPtrs          *ChatRepositoryMockAddDeliveriesParamPtrs
+	expectationOrigins ChatRepositoryMockAddDeliveriesExpectationOrigins
+	results            *ChatRepositoryMockAddDeliveriesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockCreateChatParams contains parameters of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatParams struct {
-	ctx  context.Context
-	chat *model.Chat
+// ChatRepositoryMockAddDeliveriesParams contains parameters of the ChatRepository.AddDeliveries
+type ChatRepositoryMockAddDeliveriesParams struct {
+	ctx        context.Context
+	deliveries []*model.Delivery
 }
 
-// ChatRepositoryMockCreateChatParamPtrs contains pointers to parameters of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatParamPtrs struct {
-	ctx  *context.Context
-	chat **model.Chat
+// ChatRepositoryMockAddDeliveriesParamPtrs contains pointers to parameters of the ChatRepository.AddDeliveries
+type ChatRepositoryMockAddDeliveriesParamPtrs struct {
+	ctx        *context.Context
+	deliveries *[]*model.Delivery
 }
 
-// ChatRepositoryMockCreateChatResults contains results of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatResults struct {
-	i1  int64
+// ChatRepositoryMockAddDeliveriesResults contains results of the ChatRepository.AddDeliveries
+type ChatRepositoryMockAddDeliveriesResults struct {
 	err error
 }
 
-// ChatRepositoryMockCreateChatOrigins contains origins of expectations of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatRepositoryMockAddDeliveriesOrigins contains origins of expectations of the ChatRepository.AddDeliveries
+type ChatRepositoryMockAddDeliveriesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originDeliveries string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -511,342 +752,348 @@ type ChatRepositoryMockCreateChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateChat *mChatRepositoryMockCreateChat) Optional() *mChatRepositoryMockCreateChat {
-	mmCreateChat.optional = true
-	return mmCreateChat
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Optional() *mChatRepositoryMockAddDeliveries {
+	mmAddDeliveries.optional = true
+	return mmAddDeliveries
 }
 
-// Expect sets up expected params for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, chat *model.Chat) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// Expect sets up expected params for ChatRepository.AddDeliveries
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Expect(ctx context.Context, deliveries []*model.Delivery) *mChatRepositoryMockAddDeliveries {
+	if mmAddDeliveries.mock.funcAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddDeliveries.defaultExpectation == nil {
+		mmAddDeliveries.defaultExpectation = &ChatRepositoryMockAddDeliveriesExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by ExpectParams functions")
+	if mmAddDeliveries.defaultExpectation.paramPtrs != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by ExpectParams functions")
 	}
 
-	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, chat}
-	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateChat.expectations {
-		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
-			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+	mmAddDeliveries.defaultExpectation.params = &ChatRepositoryMockAddDeliveriesParams{ctx, deliveries}
+	mmAddDeliveries.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddDeliveries.expectations {
+		if minimock.Equal(e.params, mmAddDeliveries.defaultExpectation.params) {
+			mmAddDeliveries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddDeliveries.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateChat
+	return mmAddDeliveries
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddDeliveries
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddDeliveries {
+	if mmAddDeliveries.mock.funcAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddDeliveries.defaultExpectation == nil {
+		mmAddDeliveries.defaultExpectation = &ChatRepositoryMockAddDeliveriesExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	if mmAddDeliveries.defaultExpectation.params != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	if mmAddDeliveries.defaultExpectation.paramPtrs == nil {
+		mmAddDeliveries.defaultExpectation.paramPtrs = &ChatRepositoryMockAddDeliveriesParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddDeliveries.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddDeliveries.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddDeliveries
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectChatParam2(chat *model.Chat) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// ExpectDeliveriesParam2 sets up expected param deliveries for ChatRepository.AddDeliveries
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) ExpectDeliveriesParam2(deliveries []*model.Delivery) *mChatRepositoryMockAddDeliveries {
+	if mmAddDeliveries.mock.funcAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddDeliveries.defaultExpectation == nil {
+		mmAddDeliveries.defaultExpectation = &ChatRepositoryMockAddDeliveriesExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	if mmAddDeliveries.defaultExpectation.params != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	if mmAddDeliveries.defaultExpectation.paramPtrs == nil {
+		mmAddDeliveries.defaultExpectation.paramPtrs = &ChatRepositoryMockAddDeliveriesParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
-	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmAddDeliveries.defaultExpectation.paramPtrs.deliveries = &deliveries
+	mmAddDeliveries.defaultExpectation.expectationOrigins.originDeliveries = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddDeliveries
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, chat *model.Chat)) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.inspectFuncCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CreateChat")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddDeliveries
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Inspect(f func(ctx context.Context, deliveries []*model.Delivery)) *mChatRepositoryMockAddDeliveries {
+	if mmAddDeliveries.mock.inspectFuncAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddDeliveries")
 	}
 
-	mmCreateChat.mock.inspectFuncCreateChat = f
+	mmAddDeliveries.mock.inspectFuncAddDeliveries = f
 
-	return mmCreateChat
+	return mmAddDeliveries
 }
 
-// Return sets up results that will be returned by ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Return(i1 int64, err error) *ChatRepositoryMock {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.AddDeliveries
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Return(err error) *ChatRepositoryMock {
+	if mmAddDeliveries.mock.funcAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{mock: mmCreateChat.mock}
+	if mmAddDeliveries.defaultExpectation == nil {
+		mmAddDeliveries.defaultExpectation = &ChatRepositoryMockAddDeliveriesExpectation{mock: mmAddDeliveries.mock}
 	}
-	mmCreateChat.defaultExpectation.results = &ChatRepositoryMockCreateChatResults{i1, err}
-	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmAddDeliveries.defaultExpectation.results = &ChatRepositoryMockAddDeliveriesResults{err}
+	mmAddDeliveries.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddDeliveries.mock
 }
 
-// Set uses given function f to mock the ChatRepository.CreateChat method
-func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, chat *model.Chat) (i1 int64, err error)) *ChatRepositoryMock {
-	if mmCreateChat.defaultExpectation != nil {
-		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CreateChat method")
+// Set uses given function f to mock the ChatRepository.AddDeliveries method
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Set(f func(ctx context.Context, deliveries []*model.Delivery) (err error)) *ChatRepositoryMock {
+	if mmAddDeliveries.defaultExpectation != nil {
+		mmAddDeliveries.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddDeliveries method")
 	}
 
-	if len(mmCreateChat.expectations) > 0 {
-		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CreateChat method")
+	if len(mmAddDeliveries.expectations) > 0 {
+		mmAddDeliveries.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddDeliveries method")
 	}
 
-	mmCreateChat.mock.funcCreateChat = f
-	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmAddDeliveries.mock.funcAddDeliveries = f
+	mmAddDeliveries.mock.funcAddDeliveriesOrigin = minimock.CallerInfo(1)
+	return mmAddDeliveries.mock
 }
 
-// When sets expectation for the ChatRepository.CreateChat which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.AddDeliveries which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, chat *model.Chat) *ChatRepositoryMockCreateChatExpectation {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) When(ctx context.Context, deliveries []*model.Delivery) *ChatRepositoryMockAddDeliveriesExpectation {
+	if mmAddDeliveries.mock.funcAddDeliveries != nil {
+		mmAddDeliveries.mock.t.Fatalf("ChatRepositoryMock.AddDeliveries mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockCreateChatExpectation{
-		mock:               mmCreateChat.mock,
-		params:             &ChatRepositoryMockCreateChatParams{ctx, chat},
-		expectationOrigins: ChatRepositoryMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockAddDeliveriesExpectation{
+		mock:               mmAddDeliveries.mock,
+		params:             &ChatRepositoryMockAddDeliveriesParams{ctx, deliveries},
+		expectationOrigins: ChatRepositoryMockAddDeliveriesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	mmAddDeliveries.expectations = append(mmAddDeliveries.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.CreateChat return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockCreateChatExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockCreateChatResults{i1, err}
+// Then sets up ChatRepository.AddDeliveries return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddDeliveriesExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddDeliveriesResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.CreateChat should be invoked
-func (mmCreateChat *mChatRepositoryMockCreateChat) Times(n uint64) *mChatRepositoryMockCreateChat {
+// Times sets number of times ChatRepository.AddDeliveries should be invoked
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Times(n uint64) *mChatRepositoryMockAddDeliveries {
 	if n == 0 {
-		mmCreateChat.mock.t.Fatalf("Times of ChatRepositoryMock.CreateChat mock can not be zero")
+		mmAddDeliveries.mock.t.Fatalf("Times of ChatRepositoryMock.AddDeliveries mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
-	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateChat
+	mm_atomic.StoreUint64(&mmAddDeliveries.expectedInvocations, n)
+	mmAddDeliveries.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddDeliveries
 }
 
-func (mmCreateChat *mChatRepositoryMockCreateChat) invocationsDone() bool {
-	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) invocationsDone() bool {
+	if len(mmAddDeliveries.expectations) == 0 && mmAddDeliveries.defaultExpectation == nil && mmAddDeliveries.mock.funcAddDeliveries == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddDeliveries.mock.afterAddDeliveriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddDeliveries.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateChat implements mm_repository.ChatRepository
-func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, chat *model.Chat) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+// AddDeliveries implements mm_repository.ChatRepository
+func (mmAddDeliveries *ChatRepositoryMock) AddDeliveries(ctx context.Context, deliveries []*model.Delivery) (err error) {
+	mm_atomic.AddUint64(&mmAddDeliveries.beforeAddDeliveriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddDeliveries.afterAddDeliveriesCounter, 1)
 
-	mmCreateChat.t.Helper()
+	mmAddDeliveries.t.Helper()
 
-	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	if mmAddDeliveries.inspectFuncAddDeliveries != nil {
+		mmAddDeliveries.inspectFuncAddDeliveries(ctx, deliveries)
 	}
 
-	mm_params := ChatRepositoryMockCreateChatParams{ctx, chat}
+	mm_params := ChatRepositoryMockAddDeliveriesParams{ctx, deliveries}
 
 	// Record call args
-	mmCreateChat.CreateChatMock.mutex.Lock()
-	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
-	mmCreateChat.CreateChatMock.mutex.Unlock()
+	mmAddDeliveries.AddDeliveriesMock.mutex.Lock()
+	mmAddDeliveries.AddDeliveriesMock.callArgs = append(mmAddDeliveries.AddDeliveriesMock.callArgs, &mm_params)
+	mmAddDeliveries.AddDeliveriesMock.mutex.Unlock()
 
-	for _, e := range mmCreateChat.CreateChatMock.expectations {
+	for _, e := range mmAddDeliveries.AddDeliveriesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+	if mmAddDeliveries.AddDeliveriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddDeliveries.AddDeliveriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddDeliveries.AddDeliveriesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddDeliveries.AddDeliveriesMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockCreateChatParams{ctx, chat}
+		mm_got := ChatRepositoryMockAddDeliveriesParams{ctx, deliveries}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddDeliveries.t.Errorf("ChatRepositoryMock.AddDeliveries got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDeliveries.AddDeliveriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.deliveries != nil && !minimock.Equal(*mm_want_ptrs.deliveries, mm_got.deliveries) {
+				mmAddDeliveries.t.Errorf("ChatRepositoryMock.AddDeliveries got unexpected parameter deliveries, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddDeliveries.AddDeliveriesMock.defaultExpectation.expectationOrigins.originDeliveries, *mm_want_ptrs.deliveries, mm_got.deliveries, minimock.Diff(*mm_want_ptrs.deliveries, mm_got.deliveries))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddDeliveries.t.Errorf("ChatRepositoryMock.AddDeliveries got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddDeliveries.AddDeliveriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		mm_results := mmAddDeliveries.AddDeliveriesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateChat.t.Fatal("No results are set for the ChatRepositoryMock.CreateChat")
+			mmAddDeliveries.t.Fatal("No results are set for the ChatRepositoryMock.AddDeliveries")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, chat)
+	if mmAddDeliveries.funcAddDeliveries != nil {
+		return mmAddDeliveries.funcAddDeliveries(ctx, deliveries)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v", ctx, chat)
+	mmAddDeliveries.t.Fatalf("Unexpected call to ChatRepositoryMock.AddDeliveries. %v %v", ctx, deliveries)
 	return
 }
 
-// CreateChatAfterCounter returns a count of finished ChatRepositoryMock.CreateChat invocations
-func (mmCreateChat *ChatRepositoryMock) CreateChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+// AddDeliveriesAfterCounter returns a count of finished ChatRepositoryMock.AddDeliveries invocations
+func (mmAddDeliveries *ChatRepositoryMock) AddDeliveriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDeliveries.afterAddDeliveriesCounter)
 }
 
-// CreateChatBeforeCounter returns a count of ChatRepositoryMock.CreateChat invocations
-func (mmCreateChat *ChatRepositoryMock) CreateChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+// AddDeliveriesBeforeCounter returns a count of ChatRepositoryMock.AddDeliveries invocations
+func (mmAddDeliveries *ChatRepositoryMock) AddDeliveriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddDeliveries.beforeAddDeliveriesCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.CreateChat.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddDeliveries.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateChat *mChatRepositoryMockCreateChat) Calls() []*ChatRepositoryMockCreateChatParams {
-	mmCreateChat.mutex.RLock()
+func (mmAddDeliveries *mChatRepositoryMockAddDeliveries) Calls() []*ChatRepositoryMockAddDeliveriesParams {
+	mmAddDeliveries.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockCreateChatParams, len(mmCreateChat.callArgs))
-	copy(argCopy, mmCreateChat.callArgs)
+	argCopy := make([]*ChatRepositoryMockAddDeliveriesParams, len(mmAddDeliveries.callArgs))
+	copy(argCopy, mmAddDeliveries.callArgs)
 
-	mmCreateChat.mutex.RUnlock()
+	mmAddDeliveries.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// MinimockAddDeliveriesDone returns true if the count of the AddDeliveries invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockCreateChatDone() bool {
-	if m.CreateChatMock.optional {
+func (m *ChatRepositoryMock) MinimockAddDeliveriesDone() bool {
+	if m.AddDeliveriesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateChatMock.expectations {
+	for _, e := range m.AddDeliveriesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateChatMock.invocationsDone()
+	return m.AddDeliveriesMock.invocationsDone()
 }
 
-// MinimockCreateChatInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockCreateChatInspect() {
-	for _, e := range m.CreateChatMock.expectations {
+// MinimockAddDeliveriesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddDeliveriesInspect() {
+	for _, e := range m.AddDeliveriesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddDeliveries at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	afterAddDeliveriesCounter := mm_atomic.LoadUint64(&m.afterAddDeliveriesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
-		if m.CreateChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+	if m.AddDeliveriesMock.defaultExpectation != nil && afterAddDeliveriesCounter < 1 {
+		if m.AddDeliveriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddDeliveries at\n%s", m.AddDeliveriesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddDeliveries at\n%s with params: %#v", m.AddDeliveriesMock.defaultExpectation.expectationOrigins.origin, *m.AddDeliveriesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	if m.funcAddDeliveries != nil && afterAddDeliveriesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddDeliveries at\n%s", m.funcAddDeliveriesOrigin)
 	}
 
-	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CreateChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	if !m.AddDeliveriesMock.invocationsDone() && afterAddDeliveriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddDeliveries at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddDeliveriesMock.expectedInvocations), m.AddDeliveriesMock.expectedInvocationsOrigin, afterAddDeliveriesCounter)
 	}
 }
 
-type mChatRepositoryMockDeleteChat struct {
+type mChatRepositoryMockAddReaction struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockDeleteChatExpectation
-	expectations       []*ChatRepositoryMockDeleteChatExpectation
+	defaultExpectation *ChatRepositoryMockAddReactionExpectation
+	expectations       []*ChatRepositoryMockAddReactionExpectation
 
-	callArgs []*ChatRepositoryMockDeleteChatParams
+	callArgs []*ChatRepositoryMockAddReactionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockDeleteChatExpectation specifies expectation struct of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatExpectation struct {
+// ChatRepositoryMockAddReactionExpectation specifies expectation struct of the ChatRepository.AddReaction
+type ChatRepositoryMockAddReactionExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockDeleteChatParams
-	paramPtrs          *ChatRepositoryMockDeleteChatParamPtrs
-	expectationOrigins ChatRepositoryMockDeleteChatExpectationOrigins
-	results            *ChatRepositoryMockDeleteChatResults
+	params             *ChatRepositoryMockAddReactionParams
+	paramPtrs          *ChatRepositoryMockAddReactionParamPtrs
+	expectationOrigins ChatRepositoryMockAddReactionExpectationOrigins
+	results            *ChatRepositoryMockAddReactionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockDeleteChatParams contains parameters of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatParams struct {
-	ctx    context.Context
-	chatID int64
+// ChatRepositoryMockAddReactionParams contains parameters of the ChatRepository.AddReaction
+type ChatRepositoryMockAddReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	username  string
+	emoji     string
 }
 
-// ChatRepositoryMockDeleteChatParamPtrs contains pointers to parameters of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatParamPtrs struct {
-	ctx    *context.Context
-	chatID *int64
+// ChatRepositoryMockAddReactionParamPtrs contains pointers to parameters of the ChatRepository.AddReaction
+type ChatRepositoryMockAddReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	username  *string
+	emoji     *string
 }
 
-// ChatRepositoryMockDeleteChatResults contains results of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatResults struct {
-	ep1 *emptypb.Empty
+// ChatRepositoryMockAddReactionResults contains results of the ChatRepository.AddReaction
+type ChatRepositoryMockAddReactionResults struct {
+	b1  bool
 	err error
 }
 
-// ChatRepositoryMockDeleteChatOrigins contains origins of expectations of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originChatID string
+// ChatRepositoryMockAddReactionOrigins contains origins of expectations of the ChatRepository.AddReaction
+type ChatRepositoryMockAddReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUsername  string
+	originEmoji     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -854,342 +1101,9187 @@ type ChatRepositoryMockDeleteChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Optional() *mChatRepositoryMockDeleteChat {
-	mmDeleteChat.optional = true
-	return mmDeleteChat
+func (mmAddReaction *mChatRepositoryMockAddReaction) Optional() *mChatRepositoryMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
 }
 
-// Expect sets up expected params for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// Expect sets up expected params for ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) Expect(ctx context.Context, messageID int64, username string, emoji string) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by ExpectParams functions")
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteChat.defaultExpectation.params = &ChatRepositoryMockDeleteChatParams{ctx, chatID}
-	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteChat.expectations {
-		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
-			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+	mmAddReaction.defaultExpectation.params = &ChatRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteChat
+	return mmAddReaction
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReactionParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmAddReaction
 }
 
-// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReactionParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.chatID = &chatID
-	mmDeleteChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+	mmAddReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmAddReaction
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteChat")
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) ExpectUsernameParam3(username string) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
 	}
 
-	mmDeleteChat.mock.inspectFuncDeleteChat = f
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{}
+	}
 
-	return mmDeleteChat
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.username = &username
+	mmAddReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddReaction
 }
 
-// Return sets up results that will be returned by ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Return(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// ExpectEmojiParam4 sets up expected param emoji for ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) ExpectEmojiParam4(emoji string) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{}
 	}
-	mmDeleteChat.defaultExpectation.results = &ChatRepositoryMockDeleteChatResults{ep1, err}
-	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
-}
 
-// Set uses given function f to mock the ChatRepository.DeleteChat method
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Set(f func(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error)) *ChatRepositoryMock {
-	if mmDeleteChat.defaultExpectation != nil {
-		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteChat method")
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Expect")
 	}
 
-	if len(mmDeleteChat.expectations) > 0 {
-		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteChat method")
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReactionParamPtrs{}
 	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
 
-	mmDeleteChat.mock.funcDeleteChat = f
-	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+	return mmAddReaction
 }
 
-// When sets expectation for the ChatRepository.DeleteChat which will trigger the result defined by the following
-// Then helper
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) When(ctx context.Context, chatID int64) *ChatRepositoryMockDeleteChatExpectation {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) Inspect(f func(ctx context.Context, messageID int64, username string, emoji string)) *mChatRepositoryMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddReaction")
 	}
 
-	expectation := &ChatRepositoryMockDeleteChatExpectation{
-		mock:               mmDeleteChat.mock,
-		params:             &ChatRepositoryMockDeleteChatParams{ctx, chatID},
-		expectationOrigins: ChatRepositoryMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
-	}
-	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by ChatRepository.AddReaction
+func (mmAddReaction *mChatRepositoryMockAddReaction) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatRepositoryMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &ChatRepositoryMockAddReactionResults{b1, err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the ChatRepository.AddReaction method
+func (mmAddReaction *mChatRepositoryMockAddReaction) Set(f func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the ChatRepository.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mChatRepositoryMockAddReaction) When(ctx context.Context, messageID int64, username string, emoji string) *ChatRepositoryMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &ChatRepositoryMockAddReactionParams{ctx, messageID, username, emoji},
+		expectationOrigins: ChatRepositoryMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddReactionExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.AddReaction should be invoked
+func (mmAddReaction *mChatRepositoryMockAddReaction) Times(n uint64) *mChatRepositoryMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of ChatRepositoryMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mChatRepositoryMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements mm_repository.ChatRepository
+func (mmAddReaction *ChatRepositoryMock) AddReaction(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, messageID, username, emoji)
+	}
+
+	mm_params := ChatRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("ChatRepositoryMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddReaction.t.Errorf("ChatRepositoryMock.AddReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddReaction.t.Errorf("ChatRepositoryMock.AddReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("ChatRepositoryMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("ChatRepositoryMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the ChatRepositoryMock.AddReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, messageID, username, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to ChatRepositoryMock.AddReaction. %v %v %v %v", ctx, messageID, username, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished ChatRepositoryMock.AddReaction invocations
+func (mmAddReaction *ChatRepositoryMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of ChatRepositoryMock.AddReaction invocations
+func (mmAddReaction *ChatRepositoryMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mChatRepositoryMockAddReaction) Calls() []*ChatRepositoryMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mChatRepositoryMockAddReadReceipts struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockAddReadReceiptsExpectation
+	expectations       []*ChatRepositoryMockAddReadReceiptsExpectation
+
+	callArgs []*ChatRepositoryMockAddReadReceiptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockAddReadReceiptsExpectation specifies expectation struct of the ChatRepository.AddReadReceipts
+type ChatRepositoryMockAddReadReceiptsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockAddReadReceiptsParams
+	paramPtrs          *ChatRepositoryMockAddReadReceiptsParamPtrs
+	expectationOrigins ChatRepositoryMockAddReadReceiptsExpectationOrigins
+	results            *ChatRepositoryMockAddReadReceiptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockAddReadReceiptsParams contains parameters of the ChatRepository.AddReadReceipts
+type ChatRepositoryMockAddReadReceiptsParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+	afterID  int64
+	upToID   int64
+	readAt   time.Time
+}
+
+// ChatRepositoryMockAddReadReceiptsParamPtrs contains pointers to parameters of the ChatRepository.AddReadReceipts
+type ChatRepositoryMockAddReadReceiptsParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+	afterID  *int64
+	upToID   *int64
+	readAt   *time.Time
+}
+
+// ChatRepositoryMockAddReadReceiptsResults contains results of the ChatRepository.AddReadReceipts
+type ChatRepositoryMockAddReadReceiptsResults struct {
+	err error
+}
+
+// ChatRepositoryMockAddReadReceiptsOrigins contains origins of expectations of the ChatRepository.AddReadReceipts
+type ChatRepositoryMockAddReadReceiptsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+	originAfterID  string
+	originUpToID   string
+	originReadAt   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Optional() *mChatRepositoryMockAddReadReceipts {
+	mmAddReadReceipts.optional = true
+	return mmAddReadReceipts
+}
+
+// Expect sets up expected params for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Expect(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by ExpectParams functions")
+	}
+
+	mmAddReadReceipts.defaultExpectation.params = &ChatRepositoryMockAddReadReceiptsParams{ctx, chatID, username, afterID, upToID, readAt}
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReadReceipts.expectations {
+		if minimock.Equal(e.params, mmAddReadReceipts.defaultExpectation.params) {
+			mmAddReadReceipts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReadReceipts.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReadReceipts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectUsernameParam3(username string) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.username = &username
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// ExpectAfterIDParam4 sets up expected param afterID for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectAfterIDParam4(afterID int64) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.afterID = &afterID
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// ExpectUpToIDParam5 sets up expected param upToID for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectUpToIDParam5(upToID int64) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.upToID = &upToID
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originUpToID = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// ExpectReadAtParam6 sets up expected param readAt for ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) ExpectReadAtParam6(readAt time.Time) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{}
+	}
+
+	if mmAddReadReceipts.defaultExpectation.params != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Expect")
+	}
+
+	if mmAddReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmAddReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockAddReadReceiptsParamPtrs{}
+	}
+	mmAddReadReceipts.defaultExpectation.paramPtrs.readAt = &readAt
+	mmAddReadReceipts.defaultExpectation.expectationOrigins.originReadAt = minimock.CallerInfo(1)
+
+	return mmAddReadReceipts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Inspect(f func(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time)) *mChatRepositoryMockAddReadReceipts {
+	if mmAddReadReceipts.mock.inspectFuncAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddReadReceipts")
+	}
+
+	mmAddReadReceipts.mock.inspectFuncAddReadReceipts = f
+
+	return mmAddReadReceipts
+}
+
+// Return sets up results that will be returned by ChatRepository.AddReadReceipts
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Return(err error) *ChatRepositoryMock {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	if mmAddReadReceipts.defaultExpectation == nil {
+		mmAddReadReceipts.defaultExpectation = &ChatRepositoryMockAddReadReceiptsExpectation{mock: mmAddReadReceipts.mock}
+	}
+	mmAddReadReceipts.defaultExpectation.results = &ChatRepositoryMockAddReadReceiptsResults{err}
+	mmAddReadReceipts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReadReceipts.mock
+}
+
+// Set uses given function f to mock the ChatRepository.AddReadReceipts method
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Set(f func(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) (err error)) *ChatRepositoryMock {
+	if mmAddReadReceipts.defaultExpectation != nil {
+		mmAddReadReceipts.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddReadReceipts method")
+	}
+
+	if len(mmAddReadReceipts.expectations) > 0 {
+		mmAddReadReceipts.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddReadReceipts method")
+	}
+
+	mmAddReadReceipts.mock.funcAddReadReceipts = f
+	mmAddReadReceipts.mock.funcAddReadReceiptsOrigin = minimock.CallerInfo(1)
+	return mmAddReadReceipts.mock
+}
+
+// When sets expectation for the ChatRepository.AddReadReceipts which will trigger the result defined by the following
+// Then helper
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) When(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) *ChatRepositoryMockAddReadReceiptsExpectation {
+	if mmAddReadReceipts.mock.funcAddReadReceipts != nil {
+		mmAddReadReceipts.mock.t.Fatalf("ChatRepositoryMock.AddReadReceipts mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockAddReadReceiptsExpectation{
+		mock:               mmAddReadReceipts.mock,
+		params:             &ChatRepositoryMockAddReadReceiptsParams{ctx, chatID, username, afterID, upToID, readAt},
+		expectationOrigins: ChatRepositoryMockAddReadReceiptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReadReceipts.expectations = append(mmAddReadReceipts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.AddReadReceipts return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddReadReceiptsExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddReadReceiptsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.AddReadReceipts should be invoked
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Times(n uint64) *mChatRepositoryMockAddReadReceipts {
+	if n == 0 {
+		mmAddReadReceipts.mock.t.Fatalf("Times of ChatRepositoryMock.AddReadReceipts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReadReceipts.expectedInvocations, n)
+	mmAddReadReceipts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReadReceipts
+}
+
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) invocationsDone() bool {
+	if len(mmAddReadReceipts.expectations) == 0 && mmAddReadReceipts.defaultExpectation == nil && mmAddReadReceipts.mock.funcAddReadReceipts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReadReceipts.mock.afterAddReadReceiptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReadReceipts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReadReceipts implements mm_repository.ChatRepository
+func (mmAddReadReceipts *ChatRepositoryMock) AddReadReceipts(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmAddReadReceipts.beforeAddReadReceiptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReadReceipts.afterAddReadReceiptsCounter, 1)
+
+	mmAddReadReceipts.t.Helper()
+
+	if mmAddReadReceipts.inspectFuncAddReadReceipts != nil {
+		mmAddReadReceipts.inspectFuncAddReadReceipts(ctx, chatID, username, afterID, upToID, readAt)
+	}
+
+	mm_params := ChatRepositoryMockAddReadReceiptsParams{ctx, chatID, username, afterID, upToID, readAt}
+
+	// Record call args
+	mmAddReadReceipts.AddReadReceiptsMock.mutex.Lock()
+	mmAddReadReceipts.AddReadReceiptsMock.callArgs = append(mmAddReadReceipts.AddReadReceiptsMock.callArgs, &mm_params)
+	mmAddReadReceipts.AddReadReceiptsMock.mutex.Unlock()
+
+	for _, e := range mmAddReadReceipts.AddReadReceiptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockAddReadReceiptsParams{ctx, chatID, username, afterID, upToID, readAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.upToID != nil && !minimock.Equal(*mm_want_ptrs.upToID, mm_got.upToID) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter upToID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originUpToID, *mm_want_ptrs.upToID, mm_got.upToID, minimock.Diff(*mm_want_ptrs.upToID, mm_got.upToID))
+			}
+
+			if mm_want_ptrs.readAt != nil && !minimock.Equal(*mm_want_ptrs.readAt, mm_got.readAt) {
+				mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameter readAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.originReadAt, *mm_want_ptrs.readAt, mm_got.readAt, minimock.Diff(*mm_want_ptrs.readAt, mm_got.readAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReadReceipts.t.Errorf("ChatRepositoryMock.AddReadReceipts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReadReceipts.AddReadReceiptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReadReceipts.t.Fatal("No results are set for the ChatRepositoryMock.AddReadReceipts")
+		}
+		return (*mm_results).err
+	}
+	if mmAddReadReceipts.funcAddReadReceipts != nil {
+		return mmAddReadReceipts.funcAddReadReceipts(ctx, chatID, username, afterID, upToID, readAt)
+	}
+	mmAddReadReceipts.t.Fatalf("Unexpected call to ChatRepositoryMock.AddReadReceipts. %v %v %v %v %v %v", ctx, chatID, username, afterID, upToID, readAt)
+	return
+}
+
+// AddReadReceiptsAfterCounter returns a count of finished ChatRepositoryMock.AddReadReceipts invocations
+func (mmAddReadReceipts *ChatRepositoryMock) AddReadReceiptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReadReceipts.afterAddReadReceiptsCounter)
+}
+
+// AddReadReceiptsBeforeCounter returns a count of ChatRepositoryMock.AddReadReceipts invocations
+func (mmAddReadReceipts *ChatRepositoryMock) AddReadReceiptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReadReceipts.beforeAddReadReceiptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddReadReceipts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReadReceipts *mChatRepositoryMockAddReadReceipts) Calls() []*ChatRepositoryMockAddReadReceiptsParams {
+	mmAddReadReceipts.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockAddReadReceiptsParams, len(mmAddReadReceipts.callArgs))
+	copy(argCopy, mmAddReadReceipts.callArgs)
+
+	mmAddReadReceipts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReadReceiptsDone returns true if the count of the AddReadReceipts invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockAddReadReceiptsDone() bool {
+	if m.AddReadReceiptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReadReceiptsMock.invocationsDone()
+}
+
+// MinimockAddReadReceiptsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddReadReceiptsInspect() {
+	for _, e := range m.AddReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReadReceipts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReadReceiptsCounter := mm_atomic.LoadUint64(&m.afterAddReadReceiptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReadReceiptsMock.defaultExpectation != nil && afterAddReadReceiptsCounter < 1 {
+		if m.AddReadReceiptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReadReceipts at\n%s", m.AddReadReceiptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddReadReceipts at\n%s with params: %#v", m.AddReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *m.AddReadReceiptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReadReceipts != nil && afterAddReadReceiptsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddReadReceipts at\n%s", m.funcAddReadReceiptsOrigin)
+	}
+
+	if !m.AddReadReceiptsMock.invocationsDone() && afterAddReadReceiptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddReadReceipts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReadReceiptsMock.expectedInvocations), m.AddReadReceiptsMock.expectedInvocationsOrigin, afterAddReadReceiptsCounter)
+	}
+}
+
+type mChatRepositoryMockCheckChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCheckChatExpectation
+	expectations       []*ChatRepositoryMockCheckChatExpectation
+
+	callArgs []*ChatRepositoryMockCheckChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCheckChatExpectation specifies expectation struct of the ChatRepository.CheckChat
+type ChatRepositoryMockCheckChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCheckChatParams
+	paramPtrs          *ChatRepositoryMockCheckChatParamPtrs
+	expectationOrigins ChatRepositoryMockCheckChatExpectationOrigins
+	results            *ChatRepositoryMockCheckChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCheckChatParams contains parameters of the ChatRepository.CheckChat
+type ChatRepositoryMockCheckChatParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockCheckChatParamPtrs contains pointers to parameters of the ChatRepository.CheckChat
+type ChatRepositoryMockCheckChatParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockCheckChatResults contains results of the ChatRepository.CheckChat
+type ChatRepositoryMockCheckChatResults struct {
+	err error
+}
+
+// ChatRepositoryMockCheckChatOrigins contains origins of expectations of the ChatRepository.CheckChat
+type ChatRepositoryMockCheckChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckChat *mChatRepositoryMockCheckChat) Optional() *mChatRepositoryMockCheckChat {
+	mmCheckChat.optional = true
+	return mmCheckChat
+}
+
+// Expect sets up expected params for ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockCheckChat {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	if mmCheckChat.defaultExpectation == nil {
+		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	}
+
+	if mmCheckChat.defaultExpectation.paramPtrs != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by ExpectParams functions")
+	}
+
+	mmCheckChat.defaultExpectation.params = &ChatRepositoryMockCheckChatParams{ctx, chatID, username}
+	mmCheckChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckChat.expectations {
+		if minimock.Equal(e.params, mmCheckChat.defaultExpectation.params) {
+			mmCheckChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCheckChat {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	if mmCheckChat.defaultExpectation == nil {
+		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	}
+
+	if mmCheckChat.defaultExpectation.params != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	}
+
+	if mmCheckChat.defaultExpectation.paramPtrs == nil {
+		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	}
+	mmCheckChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockCheckChat {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	if mmCheckChat.defaultExpectation == nil {
+		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	}
+
+	if mmCheckChat.defaultExpectation.params != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	}
+
+	if mmCheckChat.defaultExpectation.paramPtrs == nil {
+		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	}
+	mmCheckChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCheckChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCheckChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) ExpectUsernameParam3(username string) *mChatRepositoryMockCheckChat {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	if mmCheckChat.defaultExpectation == nil {
+		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{}
+	}
+
+	if mmCheckChat.defaultExpectation.params != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Expect")
+	}
+
+	if mmCheckChat.defaultExpectation.paramPtrs == nil {
+		mmCheckChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCheckChatParamPtrs{}
+	}
+	mmCheckChat.defaultExpectation.paramPtrs.username = &username
+	mmCheckChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCheckChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockCheckChat {
+	if mmCheckChat.mock.inspectFuncCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CheckChat")
+	}
+
+	mmCheckChat.mock.inspectFuncCheckChat = f
+
+	return mmCheckChat
+}
+
+// Return sets up results that will be returned by ChatRepository.CheckChat
+func (mmCheckChat *mChatRepositoryMockCheckChat) Return(err error) *ChatRepositoryMock {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	if mmCheckChat.defaultExpectation == nil {
+		mmCheckChat.defaultExpectation = &ChatRepositoryMockCheckChatExpectation{mock: mmCheckChat.mock}
+	}
+	mmCheckChat.defaultExpectation.results = &ChatRepositoryMockCheckChatResults{err}
+	mmCheckChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CheckChat method
+func (mmCheckChat *mChatRepositoryMockCheckChat) Set(f func(ctx context.Context, chatID int64, username string) (err error)) *ChatRepositoryMock {
+	if mmCheckChat.defaultExpectation != nil {
+		mmCheckChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CheckChat method")
+	}
+
+	if len(mmCheckChat.expectations) > 0 {
+		mmCheckChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CheckChat method")
+	}
+
+	mmCheckChat.mock.funcCheckChat = f
+	mmCheckChat.mock.funcCheckChatOrigin = minimock.CallerInfo(1)
+	return mmCheckChat.mock
+}
+
+// When sets expectation for the ChatRepository.CheckChat which will trigger the result defined by the following
+// Then helper
+func (mmCheckChat *mChatRepositoryMockCheckChat) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockCheckChatExpectation {
+	if mmCheckChat.mock.funcCheckChat != nil {
+		mmCheckChat.mock.t.Fatalf("ChatRepositoryMock.CheckChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCheckChatExpectation{
+		mock:               mmCheckChat.mock,
+		params:             &ChatRepositoryMockCheckChatParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockCheckChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckChat.expectations = append(mmCheckChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CheckChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCheckChatExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCheckChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CheckChat should be invoked
+func (mmCheckChat *mChatRepositoryMockCheckChat) Times(n uint64) *mChatRepositoryMockCheckChat {
+	if n == 0 {
+		mmCheckChat.mock.t.Fatalf("Times of ChatRepositoryMock.CheckChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckChat.expectedInvocations, n)
+	mmCheckChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckChat
+}
+
+func (mmCheckChat *mChatRepositoryMockCheckChat) invocationsDone() bool {
+	if len(mmCheckChat.expectations) == 0 && mmCheckChat.defaultExpectation == nil && mmCheckChat.mock.funcCheckChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckChat.mock.afterCheckChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckChat implements mm_repository.ChatRepository
+func (mmCheckChat *ChatRepositoryMock) CheckChat(ctx context.Context, chatID int64, username string) (err error) {
+	mm_atomic.AddUint64(&mmCheckChat.beforeCheckChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckChat.afterCheckChatCounter, 1)
+
+	mmCheckChat.t.Helper()
+
+	if mmCheckChat.inspectFuncCheckChat != nil {
+		mmCheckChat.inspectFuncCheckChat(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockCheckChatParams{ctx, chatID, username}
+
+	// Record call args
+	mmCheckChat.CheckChatMock.mutex.Lock()
+	mmCheckChat.CheckChatMock.callArgs = append(mmCheckChat.CheckChatMock.callArgs, &mm_params)
+	mmCheckChat.CheckChatMock.mutex.Unlock()
+
+	for _, e := range mmCheckChat.CheckChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckChat.CheckChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckChat.CheckChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckChat.CheckChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckChat.CheckChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCheckChatParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckChat.t.Errorf("ChatRepositoryMock.CheckChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckChat.CheckChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckChat.CheckChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckChat.t.Fatal("No results are set for the ChatRepositoryMock.CheckChat")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckChat.funcCheckChat != nil {
+		return mmCheckChat.funcCheckChat(ctx, chatID, username)
+	}
+	mmCheckChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CheckChat. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// CheckChatAfterCounter returns a count of finished ChatRepositoryMock.CheckChat invocations
+func (mmCheckChat *ChatRepositoryMock) CheckChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckChat.afterCheckChatCounter)
+}
+
+// CheckChatBeforeCounter returns a count of ChatRepositoryMock.CheckChat invocations
+func (mmCheckChat *ChatRepositoryMock) CheckChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckChat.beforeCheckChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CheckChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckChat *mChatRepositoryMockCheckChat) Calls() []*ChatRepositoryMockCheckChatParams {
+	mmCheckChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCheckChatParams, len(mmCheckChat.callArgs))
+	copy(argCopy, mmCheckChat.callArgs)
+
+	mmCheckChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckChatDone returns true if the count of the CheckChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCheckChatDone() bool {
+	if m.CheckChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckChatMock.invocationsDone()
+}
+
+// MinimockCheckChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCheckChatInspect() {
+	for _, e := range m.CheckChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckChatCounter := mm_atomic.LoadUint64(&m.afterCheckChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckChatMock.defaultExpectation != nil && afterCheckChatCounter < 1 {
+		if m.CheckChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s", m.CheckChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s with params: %#v", m.CheckChatMock.defaultExpectation.expectationOrigins.origin, *m.CheckChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckChat != nil && afterCheckChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CheckChat at\n%s", m.funcCheckChatOrigin)
+	}
+
+	if !m.CheckChatMock.invocationsDone() && afterCheckChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CheckChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckChatMock.expectedInvocations), m.CheckChatMock.expectedInvocationsOrigin, afterCheckChatCounter)
+	}
+}
+
+type mChatRepositoryMockCountChatMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCountChatMembersExpectation
+	expectations       []*ChatRepositoryMockCountChatMembersExpectation
+
+	callArgs []*ChatRepositoryMockCountChatMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCountChatMembersExpectation specifies expectation struct of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCountChatMembersParams
+	paramPtrs          *ChatRepositoryMockCountChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockCountChatMembersExpectationOrigins
+	results            *ChatRepositoryMockCountChatMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCountChatMembersParams contains parameters of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockCountChatMembersParamPtrs contains pointers to parameters of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockCountChatMembersResults contains results of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersResults struct {
+	i1  int
+	err error
+}
+
+// ChatRepositoryMockCountChatMembersOrigins contains origins of expectations of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Optional() *mChatRepositoryMockCountChatMembers {
+	mmCountChatMembers.optional = true
+	return mmCountChatMembers
+}
+
+// Expect sets up expected params for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
+	}
+
+	if mmCountChatMembers.defaultExpectation.paramPtrs != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by ExpectParams functions")
+	}
+
+	mmCountChatMembers.defaultExpectation.params = &ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+	mmCountChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountChatMembers.expectations {
+		if minimock.Equal(e.params, mmCountChatMembers.defaultExpectation.params) {
+			mmCountChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountChatMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmCountChatMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
+	}
+
+	if mmCountChatMembers.defaultExpectation.params != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Expect")
+	}
+
+	if mmCountChatMembers.defaultExpectation.paramPtrs == nil {
+		mmCountChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockCountChatMembersParamPtrs{}
+	}
+	mmCountChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountChatMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
+	}
+
+	if mmCountChatMembers.defaultExpectation.params != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Expect")
+	}
+
+	if mmCountChatMembers.defaultExpectation.paramPtrs == nil {
+		mmCountChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockCountChatMembersParamPtrs{}
+	}
+	mmCountChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCountChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCountChatMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.inspectFuncCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CountChatMembers")
+	}
+
+	mmCountChatMembers.mock.inspectFuncCountChatMembers = f
+
+	return mmCountChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Return(i1 int, err error) *ChatRepositoryMock {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{mock: mmCountChatMembers.mock}
+	}
+	mmCountChatMembers.defaultExpectation.results = &ChatRepositoryMockCountChatMembersResults{i1, err}
+	mmCountChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CountChatMembers method
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Set(f func(ctx context.Context, chatID int64) (i1 int, err error)) *ChatRepositoryMock {
+	if mmCountChatMembers.defaultExpectation != nil {
+		mmCountChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CountChatMembers method")
+	}
+
+	if len(mmCountChatMembers.expectations) > 0 {
+		mmCountChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CountChatMembers method")
+	}
+
+	mmCountChatMembers.mock.funcCountChatMembers = f
+	mmCountChatMembers.mock.funcCountChatMembersOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers.mock
+}
+
+// When sets expectation for the ChatRepository.CountChatMembers which will trigger the result defined by the following
+// Then helper
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) When(ctx context.Context, chatID int64) *ChatRepositoryMockCountChatMembersExpectation {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCountChatMembersExpectation{
+		mock:               mmCountChatMembers.mock,
+		params:             &ChatRepositoryMockCountChatMembersParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockCountChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountChatMembers.expectations = append(mmCountChatMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CountChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCountChatMembersExpectation) Then(i1 int, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCountChatMembersResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CountChatMembers should be invoked
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Times(n uint64) *mChatRepositoryMockCountChatMembers {
+	if n == 0 {
+		mmCountChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.CountChatMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountChatMembers.expectedInvocations, n)
+	mmCountChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers
+}
+
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) invocationsDone() bool {
+	if len(mmCountChatMembers.expectations) == 0 && mmCountChatMembers.defaultExpectation == nil && mmCountChatMembers.mock.funcCountChatMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountChatMembers.mock.afterCountChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountChatMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountChatMembers implements mm_repository.ChatRepository
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembers(ctx context.Context, chatID int64) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmCountChatMembers.beforeCountChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountChatMembers.afterCountChatMembersCounter, 1)
+
+	mmCountChatMembers.t.Helper()
+
+	if mmCountChatMembers.inspectFuncCountChatMembers != nil {
+		mmCountChatMembers.inspectFuncCountChatMembers(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+
+	// Record call args
+	mmCountChatMembers.CountChatMembersMock.mutex.Lock()
+	mmCountChatMembers.CountChatMembersMock.callArgs = append(mmCountChatMembers.CountChatMembersMock.callArgs, &mm_params)
+	mmCountChatMembers.CountChatMembersMock.mutex.Unlock()
+
+	for _, e := range mmCountChatMembers.CountChatMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountChatMembers.CountChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountChatMembers.CountChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountChatMembers.CountChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmCountChatMembers.CountChatMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountChatMembers.CountChatMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.CountChatMembers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountChatMembers.funcCountChatMembers != nil {
+		return mmCountChatMembers.funcCountChatMembers(ctx, chatID)
+	}
+	mmCountChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.CountChatMembers. %v %v", ctx, chatID)
+	return
+}
+
+// CountChatMembersAfterCounter returns a count of finished ChatRepositoryMock.CountChatMembers invocations
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountChatMembers.afterCountChatMembersCounter)
+}
+
+// CountChatMembersBeforeCounter returns a count of ChatRepositoryMock.CountChatMembers invocations
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountChatMembers.beforeCountChatMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CountChatMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Calls() []*ChatRepositoryMockCountChatMembersParams {
+	mmCountChatMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCountChatMembersParams, len(mmCountChatMembers.callArgs))
+	copy(argCopy, mmCountChatMembers.callArgs)
+
+	mmCountChatMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountChatMembersDone returns true if the count of the CountChatMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCountChatMembersDone() bool {
+	if m.CountChatMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountChatMembersMock.invocationsDone()
+}
+
+// MinimockCountChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCountChatMembersInspect() {
+	for _, e := range m.CountChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountChatMembersCounter := mm_atomic.LoadUint64(&m.afterCountChatMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountChatMembersMock.defaultExpectation != nil && afterCountChatMembersCounter < 1 {
+		if m.CountChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s", m.CountChatMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s with params: %#v", m.CountChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.CountChatMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountChatMembers != nil && afterCountChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s", m.funcCountChatMembersOrigin)
+	}
+
+	if !m.CountChatMembersMock.invocationsDone() && afterCountChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CountChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountChatMembersMock.expectedInvocations), m.CountChatMembersMock.expectedInvocationsOrigin, afterCountChatMembersCounter)
+	}
+}
+
+type mChatRepositoryMockCountPinnedMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCountPinnedMessagesExpectation
+	expectations       []*ChatRepositoryMockCountPinnedMessagesExpectation
+
+	callArgs []*ChatRepositoryMockCountPinnedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCountPinnedMessagesExpectation specifies expectation struct of the ChatRepository.CountPinnedMessages
+type ChatRepositoryMockCountPinnedMessagesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCountPinnedMessagesParams
+	paramPtrs          *ChatRepositoryMockCountPinnedMessagesParamPtrs
+	expectationOrigins ChatRepositoryMockCountPinnedMessagesExpectationOrigins
+	results            *ChatRepositoryMockCountPinnedMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCountPinnedMessagesParams contains parameters of the ChatRepository.CountPinnedMessages
+type ChatRepositoryMockCountPinnedMessagesParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockCountPinnedMessagesParamPtrs contains pointers to parameters of the ChatRepository.CountPinnedMessages
+type ChatRepositoryMockCountPinnedMessagesParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockCountPinnedMessagesResults contains results of the ChatRepository.CountPinnedMessages
+type ChatRepositoryMockCountPinnedMessagesResults struct {
+	i1  int
+	err error
+}
+
+// ChatRepositoryMockCountPinnedMessagesOrigins contains origins of expectations of the ChatRepository.CountPinnedMessages
+type ChatRepositoryMockCountPinnedMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Optional() *mChatRepositoryMockCountPinnedMessages {
+	mmCountPinnedMessages.optional = true
+	return mmCountPinnedMessages
+}
+
+// Expect sets up expected params for ChatRepository.CountPinnedMessages
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockCountPinnedMessages {
+	if mmCountPinnedMessages.mock.funcCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Set")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation == nil {
+		mmCountPinnedMessages.defaultExpectation = &ChatRepositoryMockCountPinnedMessagesExpectation{}
+	}
+
+	if mmCountPinnedMessages.defaultExpectation.paramPtrs != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmCountPinnedMessages.defaultExpectation.params = &ChatRepositoryMockCountPinnedMessagesParams{ctx, chatID}
+	mmCountPinnedMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountPinnedMessages.expectations {
+		if minimock.Equal(e.params, mmCountPinnedMessages.defaultExpectation.params) {
+			mmCountPinnedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountPinnedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmCountPinnedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CountPinnedMessages
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCountPinnedMessages {
+	if mmCountPinnedMessages.mock.funcCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Set")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation == nil {
+		mmCountPinnedMessages.defaultExpectation = &ChatRepositoryMockCountPinnedMessagesExpectation{}
+	}
+
+	if mmCountPinnedMessages.defaultExpectation.params != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Expect")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmCountPinnedMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockCountPinnedMessagesParamPtrs{}
+	}
+	mmCountPinnedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountPinnedMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountPinnedMessages
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.CountPinnedMessages
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockCountPinnedMessages {
+	if mmCountPinnedMessages.mock.funcCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Set")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation == nil {
+		mmCountPinnedMessages.defaultExpectation = &ChatRepositoryMockCountPinnedMessagesExpectation{}
+	}
+
+	if mmCountPinnedMessages.defaultExpectation.params != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Expect")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmCountPinnedMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockCountPinnedMessagesParamPtrs{}
+	}
+	mmCountPinnedMessages.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCountPinnedMessages.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCountPinnedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CountPinnedMessages
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockCountPinnedMessages {
+	if mmCountPinnedMessages.mock.inspectFuncCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CountPinnedMessages")
+	}
+
+	mmCountPinnedMessages.mock.inspectFuncCountPinnedMessages = f
+
+	return mmCountPinnedMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.CountPinnedMessages
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Return(i1 int, err error) *ChatRepositoryMock {
+	if mmCountPinnedMessages.mock.funcCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Set")
+	}
+
+	if mmCountPinnedMessages.defaultExpectation == nil {
+		mmCountPinnedMessages.defaultExpectation = &ChatRepositoryMockCountPinnedMessagesExpectation{mock: mmCountPinnedMessages.mock}
+	}
+	mmCountPinnedMessages.defaultExpectation.results = &ChatRepositoryMockCountPinnedMessagesResults{i1, err}
+	mmCountPinnedMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountPinnedMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CountPinnedMessages method
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Set(f func(ctx context.Context, chatID int64) (i1 int, err error)) *ChatRepositoryMock {
+	if mmCountPinnedMessages.defaultExpectation != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CountPinnedMessages method")
+	}
+
+	if len(mmCountPinnedMessages.expectations) > 0 {
+		mmCountPinnedMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CountPinnedMessages method")
+	}
+
+	mmCountPinnedMessages.mock.funcCountPinnedMessages = f
+	mmCountPinnedMessages.mock.funcCountPinnedMessagesOrigin = minimock.CallerInfo(1)
+	return mmCountPinnedMessages.mock
+}
+
+// When sets expectation for the ChatRepository.CountPinnedMessages which will trigger the result defined by the following
+// Then helper
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) When(ctx context.Context, chatID int64) *ChatRepositoryMockCountPinnedMessagesExpectation {
+	if mmCountPinnedMessages.mock.funcCountPinnedMessages != nil {
+		mmCountPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.CountPinnedMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCountPinnedMessagesExpectation{
+		mock:               mmCountPinnedMessages.mock,
+		params:             &ChatRepositoryMockCountPinnedMessagesParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockCountPinnedMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountPinnedMessages.expectations = append(mmCountPinnedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CountPinnedMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCountPinnedMessagesExpectation) Then(i1 int, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCountPinnedMessagesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CountPinnedMessages should be invoked
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Times(n uint64) *mChatRepositoryMockCountPinnedMessages {
+	if n == 0 {
+		mmCountPinnedMessages.mock.t.Fatalf("Times of ChatRepositoryMock.CountPinnedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountPinnedMessages.expectedInvocations, n)
+	mmCountPinnedMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountPinnedMessages
+}
+
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) invocationsDone() bool {
+	if len(mmCountPinnedMessages.expectations) == 0 && mmCountPinnedMessages.defaultExpectation == nil && mmCountPinnedMessages.mock.funcCountPinnedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountPinnedMessages.mock.afterCountPinnedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountPinnedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountPinnedMessages implements mm_repository.ChatRepository
+func (mmCountPinnedMessages *ChatRepositoryMock) CountPinnedMessages(ctx context.Context, chatID int64) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmCountPinnedMessages.beforeCountPinnedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountPinnedMessages.afterCountPinnedMessagesCounter, 1)
+
+	mmCountPinnedMessages.t.Helper()
+
+	if mmCountPinnedMessages.inspectFuncCountPinnedMessages != nil {
+		mmCountPinnedMessages.inspectFuncCountPinnedMessages(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockCountPinnedMessagesParams{ctx, chatID}
+
+	// Record call args
+	mmCountPinnedMessages.CountPinnedMessagesMock.mutex.Lock()
+	mmCountPinnedMessages.CountPinnedMessagesMock.callArgs = append(mmCountPinnedMessages.CountPinnedMessagesMock.callArgs, &mm_params)
+	mmCountPinnedMessages.CountPinnedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmCountPinnedMessages.CountPinnedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCountPinnedMessagesParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountPinnedMessages.t.Errorf("ChatRepositoryMock.CountPinnedMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCountPinnedMessages.t.Errorf("ChatRepositoryMock.CountPinnedMessages got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountPinnedMessages.t.Errorf("ChatRepositoryMock.CountPinnedMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountPinnedMessages.CountPinnedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountPinnedMessages.t.Fatal("No results are set for the ChatRepositoryMock.CountPinnedMessages")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountPinnedMessages.funcCountPinnedMessages != nil {
+		return mmCountPinnedMessages.funcCountPinnedMessages(ctx, chatID)
+	}
+	mmCountPinnedMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.CountPinnedMessages. %v %v", ctx, chatID)
+	return
+}
+
+// CountPinnedMessagesAfterCounter returns a count of finished ChatRepositoryMock.CountPinnedMessages invocations
+func (mmCountPinnedMessages *ChatRepositoryMock) CountPinnedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPinnedMessages.afterCountPinnedMessagesCounter)
+}
+
+// CountPinnedMessagesBeforeCounter returns a count of ChatRepositoryMock.CountPinnedMessages invocations
+func (mmCountPinnedMessages *ChatRepositoryMock) CountPinnedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPinnedMessages.beforeCountPinnedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CountPinnedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountPinnedMessages *mChatRepositoryMockCountPinnedMessages) Calls() []*ChatRepositoryMockCountPinnedMessagesParams {
+	mmCountPinnedMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCountPinnedMessagesParams, len(mmCountPinnedMessages.callArgs))
+	copy(argCopy, mmCountPinnedMessages.callArgs)
+
+	mmCountPinnedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountPinnedMessagesDone returns true if the count of the CountPinnedMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCountPinnedMessagesDone() bool {
+	if m.CountPinnedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountPinnedMessagesMock.invocationsDone()
+}
+
+// MinimockCountPinnedMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCountPinnedMessagesInspect() {
+	for _, e := range m.CountPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountPinnedMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountPinnedMessagesCounter := mm_atomic.LoadUint64(&m.afterCountPinnedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountPinnedMessagesMock.defaultExpectation != nil && afterCountPinnedMessagesCounter < 1 {
+		if m.CountPinnedMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountPinnedMessages at\n%s", m.CountPinnedMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountPinnedMessages at\n%s with params: %#v", m.CountPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *m.CountPinnedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountPinnedMessages != nil && afterCountPinnedMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CountPinnedMessages at\n%s", m.funcCountPinnedMessagesOrigin)
+	}
+
+	if !m.CountPinnedMessagesMock.invocationsDone() && afterCountPinnedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CountPinnedMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountPinnedMessagesMock.expectedInvocations), m.CountPinnedMessagesMock.expectedInvocationsOrigin, afterCountPinnedMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockCreateChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCreateChatExpectation
+	expectations       []*ChatRepositoryMockCreateChatExpectation
+
+	callArgs []*ChatRepositoryMockCreateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCreateChatExpectation specifies expectation struct of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCreateChatParams
+	paramPtrs          *ChatRepositoryMockCreateChatParamPtrs
+	expectationOrigins ChatRepositoryMockCreateChatExpectationOrigins
+	results            *ChatRepositoryMockCreateChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCreateChatParams contains parameters of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatParams struct {
+	ctx  context.Context
+	chat *model.Chat
+}
+
+// ChatRepositoryMockCreateChatParamPtrs contains pointers to parameters of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.Chat
+}
+
+// ChatRepositoryMockCreateChatResults contains results of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockCreateChatOrigins contains origins of expectations of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateChat *mChatRepositoryMockCreateChat) Optional() *mChatRepositoryMockCreateChat {
+	mmCreateChat.optional = true
+	return mmCreateChat
+}
+
+// Expect sets up expected params for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, chat *model.Chat) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by ExpectParams functions")
+	}
+
+	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, chat}
+	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateChat.expectations {
+		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
+			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectChatParam2(chat *model.Chat) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
+	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, chat *model.Chat)) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.inspectFuncCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CreateChat")
+	}
+
+	mmCreateChat.mock.inspectFuncCreateChat = f
+
+	return mmCreateChat
+}
+
+// Return sets up results that will be returned by ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{mock: mmCreateChat.mock}
+	}
+	mmCreateChat.defaultExpectation.results = &ChatRepositoryMockCreateChatResults{i1, err}
+	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CreateChat method
+func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, chat *model.Chat) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmCreateChat.defaultExpectation != nil {
+		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CreateChat method")
+	}
+
+	if len(mmCreateChat.expectations) > 0 {
+		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CreateChat method")
+	}
+
+	mmCreateChat.mock.funcCreateChat = f
+	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// When sets expectation for the ChatRepository.CreateChat which will trigger the result defined by the following
+// Then helper
+func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, chat *model.Chat) *ChatRepositoryMockCreateChatExpectation {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCreateChatExpectation{
+		mock:               mmCreateChat.mock,
+		params:             &ChatRepositoryMockCreateChatParams{ctx, chat},
+		expectationOrigins: ChatRepositoryMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CreateChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCreateChatExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCreateChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CreateChat should be invoked
+func (mmCreateChat *mChatRepositoryMockCreateChat) Times(n uint64) *mChatRepositoryMockCreateChat {
+	if n == 0 {
+		mmCreateChat.mock.t.Fatalf("Times of ChatRepositoryMock.CreateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
+	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateChat
+}
+
+func (mmCreateChat *mChatRepositoryMockCreateChat) invocationsDone() bool {
+	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateChat implements mm_repository.ChatRepository
+func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, chat *model.Chat) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+
+	mmCreateChat.t.Helper()
+
+	if mmCreateChat.inspectFuncCreateChat != nil {
+		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	}
+
+	mm_params := ChatRepositoryMockCreateChatParams{ctx, chat}
+
+	// Record call args
+	mmCreateChat.CreateChatMock.mutex.Lock()
+	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
+	mmCreateChat.CreateChatMock.mutex.Unlock()
+
+	for _, e := range mmCreateChat.CreateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCreateChatParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateChat.t.Fatal("No results are set for the ChatRepositoryMock.CreateChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateChat.funcCreateChat != nil {
+		return mmCreateChat.funcCreateChat(ctx, chat)
+	}
+	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v", ctx, chat)
+	return
+}
+
+// CreateChatAfterCounter returns a count of finished ChatRepositoryMock.CreateChat invocations
+func (mmCreateChat *ChatRepositoryMock) CreateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+}
+
+// CreateChatBeforeCounter returns a count of ChatRepositoryMock.CreateChat invocations
+func (mmCreateChat *ChatRepositoryMock) CreateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CreateChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateChat *mChatRepositoryMockCreateChat) Calls() []*ChatRepositoryMockCreateChatParams {
+	mmCreateChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCreateChatParams, len(mmCreateChat.callArgs))
+	copy(argCopy, mmCreateChat.callArgs)
+
+	mmCreateChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCreateChatDone() bool {
+	if m.CreateChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateChatMock.invocationsDone()
+}
+
+// MinimockCreateChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCreateChatInspect() {
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
+		if m.CreateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	}
+
+	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CreateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	}
+}
+
+type mChatRepositoryMockDeleteChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteChatExpectation
+	expectations       []*ChatRepositoryMockDeleteChatExpectation
+
+	callArgs []*ChatRepositoryMockDeleteChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteChatExpectation specifies expectation struct of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteChatParams
+	paramPtrs          *ChatRepositoryMockDeleteChatParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteChatExpectationOrigins
+	results            *ChatRepositoryMockDeleteChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteChatParams contains parameters of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockDeleteChatParamPtrs contains pointers to parameters of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockDeleteChatResults contains results of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatRepositoryMockDeleteChatOrigins contains origins of expectations of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Optional() *mChatRepositoryMockDeleteChat {
+	mmDeleteChat.optional = true
+	return mmDeleteChat
+}
+
+// Expect sets up expected params for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChat.defaultExpectation.params = &ChatRepositoryMockDeleteChatParams{ctx, chatID}
+	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteChat.expectations {
+		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
+			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmDeleteChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteChat")
+	}
+
+	mmDeleteChat.mock.inspectFuncDeleteChat = f
+
+	return mmDeleteChat
+}
+
+// Return sets up results that will be returned by ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Return(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	}
+	mmDeleteChat.defaultExpectation.results = &ChatRepositoryMockDeleteChatResults{ep1, err}
+	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.DeleteChat method
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Set(f func(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error)) *ChatRepositoryMock {
+	if mmDeleteChat.defaultExpectation != nil {
+		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteChat method")
+	}
+
+	if len(mmDeleteChat.expectations) > 0 {
+		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteChat method")
+	}
+
+	mmDeleteChat.mock.funcDeleteChat = f
+	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// When sets expectation for the ChatRepository.DeleteChat which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) When(ctx context.Context, chatID int64) *ChatRepositoryMockDeleteChatExpectation {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteChatExpectation{
+		mock:               mmDeleteChat.mock,
+		params:             &ChatRepositoryMockDeleteChatParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.DeleteChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteChatResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.DeleteChat should be invoked
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Times(n uint64) *mChatRepositoryMockDeleteChat {
+	if n == 0 {
+		mmDeleteChat.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
+	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat
+}
+
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) invocationsDone() bool {
+	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChat implements mm_repository.ChatRepository
+func (mmDeleteChat *ChatRepositoryMock) DeleteChat(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+
+	mmDeleteChat.t.Helper()
+
+	if mmDeleteChat.inspectFuncDeleteChat != nil {
+		mmDeleteChat.inspectFuncDeleteChat(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockDeleteChatParams{ctx, chatID}
+
+	// Record call args
+	mmDeleteChat.DeleteChatMock.mutex.Lock()
+	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
+	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteChatParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChat.t.Fatal("No results are set for the ChatRepositoryMock.DeleteChat")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmDeleteChat.funcDeleteChat != nil {
+		return mmDeleteChat.funcDeleteChat(ctx, chatID)
+	}
+	mmDeleteChat.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteChat. %v %v", ctx, chatID)
+	return
+}
+
+// DeleteChatAfterCounter returns a count of finished ChatRepositoryMock.DeleteChat invocations
+func (mmDeleteChat *ChatRepositoryMock) DeleteChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+}
+
+// DeleteChatBeforeCounter returns a count of ChatRepositoryMock.DeleteChat invocations
+func (mmDeleteChat *ChatRepositoryMock) DeleteChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Calls() []*ChatRepositoryMockDeleteChatParams {
+	mmDeleteChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteChatParams, len(mmDeleteChat.callArgs))
+	copy(argCopy, mmDeleteChat.callArgs)
+
+	mmDeleteChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteChatDone() bool {
+	if m.DeleteChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatMock.invocationsDone()
+}
+
+// MinimockDeleteChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
+		if m.DeleteChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	}
+
+	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	}
+}
+
+type mChatRepositoryMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteMessageExpectation
+	expectations       []*ChatRepositoryMockDeleteMessageExpectation
+
+	callArgs []*ChatRepositoryMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteMessageExpectation specifies expectation struct of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteMessageParams
+	paramPtrs          *ChatRepositoryMockDeleteMessageParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteMessageExpectationOrigins
+	results            *ChatRepositoryMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteMessageParams contains parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// ChatRepositoryMockDeleteMessageParamPtrs contains pointers to parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// ChatRepositoryMockDeleteMessageResults contains results of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockDeleteMessageOrigins contains origins of expectations of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Optional() *mChatRepositoryMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Expect(ctx context.Context, messageID int64) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatRepositoryMockDeleteMessageParams{ctx, messageID}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Inspect(f func(ctx context.Context, messageID int64)) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatRepositoryMockDeleteMessageResults{b1, err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.DeleteMessage method
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Set(f func(ctx context.Context, messageID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatRepository.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) When(ctx context.Context, messageID int64) *ChatRepositoryMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatRepositoryMockDeleteMessageParams{ctx, messageID},
+		expectationOrigins: ChatRepositoryMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteMessageExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteMessageResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Times(n uint64) *mChatRepositoryMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_repository.ChatRepository
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessage(ctx context.Context, messageID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, messageID)
+	}
+
+	mm_params := ChatRepositoryMockDeleteMessageParams{ctx, messageID}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteMessageParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatRepositoryMock.DeleteMessage")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, messageID)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteMessage. %v %v", ctx, messageID)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Calls() []*ChatRepositoryMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatRepositoryMockEditMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockEditMessageExpectation
+	expectations       []*ChatRepositoryMockEditMessageExpectation
+
+	callArgs []*ChatRepositoryMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockEditMessageExpectation specifies expectation struct of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockEditMessageParams
+	paramPtrs          *ChatRepositoryMockEditMessageParamPtrs
+	expectationOrigins ChatRepositoryMockEditMessageExpectationOrigins
+	results            *ChatRepositoryMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockEditMessageParams contains parameters of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageParams struct {
+	ctx       context.Context
+	messageID int64
+	text      string
+}
+
+// ChatRepositoryMockEditMessageParamPtrs contains pointers to parameters of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	text      *string
+}
+
+// ChatRepositoryMockEditMessageResults contains results of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockEditMessageOrigins contains origins of expectations of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originText      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatRepositoryMockEditMessage) Optional() *mChatRepositoryMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Expect(ctx context.Context, messageID int64, text string) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatRepositoryMockEditMessageParams{ctx, messageID, text}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmEditMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectTextParam3 sets up expected param text for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectTextParam3(text string) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.text = &text
+	mmEditMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Inspect(f func(ctx context.Context, messageID int64, text string)) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatRepositoryMockEditMessageResults{b1, err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.EditMessage method
+func (mmEditMessage *mChatRepositoryMockEditMessage) Set(f func(ctx context.Context, messageID int64, text string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatRepository.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatRepositoryMockEditMessage) When(ctx context.Context, messageID int64, text string) *ChatRepositoryMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatRepositoryMockEditMessageParams{ctx, messageID, text},
+		expectationOrigins: ChatRepositoryMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockEditMessageExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockEditMessageResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.EditMessage should be invoked
+func (mmEditMessage *mChatRepositoryMockEditMessage) Times(n uint64) *mChatRepositoryMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatRepositoryMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatRepositoryMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_repository.ChatRepository
+func (mmEditMessage *ChatRepositoryMock) EditMessage(ctx context.Context, messageID int64, text string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, messageID, text)
+	}
+
+	mm_params := ChatRepositoryMockEditMessageParams{ctx, messageID, text}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockEditMessageParams{ctx, messageID, text}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatRepositoryMock.EditMessage")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, messageID, text)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.EditMessage. %v %v %v", ctx, messageID, text)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatRepositoryMock.EditMessage invocations
+func (mmEditMessage *ChatRepositoryMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatRepositoryMock.EditMessage invocations
+func (mmEditMessage *ChatRepositoryMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatRepositoryMockEditMessage) Calls() []*ChatRepositoryMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatRepositoryMockGetChatMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetChatMessagesExpectation
+	expectations       []*ChatRepositoryMockGetChatMessagesExpectation
+
+	callArgs []*ChatRepositoryMockGetChatMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetChatMessagesExpectation specifies expectation struct of the ChatRepository.GetChatMessages
+type ChatRepositoryMockGetChatMessagesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetChatMessagesParams
+	paramPtrs          *ChatRepositoryMockGetChatMessagesParamPtrs
+	expectationOrigins ChatRepositoryMockGetChatMessagesExpectationOrigins
+	results            *ChatRepositoryMockGetChatMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetChatMessagesParams contains parameters of the ChatRepository.GetChatMessages
+type ChatRepositoryMockGetChatMessagesParams struct {
+	ctx    context.Context
+	filter *model.MessagesFilter
+}
+
+// ChatRepositoryMockGetChatMessagesParamPtrs contains pointers to parameters of the ChatRepository.GetChatMessages
+type ChatRepositoryMockGetChatMessagesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MessagesFilter
+}
+
+// ChatRepositoryMockGetChatMessagesResults contains results of the ChatRepository.GetChatMessages
+type ChatRepositoryMockGetChatMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatRepositoryMockGetChatMessagesOrigins contains origins of expectations of the ChatRepository.GetChatMessages
+type ChatRepositoryMockGetChatMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Optional() *mChatRepositoryMockGetChatMessages {
+	mmGetChatMessages.optional = true
+	return mmGetChatMessages
+}
+
+// Expect sets up expected params for ChatRepository.GetChatMessages
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Expect(ctx context.Context, filter *model.MessagesFilter) *mChatRepositoryMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatRepositoryMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by ExpectParams functions")
+	}
+
+	mmGetChatMessages.defaultExpectation.params = &ChatRepositoryMockGetChatMessagesParams{ctx, filter}
+	mmGetChatMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChatMessages.expectations {
+		if minimock.Equal(e.params, mmGetChatMessages.defaultExpectation.params) {
+			mmGetChatMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChatMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChatMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetChatMessages
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatRepositoryMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.params != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Expect")
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs == nil {
+		mmGetChatMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatMessagesParamPtrs{}
+	}
+	mmGetChatMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChatMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChatMessages
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatRepository.GetChatMessages
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) ExpectFilterParam2(filter *model.MessagesFilter) *mChatRepositoryMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatRepositoryMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.params != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Expect")
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs == nil {
+		mmGetChatMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatMessagesParamPtrs{}
+	}
+	mmGetChatMessages.defaultExpectation.paramPtrs.filter = &filter
+	mmGetChatMessages.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetChatMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetChatMessages
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Inspect(f func(ctx context.Context, filter *model.MessagesFilter)) *mChatRepositoryMockGetChatMessages {
+	if mmGetChatMessages.mock.inspectFuncGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetChatMessages")
+	}
+
+	mmGetChatMessages.mock.inspectFuncGetChatMessages = f
+
+	return mmGetChatMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.GetChatMessages
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Return(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatRepositoryMockGetChatMessagesExpectation{mock: mmGetChatMessages.mock}
+	}
+	mmGetChatMessages.defaultExpectation.results = &ChatRepositoryMockGetChatMessagesResults{mpa1, err}
+	mmGetChatMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetChatMessages method
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Set(f func(ctx context.Context, filter *model.MessagesFilter) (mpa1 []*model.Message, err error)) *ChatRepositoryMock {
+	if mmGetChatMessages.defaultExpectation != nil {
+		mmGetChatMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetChatMessages method")
+	}
+
+	if len(mmGetChatMessages.expectations) > 0 {
+		mmGetChatMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetChatMessages method")
+	}
+
+	mmGetChatMessages.mock.funcGetChatMessages = f
+	mmGetChatMessages.mock.funcGetChatMessagesOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages.mock
+}
+
+// When sets expectation for the ChatRepository.GetChatMessages which will trigger the result defined by the following
+// Then helper
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) When(ctx context.Context, filter *model.MessagesFilter) *ChatRepositoryMockGetChatMessagesExpectation {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatRepositoryMock.GetChatMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetChatMessagesExpectation{
+		mock:               mmGetChatMessages.mock,
+		params:             &ChatRepositoryMockGetChatMessagesParams{ctx, filter},
+		expectationOrigins: ChatRepositoryMockGetChatMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChatMessages.expectations = append(mmGetChatMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetChatMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetChatMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetChatMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetChatMessages should be invoked
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Times(n uint64) *mChatRepositoryMockGetChatMessages {
+	if n == 0 {
+		mmGetChatMessages.mock.t.Fatalf("Times of ChatRepositoryMock.GetChatMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChatMessages.expectedInvocations, n)
+	mmGetChatMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages
+}
+
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) invocationsDone() bool {
+	if len(mmGetChatMessages.expectations) == 0 && mmGetChatMessages.defaultExpectation == nil && mmGetChatMessages.mock.funcGetChatMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChatMessages.mock.afterGetChatMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChatMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChatMessages implements mm_repository.ChatRepository
+func (mmGetChatMessages *ChatRepositoryMock) GetChatMessages(ctx context.Context, filter *model.MessagesFilter) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmGetChatMessages.beforeGetChatMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChatMessages.afterGetChatMessagesCounter, 1)
+
+	mmGetChatMessages.t.Helper()
+
+	if mmGetChatMessages.inspectFuncGetChatMessages != nil {
+		mmGetChatMessages.inspectFuncGetChatMessages(ctx, filter)
+	}
+
+	mm_params := ChatRepositoryMockGetChatMessagesParams{ctx, filter}
+
+	// Record call args
+	mmGetChatMessages.GetChatMessagesMock.mutex.Lock()
+	mmGetChatMessages.GetChatMessagesMock.callArgs = append(mmGetChatMessages.GetChatMessagesMock.callArgs, &mm_params)
+	mmGetChatMessages.GetChatMessagesMock.mutex.Unlock()
+
+	for _, e := range mmGetChatMessages.GetChatMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetChatMessages.GetChatMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChatMessages.GetChatMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetChatMessagesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChatMessages.t.Errorf("ChatRepositoryMock.GetChatMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetChatMessages.t.Errorf("ChatRepositoryMock.GetChatMessages got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChatMessages.t.Errorf("ChatRepositoryMock.GetChatMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChatMessages.t.Fatal("No results are set for the ChatRepositoryMock.GetChatMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetChatMessages.funcGetChatMessages != nil {
+		return mmGetChatMessages.funcGetChatMessages(ctx, filter)
+	}
+	mmGetChatMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.GetChatMessages. %v %v", ctx, filter)
+	return
+}
+
+// GetChatMessagesAfterCounter returns a count of finished ChatRepositoryMock.GetChatMessages invocations
+func (mmGetChatMessages *ChatRepositoryMock) GetChatMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMessages.afterGetChatMessagesCounter)
+}
+
+// GetChatMessagesBeforeCounter returns a count of ChatRepositoryMock.GetChatMessages invocations
+func (mmGetChatMessages *ChatRepositoryMock) GetChatMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMessages.beforeGetChatMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetChatMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChatMessages *mChatRepositoryMockGetChatMessages) Calls() []*ChatRepositoryMockGetChatMessagesParams {
+	mmGetChatMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetChatMessagesParams, len(mmGetChatMessages.callArgs))
+	copy(argCopy, mmGetChatMessages.callArgs)
+
+	mmGetChatMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatMessagesDone returns true if the count of the GetChatMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetChatMessagesDone() bool {
+	if m.GetChatMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMessagesMock.invocationsDone()
+}
+
+// MinimockGetChatMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetChatMessagesInspect() {
+	for _, e := range m.GetChatMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatMessagesCounter := mm_atomic.LoadUint64(&m.afterGetChatMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMessagesMock.defaultExpectation != nil && afterGetChatMessagesCounter < 1 {
+		if m.GetChatMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMessages at\n%s", m.GetChatMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMessages at\n%s with params: %#v", m.GetChatMessagesMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChatMessages != nil && afterGetChatMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMessages at\n%s", m.funcGetChatMessagesOrigin)
+	}
+
+	if !m.GetChatMessagesMock.invocationsDone() && afterGetChatMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetChatMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMessagesMock.expectedInvocations), m.GetChatMessagesMock.expectedInvocationsOrigin, afterGetChatMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockGetLastSeen struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetLastSeenExpectation
+	expectations       []*ChatRepositoryMockGetLastSeenExpectation
+
+	callArgs []*ChatRepositoryMockGetLastSeenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetLastSeenExpectation specifies expectation struct of the ChatRepository.GetLastSeen
+type ChatRepositoryMockGetLastSeenExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetLastSeenParams
+	paramPtrs          *ChatRepositoryMockGetLastSeenParamPtrs
+	expectationOrigins ChatRepositoryMockGetLastSeenExpectationOrigins
+	results            *ChatRepositoryMockGetLastSeenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetLastSeenParams contains parameters of the ChatRepository.GetLastSeen
+type ChatRepositoryMockGetLastSeenParams struct {
+	ctx       context.Context
+	usernames []string
+}
+
+// ChatRepositoryMockGetLastSeenParamPtrs contains pointers to parameters of the ChatRepository.GetLastSeen
+type ChatRepositoryMockGetLastSeenParamPtrs struct {
+	ctx       *context.Context
+	usernames *[]string
+}
+
+// ChatRepositoryMockGetLastSeenResults contains results of the ChatRepository.GetLastSeen
+type ChatRepositoryMockGetLastSeenResults struct {
+	m1  map[string]time.Time
+	err error
+}
+
+// ChatRepositoryMockGetLastSeenOrigins contains origins of expectations of the ChatRepository.GetLastSeen
+type ChatRepositoryMockGetLastSeenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Optional() *mChatRepositoryMockGetLastSeen {
+	mmGetLastSeen.optional = true
+	return mmGetLastSeen
+}
+
+// Expect sets up expected params for ChatRepository.GetLastSeen
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Expect(ctx context.Context, usernames []string) *mChatRepositoryMockGetLastSeen {
+	if mmGetLastSeen.mock.funcGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Set")
+	}
+
+	if mmGetLastSeen.defaultExpectation == nil {
+		mmGetLastSeen.defaultExpectation = &ChatRepositoryMockGetLastSeenExpectation{}
+	}
+
+	if mmGetLastSeen.defaultExpectation.paramPtrs != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by ExpectParams functions")
+	}
+
+	mmGetLastSeen.defaultExpectation.params = &ChatRepositoryMockGetLastSeenParams{ctx, usernames}
+	mmGetLastSeen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLastSeen.expectations {
+		if minimock.Equal(e.params, mmGetLastSeen.defaultExpectation.params) {
+			mmGetLastSeen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLastSeen.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLastSeen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetLastSeen
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetLastSeen {
+	if mmGetLastSeen.mock.funcGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Set")
+	}
+
+	if mmGetLastSeen.defaultExpectation == nil {
+		mmGetLastSeen.defaultExpectation = &ChatRepositoryMockGetLastSeenExpectation{}
+	}
+
+	if mmGetLastSeen.defaultExpectation.params != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Expect")
+	}
+
+	if mmGetLastSeen.defaultExpectation.paramPtrs == nil {
+		mmGetLastSeen.defaultExpectation.paramPtrs = &ChatRepositoryMockGetLastSeenParamPtrs{}
+	}
+	mmGetLastSeen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLastSeen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLastSeen
+}
+
+// ExpectUsernamesParam2 sets up expected param usernames for ChatRepository.GetLastSeen
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) ExpectUsernamesParam2(usernames []string) *mChatRepositoryMockGetLastSeen {
+	if mmGetLastSeen.mock.funcGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Set")
+	}
+
+	if mmGetLastSeen.defaultExpectation == nil {
+		mmGetLastSeen.defaultExpectation = &ChatRepositoryMockGetLastSeenExpectation{}
+	}
+
+	if mmGetLastSeen.defaultExpectation.params != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Expect")
+	}
+
+	if mmGetLastSeen.defaultExpectation.paramPtrs == nil {
+		mmGetLastSeen.defaultExpectation.paramPtrs = &ChatRepositoryMockGetLastSeenParamPtrs{}
+	}
+	mmGetLastSeen.defaultExpectation.paramPtrs.usernames = &usernames
+	mmGetLastSeen.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmGetLastSeen
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetLastSeen
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Inspect(f func(ctx context.Context, usernames []string)) *mChatRepositoryMockGetLastSeen {
+	if mmGetLastSeen.mock.inspectFuncGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetLastSeen")
+	}
+
+	mmGetLastSeen.mock.inspectFuncGetLastSeen = f
+
+	return mmGetLastSeen
+}
+
+// Return sets up results that will be returned by ChatRepository.GetLastSeen
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Return(m1 map[string]time.Time, err error) *ChatRepositoryMock {
+	if mmGetLastSeen.mock.funcGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Set")
+	}
+
+	if mmGetLastSeen.defaultExpectation == nil {
+		mmGetLastSeen.defaultExpectation = &ChatRepositoryMockGetLastSeenExpectation{mock: mmGetLastSeen.mock}
+	}
+	mmGetLastSeen.defaultExpectation.results = &ChatRepositoryMockGetLastSeenResults{m1, err}
+	mmGetLastSeen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLastSeen.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetLastSeen method
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Set(f func(ctx context.Context, usernames []string) (m1 map[string]time.Time, err error)) *ChatRepositoryMock {
+	if mmGetLastSeen.defaultExpectation != nil {
+		mmGetLastSeen.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetLastSeen method")
+	}
+
+	if len(mmGetLastSeen.expectations) > 0 {
+		mmGetLastSeen.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetLastSeen method")
+	}
+
+	mmGetLastSeen.mock.funcGetLastSeen = f
+	mmGetLastSeen.mock.funcGetLastSeenOrigin = minimock.CallerInfo(1)
+	return mmGetLastSeen.mock
+}
+
+// When sets expectation for the ChatRepository.GetLastSeen which will trigger the result defined by the following
+// Then helper
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) When(ctx context.Context, usernames []string) *ChatRepositoryMockGetLastSeenExpectation {
+	if mmGetLastSeen.mock.funcGetLastSeen != nil {
+		mmGetLastSeen.mock.t.Fatalf("ChatRepositoryMock.GetLastSeen mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetLastSeenExpectation{
+		mock:               mmGetLastSeen.mock,
+		params:             &ChatRepositoryMockGetLastSeenParams{ctx, usernames},
+		expectationOrigins: ChatRepositoryMockGetLastSeenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLastSeen.expectations = append(mmGetLastSeen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetLastSeen return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetLastSeenExpectation) Then(m1 map[string]time.Time, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetLastSeenResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetLastSeen should be invoked
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Times(n uint64) *mChatRepositoryMockGetLastSeen {
+	if n == 0 {
+		mmGetLastSeen.mock.t.Fatalf("Times of ChatRepositoryMock.GetLastSeen mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLastSeen.expectedInvocations, n)
+	mmGetLastSeen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLastSeen
+}
+
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) invocationsDone() bool {
+	if len(mmGetLastSeen.expectations) == 0 && mmGetLastSeen.defaultExpectation == nil && mmGetLastSeen.mock.funcGetLastSeen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLastSeen.mock.afterGetLastSeenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLastSeen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLastSeen implements mm_repository.ChatRepository
+func (mmGetLastSeen *ChatRepositoryMock) GetLastSeen(ctx context.Context, usernames []string) (m1 map[string]time.Time, err error) {
+	mm_atomic.AddUint64(&mmGetLastSeen.beforeGetLastSeenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLastSeen.afterGetLastSeenCounter, 1)
+
+	mmGetLastSeen.t.Helper()
+
+	if mmGetLastSeen.inspectFuncGetLastSeen != nil {
+		mmGetLastSeen.inspectFuncGetLastSeen(ctx, usernames)
+	}
+
+	mm_params := ChatRepositoryMockGetLastSeenParams{ctx, usernames}
+
+	// Record call args
+	mmGetLastSeen.GetLastSeenMock.mutex.Lock()
+	mmGetLastSeen.GetLastSeenMock.callArgs = append(mmGetLastSeen.GetLastSeenMock.callArgs, &mm_params)
+	mmGetLastSeen.GetLastSeenMock.mutex.Unlock()
+
+	for _, e := range mmGetLastSeen.GetLastSeenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetLastSeen.GetLastSeenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLastSeen.GetLastSeenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLastSeen.GetLastSeenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLastSeen.GetLastSeenMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetLastSeenParams{ctx, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLastSeen.t.Errorf("ChatRepositoryMock.GetLastSeen got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLastSeen.GetLastSeenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmGetLastSeen.t.Errorf("ChatRepositoryMock.GetLastSeen got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLastSeen.GetLastSeenMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLastSeen.t.Errorf("ChatRepositoryMock.GetLastSeen got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLastSeen.GetLastSeenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLastSeen.GetLastSeenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLastSeen.t.Fatal("No results are set for the ChatRepositoryMock.GetLastSeen")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetLastSeen.funcGetLastSeen != nil {
+		return mmGetLastSeen.funcGetLastSeen(ctx, usernames)
+	}
+	mmGetLastSeen.t.Fatalf("Unexpected call to ChatRepositoryMock.GetLastSeen. %v %v", ctx, usernames)
+	return
+}
+
+// GetLastSeenAfterCounter returns a count of finished ChatRepositoryMock.GetLastSeen invocations
+func (mmGetLastSeen *ChatRepositoryMock) GetLastSeenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLastSeen.afterGetLastSeenCounter)
+}
+
+// GetLastSeenBeforeCounter returns a count of ChatRepositoryMock.GetLastSeen invocations
+func (mmGetLastSeen *ChatRepositoryMock) GetLastSeenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLastSeen.beforeGetLastSeenCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetLastSeen.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLastSeen *mChatRepositoryMockGetLastSeen) Calls() []*ChatRepositoryMockGetLastSeenParams {
+	mmGetLastSeen.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetLastSeenParams, len(mmGetLastSeen.callArgs))
+	copy(argCopy, mmGetLastSeen.callArgs)
+
+	mmGetLastSeen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLastSeenDone returns true if the count of the GetLastSeen invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetLastSeenDone() bool {
+	if m.GetLastSeenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLastSeenMock.invocationsDone()
+}
+
+// MinimockGetLastSeenInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetLastSeenInspect() {
+	for _, e := range m.GetLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetLastSeen at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLastSeenCounter := mm_atomic.LoadUint64(&m.afterGetLastSeenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLastSeenMock.defaultExpectation != nil && afterGetLastSeenCounter < 1 {
+		if m.GetLastSeenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetLastSeen at\n%s", m.GetLastSeenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetLastSeen at\n%s with params: %#v", m.GetLastSeenMock.defaultExpectation.expectationOrigins.origin, *m.GetLastSeenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLastSeen != nil && afterGetLastSeenCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetLastSeen at\n%s", m.funcGetLastSeenOrigin)
+	}
+
+	if !m.GetLastSeenMock.invocationsDone() && afterGetLastSeenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetLastSeen at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLastSeenMock.expectedInvocations), m.GetLastSeenMock.expectedInvocationsOrigin, afterGetLastSeenCounter)
+	}
+}
+
+type mChatRepositoryMockGetMemberRole struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMemberRoleExpectation
+	expectations       []*ChatRepositoryMockGetMemberRoleExpectation
+
+	callArgs []*ChatRepositoryMockGetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetMemberRoleExpectation specifies expectation struct of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetMemberRoleParams
+	paramPtrs          *ChatRepositoryMockGetMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockGetMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockGetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetMemberRoleParams contains parameters of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockGetMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockGetMemberRoleResults contains results of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleResults struct {
+	r1  model.Role
+	err error
+}
+
+// ChatRepositoryMockGetMemberRoleOrigins contains origins of expectations of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Optional() *mChatRepositoryMockGetMemberRole {
+	mmGetMemberRole.optional = true
+	return mmGetMemberRole
+}
+
+// Expect sets up expected params for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmGetMemberRole.defaultExpectation.params = &ChatRepositoryMockGetMemberRoleParams{ctx, chatID, username}
+	mmGetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMemberRole.expectations {
+		if minimock.Equal(e.params, mmGetMemberRole.defaultExpectation.params) {
+			mmGetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectUsernameParam3(username string) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.username = &username
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.inspectFuncGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMemberRole")
+	}
+
+	mmGetMemberRole.mock.inspectFuncGetMemberRole = f
+
+	return mmGetMemberRole
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Return(r1 model.Role, err error) *ChatRepositoryMock {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{mock: mmGetMemberRole.mock}
+	}
+	mmGetMemberRole.defaultExpectation.results = &ChatRepositoryMockGetMemberRoleResults{r1, err}
+	mmGetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMemberRole method
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Set(f func(ctx context.Context, chatID int64, username string) (r1 model.Role, err error)) *ChatRepositoryMock {
+	if mmGetMemberRole.defaultExpectation != nil {
+		mmGetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMemberRole method")
+	}
+
+	if len(mmGetMemberRole.expectations) > 0 {
+		mmGetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMemberRole method")
+	}
+
+	mmGetMemberRole.mock.funcGetMemberRole = f
+	mmGetMemberRole.mock.funcGetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole.mock
+}
+
+// When sets expectation for the ChatRepository.GetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockGetMemberRoleExpectation {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMemberRoleExpectation{
+		mock:               mmGetMemberRole.mock,
+		params:             &ChatRepositoryMockGetMemberRoleParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockGetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMemberRole.expectations = append(mmGetMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMemberRoleExpectation) Then(r1 model.Role, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMemberRoleResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMemberRole should be invoked
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Times(n uint64) *mChatRepositoryMockGetMemberRole {
+	if n == 0 {
+		mmGetMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.GetMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMemberRole.expectedInvocations, n)
+	mmGetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole
+}
+
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) invocationsDone() bool {
+	if len(mmGetMemberRole.expectations) == 0 && mmGetMemberRole.defaultExpectation == nil && mmGetMemberRole.mock.funcGetMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMemberRole.mock.afterGetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMemberRole implements mm_repository.ChatRepository
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRole(ctx context.Context, chatID int64, username string) (r1 model.Role, err error) {
+	mm_atomic.AddUint64(&mmGetMemberRole.beforeGetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMemberRole.afterGetMemberRoleCounter, 1)
+
+	mmGetMemberRole.t.Helper()
+
+	if mmGetMemberRole.inspectFuncGetMemberRole != nil {
+		mmGetMemberRole.inspectFuncGetMemberRole(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockGetMemberRoleParams{ctx, chatID, username}
+
+	// Record call args
+	mmGetMemberRole.GetMemberRoleMock.mutex.Lock()
+	mmGetMemberRole.GetMemberRoleMock.callArgs = append(mmGetMemberRole.GetMemberRoleMock.callArgs, &mm_params)
+	mmGetMemberRole.GetMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmGetMemberRole.GetMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetMemberRole.GetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMemberRole.GetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMemberRoleParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.GetMemberRole")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetMemberRole.funcGetMemberRole != nil {
+		return mmGetMemberRole.funcGetMemberRole(ctx, chatID, username)
+	}
+	mmGetMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMemberRole. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.GetMemberRole invocations
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMemberRole.afterGetMemberRoleCounter)
+}
+
+// GetMemberRoleBeforeCounter returns a count of ChatRepositoryMock.GetMemberRole invocations
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMemberRole.beforeGetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Calls() []*ChatRepositoryMockGetMemberRoleParams {
+	mmGetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMemberRoleParams, len(mmGetMemberRole.callArgs))
+	copy(argCopy, mmGetMemberRole.callArgs)
+
+	mmGetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMemberRoleDone returns true if the count of the GetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMemberRoleDone() bool {
+	if m.GetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMemberRoleMock.invocationsDone()
+}
+
+// MinimockGetMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMemberRoleInspect() {
+	for _, e := range m.GetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterGetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMemberRoleMock.defaultExpectation != nil && afterGetMemberRoleCounter < 1 {
+		if m.GetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s", m.GetMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s with params: %#v", m.GetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.GetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMemberRole != nil && afterGetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s", m.funcGetMemberRoleOrigin)
+	}
+
+	if !m.GetMemberRoleMock.invocationsDone() && afterGetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMemberRoleMock.expectedInvocations), m.GetMemberRoleMock.expectedInvocationsOrigin, afterGetMemberRoleCounter)
+	}
+}
+
+type mChatRepositoryMockGetMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMessageExpectation
+	expectations       []*ChatRepositoryMockGetMessageExpectation
+
+	callArgs []*ChatRepositoryMockGetMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetMessageExpectation specifies expectation struct of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetMessageParams
+	paramPtrs          *ChatRepositoryMockGetMessageParamPtrs
+	expectationOrigins ChatRepositoryMockGetMessageExpectationOrigins
+	results            *ChatRepositoryMockGetMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetMessageParams contains parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// ChatRepositoryMockGetMessageParamPtrs contains pointers to parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// ChatRepositoryMockGetMessageResults contains results of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatRepositoryMockGetMessageOrigins contains origins of expectations of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessage *mChatRepositoryMockGetMessage) Optional() *mChatRepositoryMockGetMessage {
+	mmGetMessage.optional = true
+	return mmGetMessage
+}
+
+// Expect sets up expected params for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Expect(ctx context.Context, messageID int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessage.defaultExpectation.params = &ChatRepositoryMockGetMessageParams{ctx, messageID}
+	mmGetMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMessage.expectations {
+		if minimock.Equal(e.params, mmGetMessage.defaultExpectation.params) {
+			mmGetMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Inspect(f func(ctx context.Context, messageID int64)) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.inspectFuncGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMessage")
+	}
+
+	mmGetMessage.mock.inspectFuncGetMessage = f
+
+	return mmGetMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Return(mp1 *model.Message, err error) *ChatRepositoryMock {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{mock: mmGetMessage.mock}
+	}
+	mmGetMessage.defaultExpectation.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	mmGetMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMessage method
+func (mmGetMessage *mChatRepositoryMockGetMessage) Set(f func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)) *ChatRepositoryMock {
+	if mmGetMessage.defaultExpectation != nil {
+		mmGetMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMessage method")
+	}
+
+	if len(mmGetMessage.expectations) > 0 {
+		mmGetMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMessage method")
+	}
+
+	mmGetMessage.mock.funcGetMessage = f
+	mmGetMessage.mock.funcGetMessageOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// When sets expectation for the ChatRepository.GetMessage which will trigger the result defined by the following
+// Then helper
+func (mmGetMessage *mChatRepositoryMockGetMessage) When(ctx context.Context, messageID int64) *ChatRepositoryMockGetMessageExpectation {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMessageExpectation{
+		mock:               mmGetMessage.mock,
+		params:             &ChatRepositoryMockGetMessageParams{ctx, messageID},
+		expectationOrigins: ChatRepositoryMockGetMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMessage.expectations = append(mmGetMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMessageExpectation) Then(mp1 *model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMessage should be invoked
+func (mmGetMessage *mChatRepositoryMockGetMessage) Times(n uint64) *mChatRepositoryMockGetMessage {
+	if n == 0 {
+		mmGetMessage.mock.t.Fatalf("Times of ChatRepositoryMock.GetMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessage.expectedInvocations, n)
+	mmGetMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMessage
+}
+
+func (mmGetMessage *mChatRepositoryMockGetMessage) invocationsDone() bool {
+	if len(mmGetMessage.expectations) == 0 && mmGetMessage.defaultExpectation == nil && mmGetMessage.mock.funcGetMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessage.mock.afterGetMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessage implements mm_repository.ChatRepository
+func (mmGetMessage *ChatRepositoryMock) GetMessage(ctx context.Context, messageID int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmGetMessage.beforeGetMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessage.afterGetMessageCounter, 1)
+
+	mmGetMessage.t.Helper()
+
+	if mmGetMessage.inspectFuncGetMessage != nil {
+		mmGetMessage.inspectFuncGetMessage(ctx, messageID)
+	}
+
+	mm_params := ChatRepositoryMockGetMessageParams{ctx, messageID}
+
+	// Record call args
+	mmGetMessage.GetMessageMock.mutex.Lock()
+	mmGetMessage.GetMessageMock.callArgs = append(mmGetMessage.GetMessageMock.callArgs, &mm_params)
+	mmGetMessage.GetMessageMock.mutex.Unlock()
+
+	for _, e := range mmGetMessage.GetMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetMessage.GetMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessage.GetMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessage.GetMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessage.GetMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMessageParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessage.GetMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessage.t.Fatal("No results are set for the ChatRepositoryMock.GetMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetMessage.funcGetMessage != nil {
+		return mmGetMessage.funcGetMessage(ctx, messageID)
+	}
+	mmGetMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMessage. %v %v", ctx, messageID)
+	return
+}
+
+// GetMessageAfterCounter returns a count of finished ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.afterGetMessageCounter)
+}
+
+// GetMessageBeforeCounter returns a count of ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.beforeGetMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessage *mChatRepositoryMockGetMessage) Calls() []*ChatRepositoryMockGetMessageParams {
+	mmGetMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMessageParams, len(mmGetMessage.callArgs))
+	copy(argCopy, mmGetMessage.callArgs)
+
+	mmGetMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageDone returns true if the count of the GetMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMessageDone() bool {
+	if m.GetMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageMock.invocationsDone()
+}
+
+// MinimockGetMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMessageInspect() {
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMessageCounter := mm_atomic.LoadUint64(&m.afterGetMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageMock.defaultExpectation != nil && afterGetMessageCounter < 1 {
+		if m.GetMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s", m.GetMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s with params: %#v", m.GetMessageMock.defaultExpectation.expectationOrigins.origin, *m.GetMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessage != nil && afterGetMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s", m.funcGetMessageOrigin)
+	}
+
+	if !m.GetMessageMock.invocationsDone() && afterGetMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageMock.expectedInvocations), m.GetMessageMock.expectedInvocationsOrigin, afterGetMessageCounter)
+	}
+}
+
+type mChatRepositoryMockGetMessageStatus struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMessageStatusExpectation
+	expectations       []*ChatRepositoryMockGetMessageStatusExpectation
+
+	callArgs []*ChatRepositoryMockGetMessageStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetMessageStatusExpectation specifies expectation struct of the ChatRepository.GetMessageStatus
+type ChatRepositoryMockGetMessageStatusExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetMessageStatusParams
+	paramPtrs          *ChatRepositoryMockGetMessageStatusParamPtrs
+	expectationOrigins ChatRepositoryMockGetMessageStatusExpectationOrigins
+	results            *ChatRepositoryMockGetMessageStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetMessageStatusParams contains parameters of the ChatRepository.GetMessageStatus
+type ChatRepositoryMockGetMessageStatusParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// ChatRepositoryMockGetMessageStatusParamPtrs contains pointers to parameters of the ChatRepository.GetMessageStatus
+type ChatRepositoryMockGetMessageStatusParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// ChatRepositoryMockGetMessageStatusResults contains results of the ChatRepository.GetMessageStatus
+type ChatRepositoryMockGetMessageStatusResults struct {
+	mpa1 []*model.MessageStatus
+	err  error
+}
+
+// ChatRepositoryMockGetMessageStatusOrigins contains origins of expectations of the ChatRepository.GetMessageStatus
+type ChatRepositoryMockGetMessageStatusExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Optional() *mChatRepositoryMockGetMessageStatus {
+	mmGetMessageStatus.optional = true
+	return mmGetMessageStatus
+}
+
+// Expect sets up expected params for ChatRepository.GetMessageStatus
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Expect(ctx context.Context, messageID int64) *mChatRepositoryMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatRepositoryMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessageStatus.defaultExpectation.params = &ChatRepositoryMockGetMessageStatusParams{ctx, messageID}
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMessageStatus.expectations {
+		if minimock.Equal(e.params, mmGetMessageStatus.defaultExpectation.params) {
+			mmGetMessageStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessageStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessageStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMessageStatus
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatRepositoryMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.GetMessageStatus
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatRepositoryMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMessageStatus
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Inspect(f func(ctx context.Context, messageID int64)) *mChatRepositoryMockGetMessageStatus {
+	if mmGetMessageStatus.mock.inspectFuncGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMessageStatus")
+	}
+
+	mmGetMessageStatus.mock.inspectFuncGetMessageStatus = f
+
+	return mmGetMessageStatus
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMessageStatus
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Return(mpa1 []*model.MessageStatus, err error) *ChatRepositoryMock {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatRepositoryMockGetMessageStatusExpectation{mock: mmGetMessageStatus.mock}
+	}
+	mmGetMessageStatus.defaultExpectation.results = &ChatRepositoryMockGetMessageStatusResults{mpa1, err}
+	mmGetMessageStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMessageStatus method
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Set(f func(ctx context.Context, messageID int64) (mpa1 []*model.MessageStatus, err error)) *ChatRepositoryMock {
+	if mmGetMessageStatus.defaultExpectation != nil {
+		mmGetMessageStatus.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMessageStatus method")
+	}
+
+	if len(mmGetMessageStatus.expectations) > 0 {
+		mmGetMessageStatus.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMessageStatus method")
+	}
+
+	mmGetMessageStatus.mock.funcGetMessageStatus = f
+	mmGetMessageStatus.mock.funcGetMessageStatusOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus.mock
+}
+
+// When sets expectation for the ChatRepository.GetMessageStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) When(ctx context.Context, messageID int64) *ChatRepositoryMockGetMessageStatusExpectation {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatRepositoryMock.GetMessageStatus mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMessageStatusExpectation{
+		mock:               mmGetMessageStatus.mock,
+		params:             &ChatRepositoryMockGetMessageStatusParams{ctx, messageID},
+		expectationOrigins: ChatRepositoryMockGetMessageStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMessageStatus.expectations = append(mmGetMessageStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMessageStatus return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMessageStatusExpectation) Then(mpa1 []*model.MessageStatus, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMessageStatusResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMessageStatus should be invoked
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Times(n uint64) *mChatRepositoryMockGetMessageStatus {
+	if n == 0 {
+		mmGetMessageStatus.mock.t.Fatalf("Times of ChatRepositoryMock.GetMessageStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessageStatus.expectedInvocations, n)
+	mmGetMessageStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus
+}
+
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) invocationsDone() bool {
+	if len(mmGetMessageStatus.expectations) == 0 && mmGetMessageStatus.defaultExpectation == nil && mmGetMessageStatus.mock.funcGetMessageStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessageStatus.mock.afterGetMessageStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessageStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessageStatus implements mm_repository.ChatRepository
+func (mmGetMessageStatus *ChatRepositoryMock) GetMessageStatus(ctx context.Context, messageID int64) (mpa1 []*model.MessageStatus, err error) {
+	mm_atomic.AddUint64(&mmGetMessageStatus.beforeGetMessageStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessageStatus.afterGetMessageStatusCounter, 1)
+
+	mmGetMessageStatus.t.Helper()
+
+	if mmGetMessageStatus.inspectFuncGetMessageStatus != nil {
+		mmGetMessageStatus.inspectFuncGetMessageStatus(ctx, messageID)
+	}
+
+	mm_params := ChatRepositoryMockGetMessageStatusParams{ctx, messageID}
+
+	// Record call args
+	mmGetMessageStatus.GetMessageStatusMock.mutex.Lock()
+	mmGetMessageStatus.GetMessageStatusMock.callArgs = append(mmGetMessageStatus.GetMessageStatusMock.callArgs, &mm_params)
+	mmGetMessageStatus.GetMessageStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetMessageStatus.GetMessageStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetMessageStatus.GetMessageStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMessageStatusParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessageStatus.t.Errorf("ChatRepositoryMock.GetMessageStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetMessageStatus.t.Errorf("ChatRepositoryMock.GetMessageStatus got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessageStatus.t.Errorf("ChatRepositoryMock.GetMessageStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessageStatus.t.Fatal("No results are set for the ChatRepositoryMock.GetMessageStatus")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetMessageStatus.funcGetMessageStatus != nil {
+		return mmGetMessageStatus.funcGetMessageStatus(ctx, messageID)
+	}
+	mmGetMessageStatus.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMessageStatus. %v %v", ctx, messageID)
+	return
+}
+
+// GetMessageStatusAfterCounter returns a count of finished ChatRepositoryMock.GetMessageStatus invocations
+func (mmGetMessageStatus *ChatRepositoryMock) GetMessageStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageStatus.afterGetMessageStatusCounter)
+}
+
+// GetMessageStatusBeforeCounter returns a count of ChatRepositoryMock.GetMessageStatus invocations
+func (mmGetMessageStatus *ChatRepositoryMock) GetMessageStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageStatus.beforeGetMessageStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMessageStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessageStatus *mChatRepositoryMockGetMessageStatus) Calls() []*ChatRepositoryMockGetMessageStatusParams {
+	mmGetMessageStatus.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMessageStatusParams, len(mmGetMessageStatus.callArgs))
+	copy(argCopy, mmGetMessageStatus.callArgs)
+
+	mmGetMessageStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageStatusDone returns true if the count of the GetMessageStatus invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMessageStatusDone() bool {
+	if m.GetMessageStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageStatusMock.invocationsDone()
+}
+
+// MinimockGetMessageStatusInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMessageStatusInspect() {
+	for _, e := range m.GetMessageStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessageStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMessageStatusCounter := mm_atomic.LoadUint64(&m.afterGetMessageStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageStatusMock.defaultExpectation != nil && afterGetMessageStatusCounter < 1 {
+		if m.GetMessageStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessageStatus at\n%s", m.GetMessageStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessageStatus at\n%s with params: %#v", m.GetMessageStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetMessageStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessageStatus != nil && afterGetMessageStatusCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetMessageStatus at\n%s", m.funcGetMessageStatusOrigin)
+	}
+
+	if !m.GetMessageStatusMock.invocationsDone() && afterGetMessageStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMessageStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageStatusMock.expectedInvocations), m.GetMessageStatusMock.expectedInvocationsOrigin, afterGetMessageStatusCounter)
+	}
+}
+
+type mChatRepositoryMockGetPinnedMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetPinnedMessagesExpectation
+	expectations       []*ChatRepositoryMockGetPinnedMessagesExpectation
+
+	callArgs []*ChatRepositoryMockGetPinnedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetPinnedMessagesExpectation specifies expectation struct of the ChatRepository.GetPinnedMessages
+type ChatRepositoryMockGetPinnedMessagesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetPinnedMessagesParams
+	paramPtrs          *ChatRepositoryMockGetPinnedMessagesParamPtrs
+	expectationOrigins ChatRepositoryMockGetPinnedMessagesExpectationOrigins
+	results            *ChatRepositoryMockGetPinnedMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetPinnedMessagesParams contains parameters of the ChatRepository.GetPinnedMessages
+type ChatRepositoryMockGetPinnedMessagesParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockGetPinnedMessagesParamPtrs contains pointers to parameters of the ChatRepository.GetPinnedMessages
+type ChatRepositoryMockGetPinnedMessagesParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockGetPinnedMessagesResults contains results of the ChatRepository.GetPinnedMessages
+type ChatRepositoryMockGetPinnedMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatRepositoryMockGetPinnedMessagesOrigins contains origins of expectations of the ChatRepository.GetPinnedMessages
+type ChatRepositoryMockGetPinnedMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Optional() *mChatRepositoryMockGetPinnedMessages {
+	mmGetPinnedMessages.optional = true
+	return mmGetPinnedMessages
+}
+
+// Expect sets up expected params for ChatRepository.GetPinnedMessages
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockGetPinnedMessages {
+	if mmGetPinnedMessages.mock.funcGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Set")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation == nil {
+		mmGetPinnedMessages.defaultExpectation = &ChatRepositoryMockGetPinnedMessagesExpectation{}
+	}
+
+	if mmGetPinnedMessages.defaultExpectation.paramPtrs != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmGetPinnedMessages.defaultExpectation.params = &ChatRepositoryMockGetPinnedMessagesParams{ctx, chatID}
+	mmGetPinnedMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPinnedMessages.expectations {
+		if minimock.Equal(e.params, mmGetPinnedMessages.defaultExpectation.params) {
+			mmGetPinnedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPinnedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPinnedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetPinnedMessages
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetPinnedMessages {
+	if mmGetPinnedMessages.mock.funcGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Set")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation == nil {
+		mmGetPinnedMessages.defaultExpectation = &ChatRepositoryMockGetPinnedMessagesExpectation{}
+	}
+
+	if mmGetPinnedMessages.defaultExpectation.params != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Expect")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmGetPinnedMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockGetPinnedMessagesParamPtrs{}
+	}
+	mmGetPinnedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPinnedMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPinnedMessages
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetPinnedMessages
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetPinnedMessages {
+	if mmGetPinnedMessages.mock.funcGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Set")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation == nil {
+		mmGetPinnedMessages.defaultExpectation = &ChatRepositoryMockGetPinnedMessagesExpectation{}
+	}
+
+	if mmGetPinnedMessages.defaultExpectation.params != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Expect")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmGetPinnedMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockGetPinnedMessagesParamPtrs{}
+	}
+	mmGetPinnedMessages.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetPinnedMessages.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetPinnedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetPinnedMessages
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockGetPinnedMessages {
+	if mmGetPinnedMessages.mock.inspectFuncGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetPinnedMessages")
+	}
+
+	mmGetPinnedMessages.mock.inspectFuncGetPinnedMessages = f
+
+	return mmGetPinnedMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.GetPinnedMessages
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Return(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	if mmGetPinnedMessages.mock.funcGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Set")
+	}
+
+	if mmGetPinnedMessages.defaultExpectation == nil {
+		mmGetPinnedMessages.defaultExpectation = &ChatRepositoryMockGetPinnedMessagesExpectation{mock: mmGetPinnedMessages.mock}
+	}
+	mmGetPinnedMessages.defaultExpectation.results = &ChatRepositoryMockGetPinnedMessagesResults{mpa1, err}
+	mmGetPinnedMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPinnedMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetPinnedMessages method
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Set(f func(ctx context.Context, chatID int64) (mpa1 []*model.Message, err error)) *ChatRepositoryMock {
+	if mmGetPinnedMessages.defaultExpectation != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetPinnedMessages method")
+	}
+
+	if len(mmGetPinnedMessages.expectations) > 0 {
+		mmGetPinnedMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetPinnedMessages method")
+	}
+
+	mmGetPinnedMessages.mock.funcGetPinnedMessages = f
+	mmGetPinnedMessages.mock.funcGetPinnedMessagesOrigin = minimock.CallerInfo(1)
+	return mmGetPinnedMessages.mock
+}
+
+// When sets expectation for the ChatRepository.GetPinnedMessages which will trigger the result defined by the following
+// Then helper
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) When(ctx context.Context, chatID int64) *ChatRepositoryMockGetPinnedMessagesExpectation {
+	if mmGetPinnedMessages.mock.funcGetPinnedMessages != nil {
+		mmGetPinnedMessages.mock.t.Fatalf("ChatRepositoryMock.GetPinnedMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetPinnedMessagesExpectation{
+		mock:               mmGetPinnedMessages.mock,
+		params:             &ChatRepositoryMockGetPinnedMessagesParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockGetPinnedMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPinnedMessages.expectations = append(mmGetPinnedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetPinnedMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetPinnedMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetPinnedMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetPinnedMessages should be invoked
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Times(n uint64) *mChatRepositoryMockGetPinnedMessages {
+	if n == 0 {
+		mmGetPinnedMessages.mock.t.Fatalf("Times of ChatRepositoryMock.GetPinnedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPinnedMessages.expectedInvocations, n)
+	mmGetPinnedMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPinnedMessages
+}
+
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) invocationsDone() bool {
+	if len(mmGetPinnedMessages.expectations) == 0 && mmGetPinnedMessages.defaultExpectation == nil && mmGetPinnedMessages.mock.funcGetPinnedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPinnedMessages.mock.afterGetPinnedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPinnedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPinnedMessages implements mm_repository.ChatRepository
+func (mmGetPinnedMessages *ChatRepositoryMock) GetPinnedMessages(ctx context.Context, chatID int64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmGetPinnedMessages.beforeGetPinnedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPinnedMessages.afterGetPinnedMessagesCounter, 1)
+
+	mmGetPinnedMessages.t.Helper()
+
+	if mmGetPinnedMessages.inspectFuncGetPinnedMessages != nil {
+		mmGetPinnedMessages.inspectFuncGetPinnedMessages(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockGetPinnedMessagesParams{ctx, chatID}
+
+	// Record call args
+	mmGetPinnedMessages.GetPinnedMessagesMock.mutex.Lock()
+	mmGetPinnedMessages.GetPinnedMessagesMock.callArgs = append(mmGetPinnedMessages.GetPinnedMessagesMock.callArgs, &mm_params)
+	mmGetPinnedMessages.GetPinnedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmGetPinnedMessages.GetPinnedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetPinnedMessagesParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPinnedMessages.t.Errorf("ChatRepositoryMock.GetPinnedMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetPinnedMessages.t.Errorf("ChatRepositoryMock.GetPinnedMessages got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPinnedMessages.t.Errorf("ChatRepositoryMock.GetPinnedMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPinnedMessages.GetPinnedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPinnedMessages.t.Fatal("No results are set for the ChatRepositoryMock.GetPinnedMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetPinnedMessages.funcGetPinnedMessages != nil {
+		return mmGetPinnedMessages.funcGetPinnedMessages(ctx, chatID)
+	}
+	mmGetPinnedMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.GetPinnedMessages. %v %v", ctx, chatID)
+	return
+}
+
+// GetPinnedMessagesAfterCounter returns a count of finished ChatRepositoryMock.GetPinnedMessages invocations
+func (mmGetPinnedMessages *ChatRepositoryMock) GetPinnedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPinnedMessages.afterGetPinnedMessagesCounter)
+}
+
+// GetPinnedMessagesBeforeCounter returns a count of ChatRepositoryMock.GetPinnedMessages invocations
+func (mmGetPinnedMessages *ChatRepositoryMock) GetPinnedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPinnedMessages.beforeGetPinnedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetPinnedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPinnedMessages *mChatRepositoryMockGetPinnedMessages) Calls() []*ChatRepositoryMockGetPinnedMessagesParams {
+	mmGetPinnedMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetPinnedMessagesParams, len(mmGetPinnedMessages.callArgs))
+	copy(argCopy, mmGetPinnedMessages.callArgs)
+
+	mmGetPinnedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPinnedMessagesDone returns true if the count of the GetPinnedMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetPinnedMessagesDone() bool {
+	if m.GetPinnedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPinnedMessagesMock.invocationsDone()
+}
+
+// MinimockGetPinnedMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetPinnedMessagesInspect() {
+	for _, e := range m.GetPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetPinnedMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPinnedMessagesCounter := mm_atomic.LoadUint64(&m.afterGetPinnedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPinnedMessagesMock.defaultExpectation != nil && afterGetPinnedMessagesCounter < 1 {
+		if m.GetPinnedMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetPinnedMessages at\n%s", m.GetPinnedMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetPinnedMessages at\n%s with params: %#v", m.GetPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *m.GetPinnedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPinnedMessages != nil && afterGetPinnedMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetPinnedMessages at\n%s", m.funcGetPinnedMessagesOrigin)
+	}
+
+	if !m.GetPinnedMessagesMock.invocationsDone() && afterGetPinnedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetPinnedMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPinnedMessagesMock.expectedInvocations), m.GetPinnedMessagesMock.expectedInvocationsOrigin, afterGetPinnedMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockGetReadCursor struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetReadCursorExpectation
+	expectations       []*ChatRepositoryMockGetReadCursorExpectation
+
+	callArgs []*ChatRepositoryMockGetReadCursorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetReadCursorExpectation specifies expectation struct of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetReadCursorParams
+	paramPtrs          *ChatRepositoryMockGetReadCursorParamPtrs
+	expectationOrigins ChatRepositoryMockGetReadCursorExpectationOrigins
+	results            *ChatRepositoryMockGetReadCursorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetReadCursorParams contains parameters of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockGetReadCursorParamPtrs contains pointers to parameters of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockGetReadCursorResults contains results of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockGetReadCursorOrigins contains origins of expectations of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Optional() *mChatRepositoryMockGetReadCursor {
+	mmGetReadCursor.optional = true
+	return mmGetReadCursor
+}
+
+// Expect sets up expected params for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadCursor.defaultExpectation.params = &ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+	mmGetReadCursor.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReadCursor.expectations {
+		if minimock.Equal(e.params, mmGetReadCursor.defaultExpectation.params) {
+			mmGetReadCursor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadCursor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadCursor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectUsernameParam3(username string) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.username = &username
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.inspectFuncGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetReadCursor")
+	}
+
+	mmGetReadCursor.mock.inspectFuncGetReadCursor = f
+
+	return mmGetReadCursor
+}
+
+// Return sets up results that will be returned by ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{mock: mmGetReadCursor.mock}
+	}
+	mmGetReadCursor.defaultExpectation.results = &ChatRepositoryMockGetReadCursorResults{i1, err}
+	mmGetReadCursor.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetReadCursor method
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Set(f func(ctx context.Context, chatID int64, username string) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmGetReadCursor.defaultExpectation != nil {
+		mmGetReadCursor.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetReadCursor method")
+	}
+
+	if len(mmGetReadCursor.expectations) > 0 {
+		mmGetReadCursor.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetReadCursor method")
+	}
+
+	mmGetReadCursor.mock.funcGetReadCursor = f
+	mmGetReadCursor.mock.funcGetReadCursorOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor.mock
+}
+
+// When sets expectation for the ChatRepository.GetReadCursor which will trigger the result defined by the following
+// Then helper
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockGetReadCursorExpectation {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetReadCursorExpectation{
+		mock:               mmGetReadCursor.mock,
+		params:             &ChatRepositoryMockGetReadCursorParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockGetReadCursorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReadCursor.expectations = append(mmGetReadCursor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetReadCursor return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetReadCursorExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetReadCursorResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetReadCursor should be invoked
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Times(n uint64) *mChatRepositoryMockGetReadCursor {
+	if n == 0 {
+		mmGetReadCursor.mock.t.Fatalf("Times of ChatRepositoryMock.GetReadCursor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadCursor.expectedInvocations, n)
+	mmGetReadCursor.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor
+}
+
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) invocationsDone() bool {
+	if len(mmGetReadCursor.expectations) == 0 && mmGetReadCursor.defaultExpectation == nil && mmGetReadCursor.mock.funcGetReadCursor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadCursor.mock.afterGetReadCursorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadCursor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadCursor implements mm_repository.ChatRepository
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursor(ctx context.Context, chatID int64, username string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetReadCursor.beforeGetReadCursorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadCursor.afterGetReadCursorCounter, 1)
+
+	mmGetReadCursor.t.Helper()
+
+	if mmGetReadCursor.inspectFuncGetReadCursor != nil {
+		mmGetReadCursor.inspectFuncGetReadCursor(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+
+	// Record call args
+	mmGetReadCursor.GetReadCursorMock.mutex.Lock()
+	mmGetReadCursor.GetReadCursorMock.callArgs = append(mmGetReadCursor.GetReadCursorMock.callArgs, &mm_params)
+	mmGetReadCursor.GetReadCursorMock.mutex.Unlock()
+
+	for _, e := range mmGetReadCursor.GetReadCursorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetReadCursor.GetReadCursorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadCursor.GetReadCursorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadCursor.GetReadCursorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadCursor.GetReadCursorMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadCursor.GetReadCursorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadCursor.t.Fatal("No results are set for the ChatRepositoryMock.GetReadCursor")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetReadCursor.funcGetReadCursor != nil {
+		return mmGetReadCursor.funcGetReadCursor(ctx, chatID, username)
+	}
+	mmGetReadCursor.t.Fatalf("Unexpected call to ChatRepositoryMock.GetReadCursor. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetReadCursorAfterCounter returns a count of finished ChatRepositoryMock.GetReadCursor invocations
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursor.afterGetReadCursorCounter)
+}
+
+// GetReadCursorBeforeCounter returns a count of ChatRepositoryMock.GetReadCursor invocations
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursor.beforeGetReadCursorCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetReadCursor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Calls() []*ChatRepositoryMockGetReadCursorParams {
+	mmGetReadCursor.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetReadCursorParams, len(mmGetReadCursor.callArgs))
+	copy(argCopy, mmGetReadCursor.callArgs)
+
+	mmGetReadCursor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadCursorDone returns true if the count of the GetReadCursor invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetReadCursorDone() bool {
+	if m.GetReadCursorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadCursorMock.invocationsDone()
+}
+
+// MinimockGetReadCursorInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetReadCursorInspect() {
+	for _, e := range m.GetReadCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReadCursorCounter := mm_atomic.LoadUint64(&m.afterGetReadCursorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadCursorMock.defaultExpectation != nil && afterGetReadCursorCounter < 1 {
+		if m.GetReadCursorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s", m.GetReadCursorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s with params: %#v", m.GetReadCursorMock.defaultExpectation.expectationOrigins.origin, *m.GetReadCursorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadCursor != nil && afterGetReadCursorCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s", m.funcGetReadCursorOrigin)
+	}
+
+	if !m.GetReadCursorMock.invocationsDone() && afterGetReadCursorCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetReadCursor at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadCursorMock.expectedInvocations), m.GetReadCursorMock.expectedInvocationsOrigin, afterGetReadCursorCounter)
+	}
+}
+
+type mChatRepositoryMockGetUserChatIDs struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetUserChatIDsExpectation
+	expectations       []*ChatRepositoryMockGetUserChatIDsExpectation
+
+	callArgs []*ChatRepositoryMockGetUserChatIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetUserChatIDsExpectation specifies expectation struct of the ChatRepository.GetUserChatIDs
+type ChatRepositoryMockGetUserChatIDsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetUserChatIDsParams
+	paramPtrs          *ChatRepositoryMockGetUserChatIDsParamPtrs
+	expectationOrigins ChatRepositoryMockGetUserChatIDsExpectationOrigins
+	results            *ChatRepositoryMockGetUserChatIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetUserChatIDsParams contains parameters of the ChatRepository.GetUserChatIDs
+type ChatRepositoryMockGetUserChatIDsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ChatRepositoryMockGetUserChatIDsParamPtrs contains pointers to parameters of the ChatRepository.GetUserChatIDs
+type ChatRepositoryMockGetUserChatIDsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ChatRepositoryMockGetUserChatIDsResults contains results of the ChatRepository.GetUserChatIDs
+type ChatRepositoryMockGetUserChatIDsResults struct {
+	ia1 []int64
+	err error
+}
+
+// ChatRepositoryMockGetUserChatIDsOrigins contains origins of expectations of the ChatRepository.GetUserChatIDs
+type ChatRepositoryMockGetUserChatIDsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Optional() *mChatRepositoryMockGetUserChatIDs {
+	mmGetUserChatIDs.optional = true
+	return mmGetUserChatIDs
+}
+
+// Expect sets up expected params for ChatRepository.GetUserChatIDs
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Expect(ctx context.Context, username string) *mChatRepositoryMockGetUserChatIDs {
+	if mmGetUserChatIDs.mock.funcGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Set")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation == nil {
+		mmGetUserChatIDs.defaultExpectation = &ChatRepositoryMockGetUserChatIDsExpectation{}
+	}
+
+	if mmGetUserChatIDs.defaultExpectation.paramPtrs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserChatIDs.defaultExpectation.params = &ChatRepositoryMockGetUserChatIDsParams{ctx, username}
+	mmGetUserChatIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserChatIDs.expectations {
+		if minimock.Equal(e.params, mmGetUserChatIDs.defaultExpectation.params) {
+			mmGetUserChatIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserChatIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserChatIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetUserChatIDs
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetUserChatIDs {
+	if mmGetUserChatIDs.mock.funcGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Set")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation == nil {
+		mmGetUserChatIDs.defaultExpectation = &ChatRepositoryMockGetUserChatIDsExpectation{}
+	}
+
+	if mmGetUserChatIDs.defaultExpectation.params != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Expect")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation.paramPtrs == nil {
+		mmGetUserChatIDs.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatIDsParamPtrs{}
+	}
+	mmGetUserChatIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserChatIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserChatIDs
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatRepository.GetUserChatIDs
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) ExpectUsernameParam2(username string) *mChatRepositoryMockGetUserChatIDs {
+	if mmGetUserChatIDs.mock.funcGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Set")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation == nil {
+		mmGetUserChatIDs.defaultExpectation = &ChatRepositoryMockGetUserChatIDsExpectation{}
+	}
+
+	if mmGetUserChatIDs.defaultExpectation.params != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Expect")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation.paramPtrs == nil {
+		mmGetUserChatIDs.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatIDsParamPtrs{}
+	}
+	mmGetUserChatIDs.defaultExpectation.paramPtrs.username = &username
+	mmGetUserChatIDs.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserChatIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetUserChatIDs
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Inspect(f func(ctx context.Context, username string)) *mChatRepositoryMockGetUserChatIDs {
+	if mmGetUserChatIDs.mock.inspectFuncGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetUserChatIDs")
+	}
+
+	mmGetUserChatIDs.mock.inspectFuncGetUserChatIDs = f
+
+	return mmGetUserChatIDs
+}
+
+// Return sets up results that will be returned by ChatRepository.GetUserChatIDs
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Return(ia1 []int64, err error) *ChatRepositoryMock {
+	if mmGetUserChatIDs.mock.funcGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Set")
+	}
+
+	if mmGetUserChatIDs.defaultExpectation == nil {
+		mmGetUserChatIDs.defaultExpectation = &ChatRepositoryMockGetUserChatIDsExpectation{mock: mmGetUserChatIDs.mock}
+	}
+	mmGetUserChatIDs.defaultExpectation.results = &ChatRepositoryMockGetUserChatIDsResults{ia1, err}
+	mmGetUserChatIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserChatIDs.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetUserChatIDs method
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Set(f func(ctx context.Context, username string) (ia1 []int64, err error)) *ChatRepositoryMock {
+	if mmGetUserChatIDs.defaultExpectation != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetUserChatIDs method")
+	}
+
+	if len(mmGetUserChatIDs.expectations) > 0 {
+		mmGetUserChatIDs.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetUserChatIDs method")
+	}
+
+	mmGetUserChatIDs.mock.funcGetUserChatIDs = f
+	mmGetUserChatIDs.mock.funcGetUserChatIDsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChatIDs.mock
+}
+
+// When sets expectation for the ChatRepository.GetUserChatIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) When(ctx context.Context, username string) *ChatRepositoryMockGetUserChatIDsExpectation {
+	if mmGetUserChatIDs.mock.funcGetUserChatIDs != nil {
+		mmGetUserChatIDs.mock.t.Fatalf("ChatRepositoryMock.GetUserChatIDs mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetUserChatIDsExpectation{
+		mock:               mmGetUserChatIDs.mock,
+		params:             &ChatRepositoryMockGetUserChatIDsParams{ctx, username},
+		expectationOrigins: ChatRepositoryMockGetUserChatIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserChatIDs.expectations = append(mmGetUserChatIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetUserChatIDs return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetUserChatIDsExpectation) Then(ia1 []int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetUserChatIDsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetUserChatIDs should be invoked
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Times(n uint64) *mChatRepositoryMockGetUserChatIDs {
+	if n == 0 {
+		mmGetUserChatIDs.mock.t.Fatalf("Times of ChatRepositoryMock.GetUserChatIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserChatIDs.expectedInvocations, n)
+	mmGetUserChatIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChatIDs
+}
+
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) invocationsDone() bool {
+	if len(mmGetUserChatIDs.expectations) == 0 && mmGetUserChatIDs.defaultExpectation == nil && mmGetUserChatIDs.mock.funcGetUserChatIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserChatIDs.mock.afterGetUserChatIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserChatIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserChatIDs implements mm_repository.ChatRepository
+func (mmGetUserChatIDs *ChatRepositoryMock) GetUserChatIDs(ctx context.Context, username string) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmGetUserChatIDs.beforeGetUserChatIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserChatIDs.afterGetUserChatIDsCounter, 1)
+
+	mmGetUserChatIDs.t.Helper()
+
+	if mmGetUserChatIDs.inspectFuncGetUserChatIDs != nil {
+		mmGetUserChatIDs.inspectFuncGetUserChatIDs(ctx, username)
+	}
+
+	mm_params := ChatRepositoryMockGetUserChatIDsParams{ctx, username}
+
+	// Record call args
+	mmGetUserChatIDs.GetUserChatIDsMock.mutex.Lock()
+	mmGetUserChatIDs.GetUserChatIDsMock.callArgs = append(mmGetUserChatIDs.GetUserChatIDsMock.callArgs, &mm_params)
+	mmGetUserChatIDs.GetUserChatIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserChatIDs.GetUserChatIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetUserChatIDsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserChatIDs.t.Errorf("ChatRepositoryMock.GetUserChatIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserChatIDs.t.Errorf("ChatRepositoryMock.GetUserChatIDs got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserChatIDs.t.Errorf("ChatRepositoryMock.GetUserChatIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserChatIDs.GetUserChatIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserChatIDs.t.Fatal("No results are set for the ChatRepositoryMock.GetUserChatIDs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetUserChatIDs.funcGetUserChatIDs != nil {
+		return mmGetUserChatIDs.funcGetUserChatIDs(ctx, username)
+	}
+	mmGetUserChatIDs.t.Fatalf("Unexpected call to ChatRepositoryMock.GetUserChatIDs. %v %v", ctx, username)
+	return
+}
+
+// GetUserChatIDsAfterCounter returns a count of finished ChatRepositoryMock.GetUserChatIDs invocations
+func (mmGetUserChatIDs *ChatRepositoryMock) GetUserChatIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChatIDs.afterGetUserChatIDsCounter)
+}
+
+// GetUserChatIDsBeforeCounter returns a count of ChatRepositoryMock.GetUserChatIDs invocations
+func (mmGetUserChatIDs *ChatRepositoryMock) GetUserChatIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChatIDs.beforeGetUserChatIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetUserChatIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserChatIDs *mChatRepositoryMockGetUserChatIDs) Calls() []*ChatRepositoryMockGetUserChatIDsParams {
+	mmGetUserChatIDs.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetUserChatIDsParams, len(mmGetUserChatIDs.callArgs))
+	copy(argCopy, mmGetUserChatIDs.callArgs)
+
+	mmGetUserChatIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserChatIDsDone returns true if the count of the GetUserChatIDs invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetUserChatIDsDone() bool {
+	if m.GetUserChatIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserChatIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserChatIDsMock.invocationsDone()
+}
+
+// MinimockGetUserChatIDsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetUserChatIDsInspect() {
+	for _, e := range m.GetUserChatIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChatIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserChatIDsCounter := mm_atomic.LoadUint64(&m.afterGetUserChatIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserChatIDsMock.defaultExpectation != nil && afterGetUserChatIDsCounter < 1 {
+		if m.GetUserChatIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChatIDs at\n%s", m.GetUserChatIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChatIDs at\n%s with params: %#v", m.GetUserChatIDsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserChatIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserChatIDs != nil && afterGetUserChatIDsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChatIDs at\n%s", m.funcGetUserChatIDsOrigin)
+	}
+
+	if !m.GetUserChatIDsMock.invocationsDone() && afterGetUserChatIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetUserChatIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserChatIDsMock.expectedInvocations), m.GetUserChatIDsMock.expectedInvocationsOrigin, afterGetUserChatIDsCounter)
+	}
+}
+
+type mChatRepositoryMockGetUserChats struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetUserChatsExpectation
+	expectations       []*ChatRepositoryMockGetUserChatsExpectation
+
+	callArgs []*ChatRepositoryMockGetUserChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetUserChatsExpectation specifies expectation struct of the ChatRepository.GetUserChats
+type ChatRepositoryMockGetUserChatsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetUserChatsParams
+	paramPtrs          *ChatRepositoryMockGetUserChatsParamPtrs
+	expectationOrigins ChatRepositoryMockGetUserChatsExpectationOrigins
+	results            *ChatRepositoryMockGetUserChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetUserChatsParams contains parameters of the ChatRepository.GetUserChats
+type ChatRepositoryMockGetUserChatsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ChatRepositoryMockGetUserChatsParamPtrs contains pointers to parameters of the ChatRepository.GetUserChats
+type ChatRepositoryMockGetUserChatsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ChatRepositoryMockGetUserChatsResults contains results of the ChatRepository.GetUserChats
+type ChatRepositoryMockGetUserChatsResults struct {
+	cpa1 []*model.Chat
+	err  error
+}
+
+// ChatRepositoryMockGetUserChatsOrigins contains origins of expectations of the ChatRepository.GetUserChats
+type ChatRepositoryMockGetUserChatsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Optional() *mChatRepositoryMockGetUserChats {
+	mmGetUserChats.optional = true
+	return mmGetUserChats
+}
+
+// Expect sets up expected params for ChatRepository.GetUserChats
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Expect(ctx context.Context, username string) *mChatRepositoryMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserChats.defaultExpectation.params = &ChatRepositoryMockGetUserChatsParams{ctx, username}
+	mmGetUserChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserChats.expectations {
+		if minimock.Equal(e.params, mmGetUserChats.defaultExpectation.params) {
+			mmGetUserChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserChats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetUserChats
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.params != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Expect")
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
+		mmGetUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatsParamPtrs{}
+	}
+	mmGetUserChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserChats
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatRepository.GetUserChats
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) ExpectUsernameParam2(username string) *mChatRepositoryMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.params != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Expect")
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
+		mmGetUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatsParamPtrs{}
+	}
+	mmGetUserChats.defaultExpectation.paramPtrs.username = &username
+	mmGetUserChats.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetUserChats
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Inspect(f func(ctx context.Context, username string)) *mChatRepositoryMockGetUserChats {
+	if mmGetUserChats.mock.inspectFuncGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetUserChats")
+	}
+
+	mmGetUserChats.mock.inspectFuncGetUserChats = f
+
+	return mmGetUserChats
+}
+
+// Return sets up results that will be returned by ChatRepository.GetUserChats
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Return(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{mock: mmGetUserChats.mock}
+	}
+	mmGetUserChats.defaultExpectation.results = &ChatRepositoryMockGetUserChatsResults{cpa1, err}
+	mmGetUserChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetUserChats method
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Set(f func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)) *ChatRepositoryMock {
+	if mmGetUserChats.defaultExpectation != nil {
+		mmGetUserChats.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetUserChats method")
+	}
+
+	if len(mmGetUserChats.expectations) > 0 {
+		mmGetUserChats.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetUserChats method")
+	}
+
+	mmGetUserChats.mock.funcGetUserChats = f
+	mmGetUserChats.mock.funcGetUserChatsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats.mock
+}
+
+// When sets expectation for the ChatRepository.GetUserChats which will trigger the result defined by the following
+// Then helper
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) When(ctx context.Context, username string) *ChatRepositoryMockGetUserChatsExpectation {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetUserChatsExpectation{
+		mock:               mmGetUserChats.mock,
+		params:             &ChatRepositoryMockGetUserChatsParams{ctx, username},
+		expectationOrigins: ChatRepositoryMockGetUserChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserChats.expectations = append(mmGetUserChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetUserChats return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetUserChatsExpectation) Then(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetUserChatsResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetUserChats should be invoked
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Times(n uint64) *mChatRepositoryMockGetUserChats {
+	if n == 0 {
+		mmGetUserChats.mock.t.Fatalf("Times of ChatRepositoryMock.GetUserChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserChats.expectedInvocations, n)
+	mmGetUserChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats
+}
+
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) invocationsDone() bool {
+	if len(mmGetUserChats.expectations) == 0 && mmGetUserChats.defaultExpectation == nil && mmGetUserChats.mock.funcGetUserChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserChats.mock.afterGetUserChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserChats implements mm_repository.ChatRepository
+func (mmGetUserChats *ChatRepositoryMock) GetUserChats(ctx context.Context, username string) (cpa1 []*model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetUserChats.beforeGetUserChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserChats.afterGetUserChatsCounter, 1)
+
+	mmGetUserChats.t.Helper()
+
+	if mmGetUserChats.inspectFuncGetUserChats != nil {
+		mmGetUserChats.inspectFuncGetUserChats(ctx, username)
+	}
+
+	mm_params := ChatRepositoryMockGetUserChatsParams{ctx, username}
+
+	// Record call args
+	mmGetUserChats.GetUserChatsMock.mutex.Lock()
+	mmGetUserChats.GetUserChatsMock.callArgs = append(mmGetUserChats.GetUserChatsMock.callArgs, &mm_params)
+	mmGetUserChats.GetUserChatsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserChats.GetUserChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetUserChats.GetUserChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserChats.GetUserChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserChats.GetUserChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserChats.GetUserChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetUserChatsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserChats.GetUserChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserChats.t.Fatal("No results are set for the ChatRepositoryMock.GetUserChats")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetUserChats.funcGetUserChats != nil {
+		return mmGetUserChats.funcGetUserChats(ctx, username)
+	}
+	mmGetUserChats.t.Fatalf("Unexpected call to ChatRepositoryMock.GetUserChats. %v %v", ctx, username)
+	return
+}
+
+// GetUserChatsAfterCounter returns a count of finished ChatRepositoryMock.GetUserChats invocations
+func (mmGetUserChats *ChatRepositoryMock) GetUserChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChats.afterGetUserChatsCounter)
+}
+
+// GetUserChatsBeforeCounter returns a count of ChatRepositoryMock.GetUserChats invocations
+func (mmGetUserChats *ChatRepositoryMock) GetUserChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChats.beforeGetUserChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetUserChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserChats *mChatRepositoryMockGetUserChats) Calls() []*ChatRepositoryMockGetUserChatsParams {
+	mmGetUserChats.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetUserChatsParams, len(mmGetUserChats.callArgs))
+	copy(argCopy, mmGetUserChats.callArgs)
+
+	mmGetUserChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserChatsDone returns true if the count of the GetUserChats invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetUserChatsDone() bool {
+	if m.GetUserChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserChatsMock.invocationsDone()
+}
+
+// MinimockGetUserChatsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetUserChatsInspect() {
+	for _, e := range m.GetUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserChatsCounter := mm_atomic.LoadUint64(&m.afterGetUserChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserChatsMock.defaultExpectation != nil && afterGetUserChatsCounter < 1 {
+		if m.GetUserChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s", m.GetUserChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s with params: %#v", m.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserChats != nil && afterGetUserChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s", m.funcGetUserChatsOrigin)
+	}
+
+	if !m.GetUserChatsMock.invocationsDone() && afterGetUserChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetUserChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserChatsMock.expectedInvocations), m.GetUserChatsMock.expectedInvocationsOrigin, afterGetUserChatsCounter)
+	}
+}
+
+type mChatRepositoryMockMarkRead struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockMarkReadExpectation
+	expectations       []*ChatRepositoryMockMarkReadExpectation
+
+	callArgs []*ChatRepositoryMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockMarkReadExpectation specifies expectation struct of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockMarkReadParams
+	paramPtrs          *ChatRepositoryMockMarkReadParamPtrs
+	expectationOrigins ChatRepositoryMockMarkReadExpectationOrigins
+	results            *ChatRepositoryMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockMarkReadParams contains parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParams struct {
+	ctx       context.Context
+	chatID    int64
+	username  string
+	messageID int64
+}
+
+// ChatRepositoryMockMarkReadParamPtrs contains pointers to parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	username  *string
+	messageID *int64
+}
+
+// ChatRepositoryMockMarkReadResults contains results of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockMarkReadOrigins contains origins of expectations of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originUsername  string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatRepositoryMockMarkRead) Optional() *mChatRepositoryMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Expect(ctx context.Context, chatID int64, username string, messageID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatRepositoryMockMarkReadParams{ctx, chatID, username, messageID}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+	mmMarkRead.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectUsernameParam3(username string) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.username = &username
+	mmMarkRead.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectMessageIDParam4 sets up expected param messageID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectMessageIDParam4(messageID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.messageID = &messageID
+	mmMarkRead.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Inspect(f func(ctx context.Context, chatID int64, username string, messageID int64)) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatRepositoryMockMarkReadResults{b1, err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatRepository.MarkRead method
+func (mmMarkRead *mChatRepositoryMockMarkRead) Set(f func(ctx context.Context, chatID int64, username string, messageID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatRepository.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatRepository.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatRepository.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatRepositoryMockMarkRead) When(ctx context.Context, chatID int64, username string, messageID int64) *ChatRepositoryMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatRepositoryMockMarkReadParams{ctx, chatID, username, messageID},
+		expectationOrigins: ChatRepositoryMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockMarkReadExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockMarkReadResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.MarkRead should be invoked
+func (mmMarkRead *mChatRepositoryMockMarkRead) Times(n uint64) *mChatRepositoryMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatRepositoryMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatRepositoryMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_repository.ChatRepository
+func (mmMarkRead *ChatRepositoryMock) MarkRead(ctx context.Context, chatID int64, username string, messageID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, chatID, username, messageID)
+	}
+
+	mm_params := ChatRepositoryMockMarkReadParams{ctx, chatID, username, messageID}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockMarkReadParams{ctx, chatID, username, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatRepositoryMock.MarkRead")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, chatID, username, messageID)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatRepositoryMock.MarkRead. %v %v %v %v", ctx, chatID, username, messageID)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatRepositoryMockMarkRead) Calls() []*ChatRepositoryMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatRepositoryMockPinMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockPinMessageExpectation
+	expectations       []*ChatRepositoryMockPinMessageExpectation
+
+	callArgs []*ChatRepositoryMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockPinMessageExpectation specifies expectation struct of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockPinMessageParams
+	paramPtrs          *ChatRepositoryMockPinMessageParamPtrs
+	expectationOrigins ChatRepositoryMockPinMessageExpectationOrigins
+	results            *ChatRepositoryMockPinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockPinMessageParams contains parameters of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	username  string
+}
+
+// ChatRepositoryMockPinMessageParamPtrs contains pointers to parameters of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	username  *string
+}
+
+// ChatRepositoryMockPinMessageResults contains results of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockPinMessageOrigins contains origins of expectations of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originUsername  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatRepositoryMockPinMessage) Optional() *mChatRepositoryMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Expect(ctx context.Context, chatID int64, messageID int64, username string) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatRepositoryMockPinMessageParams{ctx, chatID, messageID, username}
+	mmPinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmPinMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectMessageIDParam3(messageID int64) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmPinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectUsernameParam4 sets up expected param username for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectUsernameParam4(username string) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.username = &username
+	mmPinMessage.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, username string)) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatRepositoryMockPinMessageResults{b1, err}
+	mmPinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.PinMessage method
+func (mmPinMessage *mChatRepositoryMockPinMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	mmPinMessage.mock.funcPinMessageOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatRepository.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatRepositoryMockPinMessage) When(ctx context.Context, chatID int64, messageID int64, username string) *ChatRepositoryMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockPinMessageExpectation{
+		mock:               mmPinMessage.mock,
+		params:             &ChatRepositoryMockPinMessageParams{ctx, chatID, messageID, username},
+		expectationOrigins: ChatRepositoryMockPinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockPinMessageExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockPinMessageResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.PinMessage should be invoked
+func (mmPinMessage *mChatRepositoryMockPinMessage) Times(n uint64) *mChatRepositoryMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatRepositoryMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	mmPinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatRepositoryMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements mm_repository.ChatRepository
+func (mmPinMessage *ChatRepositoryMock) PinMessage(ctx context.Context, chatID int64, messageID int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	mmPinMessage.t.Helper()
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, chatID, messageID, username)
+	}
+
+	mm_params := ChatRepositoryMockPinMessageParams{ctx, chatID, messageID, username}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockPinMessageParams{ctx, chatID, messageID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatRepositoryMock.PinMessage")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, chatID, messageID, username)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.PinMessage. %v %v %v %v", ctx, chatID, messageID, username)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatRepositoryMock.PinMessage invocations
+func (mmPinMessage *ChatRepositoryMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatRepositoryMock.PinMessage invocations
+func (mmPinMessage *ChatRepositoryMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatRepositoryMockPinMessage) Calls() []*ChatRepositoryMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s", m.PinMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s with params: %#v", m.PinMessageMock.defaultExpectation.expectationOrigins.origin, *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s", m.funcPinMessageOrigin)
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.PinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), m.PinMessageMock.expectedInvocationsOrigin, afterPinMessageCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveChatMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveChatMemberExpectation
+	expectations       []*ChatRepositoryMockRemoveChatMemberExpectation
+
+	callArgs []*ChatRepositoryMockRemoveChatMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRemoveChatMemberExpectation specifies expectation struct of the ChatRepository.RemoveChatMember
+type ChatRepositoryMockRemoveChatMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRemoveChatMemberParams
+	paramPtrs          *ChatRepositoryMockRemoveChatMemberParamPtrs
+	expectationOrigins ChatRepositoryMockRemoveChatMemberExpectationOrigins
+	results            *ChatRepositoryMockRemoveChatMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRemoveChatMemberParams contains parameters of the ChatRepository.RemoveChatMember
+type ChatRepositoryMockRemoveChatMemberParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockRemoveChatMemberParamPtrs contains pointers to parameters of the ChatRepository.RemoveChatMember
+type ChatRepositoryMockRemoveChatMemberParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockRemoveChatMemberResults contains results of the ChatRepository.RemoveChatMember
+type ChatRepositoryMockRemoveChatMemberResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockRemoveChatMemberOrigins contains origins of expectations of the ChatRepository.RemoveChatMember
+type ChatRepositoryMockRemoveChatMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Optional() *mChatRepositoryMockRemoveChatMember {
+	mmRemoveChatMember.optional = true
+	return mmRemoveChatMember
+}
+
+// Expect sets up expected params for ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatRepositoryMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveChatMember.defaultExpectation.params = &ChatRepositoryMockRemoveChatMemberParams{ctx, chatID, username}
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveChatMember.expectations {
+		if minimock.Equal(e.params, mmRemoveChatMember.defaultExpectation.params) {
+			mmRemoveChatMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveChatMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveChatMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatRepositoryMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatRepositoryMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) ExpectUsernameParam3(username string) *mChatRepositoryMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatRepositoryMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.username = &username
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockRemoveChatMember {
+	if mmRemoveChatMember.mock.inspectFuncRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveChatMember")
+	}
+
+	mmRemoveChatMember.mock.inspectFuncRemoveChatMember = f
+
+	return mmRemoveChatMember
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveChatMember
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatRepositoryMockRemoveChatMemberExpectation{mock: mmRemoveChatMember.mock}
+	}
+	mmRemoveChatMember.defaultExpectation.results = &ChatRepositoryMockRemoveChatMemberResults{b1, err}
+	mmRemoveChatMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveChatMember method
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Set(f func(ctx context.Context, chatID int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmRemoveChatMember.defaultExpectation != nil {
+		mmRemoveChatMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveChatMember method")
+	}
+
+	if len(mmRemoveChatMember.expectations) > 0 {
+		mmRemoveChatMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveChatMember method")
+	}
+
+	mmRemoveChatMember.mock.funcRemoveChatMember = f
+	mmRemoveChatMember.mock.funcRemoveChatMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveChatMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockRemoveChatMemberExpectation {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveChatMemberExpectation{
+		mock:               mmRemoveChatMember.mock,
+		params:             &ChatRepositoryMockRemoveChatMemberParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockRemoveChatMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveChatMember.expectations = append(mmRemoveChatMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveChatMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveChatMemberExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveChatMemberResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveChatMember should be invoked
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Times(n uint64) *mChatRepositoryMockRemoveChatMember {
+	if n == 0 {
+		mmRemoveChatMember.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveChatMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveChatMember.expectedInvocations, n)
+	mmRemoveChatMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember
+}
+
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) invocationsDone() bool {
+	if len(mmRemoveChatMember.expectations) == 0 && mmRemoveChatMember.defaultExpectation == nil && mmRemoveChatMember.mock.funcRemoveChatMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveChatMember.mock.afterRemoveChatMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveChatMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveChatMember implements mm_repository.ChatRepository
+func (mmRemoveChatMember *ChatRepositoryMock) RemoveChatMember(ctx context.Context, chatID int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveChatMember.beforeRemoveChatMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveChatMember.afterRemoveChatMemberCounter, 1)
+
+	mmRemoveChatMember.t.Helper()
+
+	if mmRemoveChatMember.inspectFuncRemoveChatMember != nil {
+		mmRemoveChatMember.inspectFuncRemoveChatMember(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockRemoveChatMemberParams{ctx, chatID, username}
+
+	// Record call args
+	mmRemoveChatMember.RemoveChatMemberMock.mutex.Lock()
+	mmRemoveChatMember.RemoveChatMemberMock.callArgs = append(mmRemoveChatMember.RemoveChatMemberMock.callArgs, &mm_params)
+	mmRemoveChatMember.RemoveChatMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveChatMember.RemoveChatMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveChatMemberParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveChatMember.t.Errorf("ChatRepositoryMock.RemoveChatMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveChatMember.t.Errorf("ChatRepositoryMock.RemoveChatMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveChatMember.t.Errorf("ChatRepositoryMock.RemoveChatMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveChatMember.t.Errorf("ChatRepositoryMock.RemoveChatMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveChatMember.t.Fatal("No results are set for the ChatRepositoryMock.RemoveChatMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveChatMember.funcRemoveChatMember != nil {
+		return mmRemoveChatMember.funcRemoveChatMember(ctx, chatID, username)
+	}
+	mmRemoveChatMember.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveChatMember. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// RemoveChatMemberAfterCounter returns a count of finished ChatRepositoryMock.RemoveChatMember invocations
+func (mmRemoveChatMember *ChatRepositoryMock) RemoveChatMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMember.afterRemoveChatMemberCounter)
+}
+
+// RemoveChatMemberBeforeCounter returns a count of ChatRepositoryMock.RemoveChatMember invocations
+func (mmRemoveChatMember *ChatRepositoryMock) RemoveChatMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMember.beforeRemoveChatMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveChatMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveChatMember *mChatRepositoryMockRemoveChatMember) Calls() []*ChatRepositoryMockRemoveChatMemberParams {
+	mmRemoveChatMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveChatMemberParams, len(mmRemoveChatMember.callArgs))
+	copy(argCopy, mmRemoveChatMember.callArgs)
+
+	mmRemoveChatMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveChatMemberDone returns true if the count of the RemoveChatMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveChatMemberDone() bool {
+	if m.RemoveChatMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveChatMemberMock.invocationsDone()
+}
+
+// MinimockRemoveChatMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveChatMemberInspect() {
+	for _, e := range m.RemoveChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveChatMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveChatMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveChatMemberMock.defaultExpectation != nil && afterRemoveChatMemberCounter < 1 {
+		if m.RemoveChatMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMember at\n%s", m.RemoveChatMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMember at\n%s with params: %#v", m.RemoveChatMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveChatMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveChatMember != nil && afterRemoveChatMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMember at\n%s", m.funcRemoveChatMemberOrigin)
+	}
+
+	if !m.RemoveChatMemberMock.invocationsDone() && afterRemoveChatMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveChatMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveChatMemberMock.expectedInvocations), m.RemoveChatMemberMock.expectedInvocationsOrigin, afterRemoveChatMemberCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveReaction struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveReactionExpectation
+	expectations       []*ChatRepositoryMockRemoveReactionExpectation
+
+	callArgs []*ChatRepositoryMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRemoveReactionExpectation specifies expectation struct of the ChatRepository.RemoveReaction
+type ChatRepositoryMockRemoveReactionExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRemoveReactionParams
+	paramPtrs          *ChatRepositoryMockRemoveReactionParamPtrs
+	expectationOrigins ChatRepositoryMockRemoveReactionExpectationOrigins
+	results            *ChatRepositoryMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRemoveReactionParams contains parameters of the ChatRepository.RemoveReaction
+type ChatRepositoryMockRemoveReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	username  string
+	emoji     string
+}
+
+// ChatRepositoryMockRemoveReactionParamPtrs contains pointers to parameters of the ChatRepository.RemoveReaction
+type ChatRepositoryMockRemoveReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	username  *string
+	emoji     *string
+}
+
+// ChatRepositoryMockRemoveReactionResults contains results of the ChatRepository.RemoveReaction
+type ChatRepositoryMockRemoveReactionResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockRemoveReactionOrigins contains origins of expectations of the ChatRepository.RemoveReaction
+type ChatRepositoryMockRemoveReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUsername  string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Optional() *mChatRepositoryMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Expect(ctx context.Context, messageID int64, username string, emoji string) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ChatRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) ExpectMessageIDParam2(messageID int64) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) ExpectUsernameParam3(username string) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.username = &username
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) ExpectEmojiParam4(emoji string) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Inspect(f func(ctx context.Context, messageID int64, username string, emoji string)) *mChatRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveReaction
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatRepositoryMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ChatRepositoryMockRemoveReactionResults{b1, err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveReaction method
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Set(f func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) When(ctx context.Context, messageID int64, username string, emoji string) *ChatRepositoryMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &ChatRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji},
+		expectationOrigins: ChatRepositoryMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveReactionExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveReaction should be invoked
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Times(n uint64) *mChatRepositoryMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_repository.ChatRepository
+func (mmRemoveReaction *ChatRepositoryMock) RemoveReaction(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, messageID, username, emoji)
+	}
+
+	mm_params := ChatRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ChatRepositoryMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemoveReaction.t.Errorf("ChatRepositoryMock.RemoveReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveReaction.t.Errorf("ChatRepositoryMock.RemoveReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("ChatRepositoryMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ChatRepositoryMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ChatRepositoryMock.RemoveReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, messageID, username, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveReaction. %v %v %v %v", ctx, messageID, username, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ChatRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatRepositoryMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ChatRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatRepositoryMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mChatRepositoryMockRemoveReaction) Calls() []*ChatRepositoryMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mChatRepositoryMockRenameChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRenameChatExpectation
+	expectations       []*ChatRepositoryMockRenameChatExpectation
+
+	callArgs []*ChatRepositoryMockRenameChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRenameChatExpectation specifies expectation struct of the ChatRepository.RenameChat
+type ChatRepositoryMockRenameChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRenameChatParams
+	paramPtrs          *ChatRepositoryMockRenameChatParamPtrs
+	expectationOrigins ChatRepositoryMockRenameChatExpectationOrigins
+	results            *ChatRepositoryMockRenameChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRenameChatParams contains parameters of the ChatRepository.RenameChat
+type ChatRepositoryMockRenameChatParams struct {
+	ctx    context.Context
+	chatID int64
+	name   string
+}
+
+// ChatRepositoryMockRenameChatParamPtrs contains pointers to parameters of the ChatRepository.RenameChat
+type ChatRepositoryMockRenameChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	name   *string
+}
+
+// ChatRepositoryMockRenameChatResults contains results of the ChatRepository.RenameChat
+type ChatRepositoryMockRenameChatResults struct {
+	err error
+}
+
+// ChatRepositoryMockRenameChatOrigins contains origins of expectations of the ChatRepository.RenameChat
+type ChatRepositoryMockRenameChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originName   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRenameChat *mChatRepositoryMockRenameChat) Optional() *mChatRepositoryMockRenameChat {
+	mmRenameChat.optional = true
+	return mmRenameChat
+}
+
+// Expect sets up expected params for ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) Expect(ctx context.Context, chatID int64, name string) *mChatRepositoryMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatRepositoryMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by ExpectParams functions")
+	}
+
+	mmRenameChat.defaultExpectation.params = &ChatRepositoryMockRenameChatParams{ctx, chatID, name}
+	mmRenameChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRenameChat.expectations {
+		if minimock.Equal(e.params, mmRenameChat.defaultExpectation.params) {
+			mmRenameChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenameChat.defaultExpectation.params)
+		}
+	}
+
+	return mmRenameChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatRepositoryMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatRepositoryMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRenameChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatRepositoryMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatRepositoryMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRenameChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// ExpectNameParam3 sets up expected param name for ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) ExpectNameParam3(name string) *mChatRepositoryMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatRepositoryMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatRepositoryMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.name = &name
+	mmRenameChat.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) Inspect(f func(ctx context.Context, chatID int64, name string)) *mChatRepositoryMockRenameChat {
+	if mmRenameChat.mock.inspectFuncRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RenameChat")
+	}
+
+	mmRenameChat.mock.inspectFuncRenameChat = f
+
+	return mmRenameChat
+}
+
+// Return sets up results that will be returned by ChatRepository.RenameChat
+func (mmRenameChat *mChatRepositoryMockRenameChat) Return(err error) *ChatRepositoryMock {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatRepositoryMockRenameChatExpectation{mock: mmRenameChat.mock}
+	}
+	mmRenameChat.defaultExpectation.results = &ChatRepositoryMockRenameChatResults{err}
+	mmRenameChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRenameChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RenameChat method
+func (mmRenameChat *mChatRepositoryMockRenameChat) Set(f func(ctx context.Context, chatID int64, name string) (err error)) *ChatRepositoryMock {
+	if mmRenameChat.defaultExpectation != nil {
+		mmRenameChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RenameChat method")
+	}
+
+	if len(mmRenameChat.expectations) > 0 {
+		mmRenameChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RenameChat method")
+	}
+
+	mmRenameChat.mock.funcRenameChat = f
+	mmRenameChat.mock.funcRenameChatOrigin = minimock.CallerInfo(1)
+	return mmRenameChat.mock
+}
+
+// When sets expectation for the ChatRepository.RenameChat which will trigger the result defined by the following
+// Then helper
+func (mmRenameChat *mChatRepositoryMockRenameChat) When(ctx context.Context, chatID int64, name string) *ChatRepositoryMockRenameChatExpectation {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatRepositoryMock.RenameChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRenameChatExpectation{
+		mock:               mmRenameChat.mock,
+		params:             &ChatRepositoryMockRenameChatParams{ctx, chatID, name},
+		expectationOrigins: ChatRepositoryMockRenameChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRenameChat.expectations = append(mmRenameChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RenameChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRenameChatExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRenameChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RenameChat should be invoked
+func (mmRenameChat *mChatRepositoryMockRenameChat) Times(n uint64) *mChatRepositoryMockRenameChat {
+	if n == 0 {
+		mmRenameChat.mock.t.Fatalf("Times of ChatRepositoryMock.RenameChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRenameChat.expectedInvocations, n)
+	mmRenameChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRenameChat
+}
+
+func (mmRenameChat *mChatRepositoryMockRenameChat) invocationsDone() bool {
+	if len(mmRenameChat.expectations) == 0 && mmRenameChat.defaultExpectation == nil && mmRenameChat.mock.funcRenameChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRenameChat.mock.afterRenameChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRenameChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RenameChat implements mm_repository.ChatRepository
+func (mmRenameChat *ChatRepositoryMock) RenameChat(ctx context.Context, chatID int64, name string) (err error) {
+	mm_atomic.AddUint64(&mmRenameChat.beforeRenameChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenameChat.afterRenameChatCounter, 1)
+
+	mmRenameChat.t.Helper()
+
+	if mmRenameChat.inspectFuncRenameChat != nil {
+		mmRenameChat.inspectFuncRenameChat(ctx, chatID, name)
+	}
+
+	mm_params := ChatRepositoryMockRenameChatParams{ctx, chatID, name}
+
+	// Record call args
+	mmRenameChat.RenameChatMock.mutex.Lock()
+	mmRenameChat.RenameChatMock.callArgs = append(mmRenameChat.RenameChatMock.callArgs, &mm_params)
+	mmRenameChat.RenameChatMock.mutex.Unlock()
+
+	for _, e := range mmRenameChat.RenameChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRenameChat.RenameChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenameChat.RenameChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenameChat.RenameChatMock.defaultExpectation.params
+		mm_want_ptrs := mmRenameChat.RenameChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRenameChatParams{ctx, chatID, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRenameChat.t.Errorf("ChatRepositoryMock.RenameChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRenameChat.t.Errorf("ChatRepositoryMock.RenameChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmRenameChat.t.Errorf("ChatRepositoryMock.RenameChat got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenameChat.t.Errorf("ChatRepositoryMock.RenameChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenameChat.RenameChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenameChat.t.Fatal("No results are set for the ChatRepositoryMock.RenameChat")
+		}
+		return (*mm_results).err
+	}
+	if mmRenameChat.funcRenameChat != nil {
+		return mmRenameChat.funcRenameChat(ctx, chatID, name)
+	}
+	mmRenameChat.t.Fatalf("Unexpected call to ChatRepositoryMock.RenameChat. %v %v %v", ctx, chatID, name)
+	return
+}
+
+// RenameChatAfterCounter returns a count of finished ChatRepositoryMock.RenameChat invocations
+func (mmRenameChat *ChatRepositoryMock) RenameChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.afterRenameChatCounter)
+}
+
+// RenameChatBeforeCounter returns a count of ChatRepositoryMock.RenameChat invocations
+func (mmRenameChat *ChatRepositoryMock) RenameChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.beforeRenameChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RenameChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenameChat *mChatRepositoryMockRenameChat) Calls() []*ChatRepositoryMockRenameChatParams {
+	mmRenameChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRenameChatParams, len(mmRenameChat.callArgs))
+	copy(argCopy, mmRenameChat.callArgs)
+
+	mmRenameChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenameChatDone returns true if the count of the RenameChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRenameChatDone() bool {
+	if m.RenameChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RenameChatMock.invocationsDone()
+}
+
+// MinimockRenameChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRenameChatInspect() {
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RenameChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRenameChatCounter := mm_atomic.LoadUint64(&m.afterRenameChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenameChatMock.defaultExpectation != nil && afterRenameChatCounter < 1 {
+		if m.RenameChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RenameChat at\n%s", m.RenameChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RenameChat at\n%s with params: %#v", m.RenameChatMock.defaultExpectation.expectationOrigins.origin, *m.RenameChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenameChat != nil && afterRenameChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RenameChat at\n%s", m.funcRenameChatOrigin)
+	}
+
+	if !m.RenameChatMock.invocationsDone() && afterRenameChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RenameChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RenameChatMock.expectedInvocations), m.RenameChatMock.expectedInvocationsOrigin, afterRenameChatCounter)
+	}
+}
+
+type mChatRepositoryMockSendMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSendMessageExpectation
+	expectations       []*ChatRepositoryMockSendMessageExpectation
+
+	callArgs []*ChatRepositoryMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSendMessageParams
+	paramPtrs          *ChatRepositoryMockSendMessageParamPtrs
+	expectationOrigins ChatRepositoryMockSendMessageExpectationOrigins
+	results            *ChatRepositoryMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParams struct {
+	ctx     context.Context
+	message *model.Message
+}
+
+// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParamPtrs struct {
+	ctx     *context.Context
+	message **model.Message
+}
+
+// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatRepositoryMockSendMessageOrigins contains origins of expectations of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, message *model.Message) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, message}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectMessageParam2(message *model.Message) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.message = &message
+	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, message *model.Message)) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Return(mp1 *model.Message, err error) *ChatRepositoryMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{mp1, err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SendMessage method
+func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, message *model.Message) (mp1 *model.Message, err error)) *ChatRepositoryMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatRepository.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, message *model.Message) *ChatRepositoryMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &ChatRepositoryMockSendMessageParams{ctx, message},
+		expectationOrigins: ChatRepositoryMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSendMessageExpectation) Then(mp1 *model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSendMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.SendMessage should be invoked
+func (mmSendMessage *mChatRepositoryMockSendMessage) Times(n uint64) *mChatRepositoryMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatRepositoryMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements mm_repository.ChatRepository
+func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, message *model.Message) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	}
+
+	mm_params := ChatRepositoryMockSendMessageParams{ctx, message}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockSendMessageParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, message)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.SendMessage. %v %v", ctx, message)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatRepositoryMock.SendMessage invocations
+func (mmSendMessage *ChatRepositoryMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatRepositoryMock.SendMessage invocations
+func (mmSendMessage *ChatRepositoryMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatRepositoryMockSendMessage) Calls() []*ChatRepositoryMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
+}
+
+type mChatRepositoryMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSetMemberRoleExpectation
+	expectations       []*ChatRepositoryMockSetMemberRoleExpectation
+
+	callArgs []*ChatRepositoryMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSetMemberRoleExpectation specifies expectation struct of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSetMemberRoleParams
+	paramPtrs          *ChatRepositoryMockSetMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockSetMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockSetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSetMemberRoleParams contains parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+	role     model.Role
+}
+
+// ChatRepositoryMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+	role     *model.Role
+}
+
+// ChatRepositoryMockSetMemberRoleResults contains results of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleResults struct {
+	err error
+}
+
+// ChatRepositoryMockSetMemberRoleOrigins contains origins of expectations of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+	originRole     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Optional() *mChatRepositoryMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Expect(ctx context.Context, chatID int64, username string, role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectUsernameParam3(username string) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.username = &username
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectRoleParam4 sets up expected param role for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectRoleParam4(role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, username string, role model.Role)) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetMemberRole")
+	}
+
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
+
+	return mmSetMemberRole
+}
+
+// Return sets up results that will be returned by ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Return(err error) *ChatRepositoryMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
+	}
+	mmSetMemberRole.defaultExpectation.results = &ChatRepositoryMockSetMemberRoleResults{err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SetMemberRole method
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, username string, role model.Role) (err error)) *ChatRepositoryMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetMemberRole method")
+	}
+
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetMemberRole method")
+	}
+
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// When sets expectation for the ChatRepository.SetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) When(ctx context.Context, chatID int64, username string, role model.Role) *ChatRepositoryMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role},
+		expectationOrigins: ChatRepositoryMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.DeleteChat return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockDeleteChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockDeleteChatResults{ep1, err}
+// Then sets up ChatRepository.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetMemberRoleExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetMemberRoleResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.DeleteChat should be invoked
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Times(n uint64) *mChatRepositoryMockDeleteChat {
+// Times sets number of times ChatRepository.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Times(n uint64) *mChatRepositoryMockSetMemberRole {
 	if n == 0 {
-		mmDeleteChat.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteChat mock can not be zero")
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.SetMemberRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
-	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
 }
 
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) invocationsDone() bool {
-	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteChat implements mm_repository.ChatRepository
-func (mmDeleteChat *ChatRepositoryMock) DeleteChat(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error) {
-	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+// SetMemberRole implements mm_repository.ChatRepository
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
 
-	mmDeleteChat.t.Helper()
+	mmSetMemberRole.t.Helper()
 
-	if mmDeleteChat.inspectFuncDeleteChat != nil {
-		mmDeleteChat.inspectFuncDeleteChat(ctx, chatID)
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, username, role)
 	}
 
-	mm_params := ChatRepositoryMockDeleteChatParams{ctx, chatID}
+	mm_params := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
 
 	// Record call args
-	mmDeleteChat.DeleteChatMock.mutex.Lock()
-	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
-	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
 
-	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ep1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockDeleteChatParams{ctx, chatID}
+		mm_got := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
-				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteChat.t.Fatal("No results are set for the ChatRepositoryMock.DeleteChat")
+			mmSetMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.SetMemberRole")
 		}
-		return (*mm_results).ep1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmDeleteChat.funcDeleteChat != nil {
-		return mmDeleteChat.funcDeleteChat(ctx, chatID)
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, username, role)
 	}
-	mmDeleteChat.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteChat. %v %v", ctx, chatID)
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.SetMemberRole. %v %v %v %v", ctx, chatID, username, role)
 	return
 }
 
-// DeleteChatAfterCounter returns a count of finished ChatRepositoryMock.DeleteChat invocations
-func (mmDeleteChat *ChatRepositoryMock) DeleteChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+// SetMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
 }
 
-// DeleteChatBeforeCounter returns a count of ChatRepositoryMock.DeleteChat invocations
-func (mmDeleteChat *ChatRepositoryMock) DeleteChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+// SetMemberRoleBeforeCounter returns a count of ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteChat.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetMemberRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Calls() []*ChatRepositoryMockDeleteChatParams {
-	mmDeleteChat.mutex.RLock()
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Calls() []*ChatRepositoryMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockDeleteChatParams, len(mmDeleteChat.callArgs))
-	copy(argCopy, mmDeleteChat.callArgs)
+	argCopy := make([]*ChatRepositoryMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
 
-	mmDeleteChat.mutex.RUnlock()
+	mmSetMemberRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockDeleteChatDone() bool {
-	if m.DeleteChatMock.optional {
+func (m *ChatRepositoryMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteChatMock.expectations {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteChatMock.invocationsDone()
+	return m.SetMemberRoleMock.invocationsDone()
 }
 
-// MinimockDeleteChatInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
-	for _, e := range m.DeleteChatMock.expectations {
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
-		if m.DeleteChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
 	}
 
-	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
 	}
 }
 
-type mChatRepositoryMockGetUserChats struct {
+type mChatRepositoryMockUnpinMessage struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockGetUserChatsExpectation
-	expectations       []*ChatRepositoryMockGetUserChatsExpectation
+	defaultExpectation *ChatRepositoryMockUnpinMessageExpectation
+	expectations       []*ChatRepositoryMockUnpinMessageExpectation
 
-	callArgs []*ChatRepositoryMockGetUserChatsParams
+	callArgs []*ChatRepositoryMockUnpinMessageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockGetUserChatsExpectation specifies expectation struct of the ChatRepository.GetUserChats
-type ChatRepositoryMockGetUserChatsExpectation struct {
+// ChatRepositoryMockUnpinMessageExpectation specifies expectation struct of the ChatRepository.UnpinMessage
+type ChatRepositoryMockUnpinMessageExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockGetUserChatsParams
-	paramPtrs          *ChatRepositoryMockGetUserChatsParamPtrs
-	expectationOrigins ChatRepositoryMockGetUserChatsExpectationOrigins
-	results            *ChatRepositoryMockGetUserChatsResults
+	params             *ChatRepositoryMockUnpinMessageParams
+	paramPtrs          *ChatRepositoryMockUnpinMessageParamPtrs
+	expectationOrigins ChatRepositoryMockUnpinMessageExpectationOrigins
+	results            *ChatRepositoryMockUnpinMessageResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockGetUserChatsParams contains parameters of the ChatRepository.GetUserChats
-type ChatRepositoryMockGetUserChatsParams struct {
-	ctx      context.Context
-	username string
+// ChatRepositoryMockUnpinMessageParams contains parameters of the ChatRepository.UnpinMessage
+type ChatRepositoryMockUnpinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
 }
 
-// ChatRepositoryMockGetUserChatsParamPtrs contains pointers to parameters of the ChatRepository.GetUserChats
-type ChatRepositoryMockGetUserChatsParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// ChatRepositoryMockUnpinMessageParamPtrs contains pointers to parameters of the ChatRepository.UnpinMessage
+type ChatRepositoryMockUnpinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
 }
 
-// ChatRepositoryMockGetUserChatsResults contains results of the ChatRepository.GetUserChats
-type ChatRepositoryMockGetUserChatsResults struct {
-	cpa1 []*model.Chat
-	err  error
+// ChatRepositoryMockUnpinMessageResults contains results of the ChatRepository.UnpinMessage
+type ChatRepositoryMockUnpinMessageResults struct {
+	b1  bool
+	err error
 }
 
-// ChatRepositoryMockGetUserChatsOrigins contains origins of expectations of the ChatRepository.GetUserChats
-type ChatRepositoryMockGetUserChatsExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// ChatRepositoryMockUnpinMessageOrigins contains origins of expectations of the ChatRepository.UnpinMessage
+type ChatRepositoryMockUnpinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1197,342 +10289,369 @@ type ChatRepositoryMockGetUserChatsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Optional() *mChatRepositoryMockGetUserChats {
-	mmGetUserChats.optional = true
-	return mmGetUserChats
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Optional() *mChatRepositoryMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
 }
 
-// Expect sets up expected params for ChatRepository.GetUserChats
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Expect(ctx context.Context, username string) *mChatRepositoryMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+// Expect sets up expected params for ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Expect(ctx context.Context, chatID int64, messageID int64) *mChatRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatRepositoryMockUnpinMessageExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by ExpectParams functions")
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserChats.defaultExpectation.params = &ChatRepositoryMockGetUserChatsParams{ctx, username}
-	mmGetUserChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserChats.expectations {
-		if minimock.Equal(e.params, mmGetUserChats.defaultExpectation.params) {
-			mmGetUserChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserChats.defaultExpectation.params)
+	mmUnpinMessage.defaultExpectation.params = &ChatRepositoryMockUnpinMessageParams{ctx, chatID, messageID}
+	mmUnpinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserChats
+	return mmUnpinMessage
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetUserChats
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatRepositoryMockUnpinMessageExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.params != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Expect")
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Expect")
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
-		mmGetUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatsParamPtrs{}
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUnpinMessageParamPtrs{}
 	}
-	mmGetUserChats.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserChats
+	return mmUnpinMessage
 }
 
-// ExpectUsernameParam2 sets up expected param username for ChatRepository.GetUserChats
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) ExpectUsernameParam2(username string) *mChatRepositoryMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatRepositoryMockUnpinMessageExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.params != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Expect")
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Expect")
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
-		mmGetUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockGetUserChatsParamPtrs{}
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUnpinMessageParamPtrs{}
 	}
-	mmGetUserChats.defaultExpectation.paramPtrs.username = &username
-	mmGetUserChats.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmUnpinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmGetUserChats
+	return mmUnpinMessage
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetUserChats
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Inspect(f func(ctx context.Context, username string)) *mChatRepositoryMockGetUserChats {
-	if mmGetUserChats.mock.inspectFuncGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetUserChats")
+// ExpectMessageIDParam3 sets up expected param messageID for ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) ExpectMessageIDParam3(messageID int64) *mChatRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
 
-	mmGetUserChats.mock.inspectFuncGetUserChats = f
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatRepositoryMockUnpinMessageExpectation{}
+	}
 
-	return mmGetUserChats
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmUnpinMessage
 }
 
-// Return sets up results that will be returned by ChatRepository.GetUserChats
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Return(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64)) *mChatRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UnpinMessage")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatRepositoryMockGetUserChatsExpectation{mock: mmGetUserChats.mock}
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
+
+	return mmUnpinMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.UnpinMessage
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
-	mmGetUserChats.defaultExpectation.results = &ChatRepositoryMockGetUserChatsResults{cpa1, err}
-	mmGetUserChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats.mock
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatRepositoryMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
+	}
+	mmUnpinMessage.defaultExpectation.results = &ChatRepositoryMockUnpinMessageResults{b1, err}
+	mmUnpinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
 }
 
-// Set uses given function f to mock the ChatRepository.GetUserChats method
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Set(f func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)) *ChatRepositoryMock {
-	if mmGetUserChats.defaultExpectation != nil {
-		mmGetUserChats.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetUserChats method")
+// Set uses given function f to mock the ChatRepository.UnpinMessage method
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Set(f func(ctx context.Context, chatID int64, messageID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UnpinMessage method")
 	}
 
-	if len(mmGetUserChats.expectations) > 0 {
-		mmGetUserChats.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetUserChats method")
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UnpinMessage method")
 	}
 
-	mmGetUserChats.mock.funcGetUserChats = f
-	mmGetUserChats.mock.funcGetUserChatsOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats.mock
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	mmUnpinMessage.mock.funcUnpinMessageOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
 }
 
-// When sets expectation for the ChatRepository.GetUserChats which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.UnpinMessage which will trigger the result defined by the following
 // Then helper
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) When(ctx context.Context, username string) *ChatRepositoryMockGetUserChatsExpectation {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatRepositoryMock.GetUserChats mock is already set by Set")
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) When(ctx context.Context, chatID int64, messageID int64) *ChatRepositoryMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatRepositoryMock.UnpinMessage mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockGetUserChatsExpectation{
-		mock:               mmGetUserChats.mock,
-		params:             &ChatRepositoryMockGetUserChatsParams{ctx, username},
-		expectationOrigins: ChatRepositoryMockGetUserChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockUnpinMessageExpectation{
+		mock:               mmUnpinMessage.mock,
+		params:             &ChatRepositoryMockUnpinMessageParams{ctx, chatID, messageID},
+		expectationOrigins: ChatRepositoryMockUnpinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserChats.expectations = append(mmGetUserChats.expectations, expectation)
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.GetUserChats return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockGetUserChatsExpectation) Then(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockGetUserChatsResults{cpa1, err}
+// Then sets up ChatRepository.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUnpinMessageExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUnpinMessageResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.GetUserChats should be invoked
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Times(n uint64) *mChatRepositoryMockGetUserChats {
+// Times sets number of times ChatRepository.UnpinMessage should be invoked
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Times(n uint64) *mChatRepositoryMockUnpinMessage {
 	if n == 0 {
-		mmGetUserChats.mock.t.Fatalf("Times of ChatRepositoryMock.GetUserChats mock can not be zero")
+		mmUnpinMessage.mock.t.Fatalf("Times of ChatRepositoryMock.UnpinMessage mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserChats.expectedInvocations, n)
-	mmGetUserChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	mmUnpinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage
 }
 
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) invocationsDone() bool {
-	if len(mmGetUserChats.expectations) == 0 && mmGetUserChats.defaultExpectation == nil && mmGetUserChats.mock.funcGetUserChats == nil {
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserChats.mock.afterGetUserChatsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserChats.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserChats implements mm_repository.ChatRepository
-func (mmGetUserChats *ChatRepositoryMock) GetUserChats(ctx context.Context, username string) (cpa1 []*model.Chat, err error) {
-	mm_atomic.AddUint64(&mmGetUserChats.beforeGetUserChatsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserChats.afterGetUserChatsCounter, 1)
+// UnpinMessage implements mm_repository.ChatRepository
+func (mmUnpinMessage *ChatRepositoryMock) UnpinMessage(ctx context.Context, chatID int64, messageID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
 
-	mmGetUserChats.t.Helper()
+	mmUnpinMessage.t.Helper()
 
-	if mmGetUserChats.inspectFuncGetUserChats != nil {
-		mmGetUserChats.inspectFuncGetUserChats(ctx, username)
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, chatID, messageID)
 	}
 
-	mm_params := ChatRepositoryMockGetUserChatsParams{ctx, username}
+	mm_params := ChatRepositoryMockUnpinMessageParams{ctx, chatID, messageID}
 
 	// Record call args
-	mmGetUserChats.GetUserChatsMock.mutex.Lock()
-	mmGetUserChats.GetUserChatsMock.callArgs = append(mmGetUserChats.GetUserChatsMock.callArgs, &mm_params)
-	mmGetUserChats.GetUserChatsMock.mutex.Unlock()
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
 
-	for _, e := range mmGetUserChats.GetUserChatsMock.expectations {
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cpa1, e.results.err
-		}
-	}
-
-	if mmGetUserChats.GetUserChatsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserChats.GetUserChatsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserChats.GetUserChatsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserChats.GetUserChatsMock.defaultExpectation.paramPtrs
+			return e.results.b1, e.results.err
+		}
+	}
 
-		mm_got := ChatRepositoryMockGetUserChatsParams{ctx, username}
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUnpinMessageParams{ctx, chatID, messageID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUnpinMessage.t.Errorf("ChatRepositoryMock.UnpinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmUnpinMessage.t.Errorf("ChatRepositoryMock.UnpinMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmUnpinMessage.t.Errorf("ChatRepositoryMock.UnpinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserChats.t.Errorf("ChatRepositoryMock.GetUserChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnpinMessage.t.Errorf("ChatRepositoryMock.UnpinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserChats.GetUserChatsMock.defaultExpectation.results
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserChats.t.Fatal("No results are set for the ChatRepositoryMock.GetUserChats")
+			mmUnpinMessage.t.Fatal("No results are set for the ChatRepositoryMock.UnpinMessage")
 		}
-		return (*mm_results).cpa1, (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmGetUserChats.funcGetUserChats != nil {
-		return mmGetUserChats.funcGetUserChats(ctx, username)
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, chatID, messageID)
 	}
-	mmGetUserChats.t.Fatalf("Unexpected call to ChatRepositoryMock.GetUserChats. %v %v", ctx, username)
+	mmUnpinMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.UnpinMessage. %v %v %v", ctx, chatID, messageID)
 	return
 }
 
-// GetUserChatsAfterCounter returns a count of finished ChatRepositoryMock.GetUserChats invocations
-func (mmGetUserChats *ChatRepositoryMock) GetUserChatsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserChats.afterGetUserChatsCounter)
+// UnpinMessageAfterCounter returns a count of finished ChatRepositoryMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatRepositoryMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
 }
 
-// GetUserChatsBeforeCounter returns a count of ChatRepositoryMock.GetUserChats invocations
-func (mmGetUserChats *ChatRepositoryMock) GetUserChatsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserChats.beforeGetUserChatsCounter)
+// UnpinMessageBeforeCounter returns a count of ChatRepositoryMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatRepositoryMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetUserChats.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UnpinMessage.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserChats *mChatRepositoryMockGetUserChats) Calls() []*ChatRepositoryMockGetUserChatsParams {
-	mmGetUserChats.mutex.RLock()
+func (mmUnpinMessage *mChatRepositoryMockUnpinMessage) Calls() []*ChatRepositoryMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockGetUserChatsParams, len(mmGetUserChats.callArgs))
-	copy(argCopy, mmGetUserChats.callArgs)
+	argCopy := make([]*ChatRepositoryMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
 
-	mmGetUserChats.mutex.RUnlock()
+	mmUnpinMessage.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserChatsDone returns true if the count of the GetUserChats invocations corresponds
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockGetUserChatsDone() bool {
-	if m.GetUserChatsMock.optional {
+func (m *ChatRepositoryMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserChatsMock.expectations {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserChatsMock.invocationsDone()
+	return m.UnpinMessageMock.invocationsDone()
 }
 
-// MinimockGetUserChatsInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockGetUserChatsInspect() {
-	for _, e := range m.GetUserChatsMock.expectations {
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UnpinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserChatsCounter := mm_atomic.LoadUint64(&m.afterGetUserChatsCounter)
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserChatsMock.defaultExpectation != nil && afterGetUserChatsCounter < 1 {
-		if m.GetUserChatsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s", m.GetUserChatsMock.defaultExpectation.returnOrigin)
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UnpinMessage at\n%s", m.UnpinMessageMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s with params: %#v", m.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserChatsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UnpinMessage at\n%s with params: %#v", m.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *m.UnpinMessageMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserChats != nil && afterGetUserChatsCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.GetUserChats at\n%s", m.funcGetUserChatsOrigin)
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.UnpinMessage at\n%s", m.funcUnpinMessageOrigin)
 	}
 
-	if !m.GetUserChatsMock.invocationsDone() && afterGetUserChatsCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetUserChats at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserChatsMock.expectedInvocations), m.GetUserChatsMock.expectedInvocationsOrigin, afterGetUserChatsCounter)
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UnpinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), m.UnpinMessageMock.expectedInvocationsOrigin, afterUnpinMessageCounter)
 	}
 }
 
-type mChatRepositoryMockSendMessage struct {
+type mChatRepositoryMockUpdateLastSeen struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockSendMessageExpectation
-	expectations       []*ChatRepositoryMockSendMessageExpectation
+	defaultExpectation *ChatRepositoryMockUpdateLastSeenExpectation
+	expectations       []*ChatRepositoryMockUpdateLastSeenExpectation
 
-	callArgs []*ChatRepositoryMockSendMessageParams
+	callArgs []*ChatRepositoryMockUpdateLastSeenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectation struct {
+// ChatRepositoryMockUpdateLastSeenExpectation specifies expectation struct of the ChatRepository.UpdateLastSeen
+type ChatRepositoryMockUpdateLastSeenExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockSendMessageParams
-	paramPtrs          *ChatRepositoryMockSendMessageParamPtrs
-	expectationOrigins ChatRepositoryMockSendMessageExpectationOrigins
-	results            *ChatRepositoryMockSendMessageResults
+	params             *ChatRepositoryMockUpdateLastSeenParams
+	paramPtrs          *ChatRepositoryMockUpdateLastSeenParamPtrs
+	expectationOrigins ChatRepositoryMockUpdateLastSeenExpectationOrigins
+	results            *ChatRepositoryMockUpdateLastSeenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParams struct {
-	ctx     context.Context
-	message *model.Message
+// ChatRepositoryMockUpdateLastSeenParams contains parameters of the ChatRepository.UpdateLastSeen
+type ChatRepositoryMockUpdateLastSeenParams struct {
+	ctx      context.Context
+	username string
 }
 
-// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParamPtrs struct {
-	ctx     *context.Context
-	message **model.Message
+// ChatRepositoryMockUpdateLastSeenParamPtrs contains pointers to parameters of the ChatRepository.UpdateLastSeen
+type ChatRepositoryMockUpdateLastSeenParamPtrs struct {
+	ctx      *context.Context
+	username *string
 }
 
-// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageResults struct {
-	ep1 *emptypb.Empty
+// ChatRepositoryMockUpdateLastSeenResults contains results of the ChatRepository.UpdateLastSeen
+type ChatRepositoryMockUpdateLastSeenResults struct {
 	err error
 }
 
-// ChatRepositoryMockSendMessageOrigins contains origins of expectations of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originMessage string
+// ChatRepositoryMockUpdateLastSeenOrigins contains origins of expectations of the ChatRepository.UpdateLastSeen
+type ChatRepositoryMockUpdateLastSeenExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1540,292 +10659,292 @@ type ChatRepositoryMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Optional() *mChatRepositoryMockUpdateLastSeen {
+	mmUpdateLastSeen.optional = true
+	return mmUpdateLastSeen
 }
 
-// Expect sets up expected params for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, message *model.Message) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatRepository.UpdateLastSeen
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Expect(ctx context.Context, username string) *mChatRepositoryMockUpdateLastSeen {
+	if mmUpdateLastSeen.mock.funcUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmUpdateLastSeen.defaultExpectation == nil {
+		mmUpdateLastSeen.defaultExpectation = &ChatRepositoryMockUpdateLastSeenExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	if mmUpdateLastSeen.defaultExpectation.paramPtrs != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, message}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmUpdateLastSeen.defaultExpectation.params = &ChatRepositoryMockUpdateLastSeenParams{ctx, username}
+	mmUpdateLastSeen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateLastSeen.expectations {
+		if minimock.Equal(e.params, mmUpdateLastSeen.defaultExpectation.params) {
+			mmUpdateLastSeen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateLastSeen.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmUpdateLastSeen
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UpdateLastSeen
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdateLastSeen {
+	if mmUpdateLastSeen.mock.funcUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmUpdateLastSeen.defaultExpectation == nil {
+		mmUpdateLastSeen.defaultExpectation = &ChatRepositoryMockUpdateLastSeenExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmUpdateLastSeen.defaultExpectation.params != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmUpdateLastSeen.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastSeen.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateLastSeenParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateLastSeen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateLastSeen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUpdateLastSeen
 }
 
-// ExpectMessageParam2 sets up expected param message for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectMessageParam2(message *model.Message) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectUsernameParam2 sets up expected param username for ChatRepository.UpdateLastSeen
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) ExpectUsernameParam2(username string) *mChatRepositoryMockUpdateLastSeen {
+	if mmUpdateLastSeen.mock.funcUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmUpdateLastSeen.defaultExpectation == nil {
+		mmUpdateLastSeen.defaultExpectation = &ChatRepositoryMockUpdateLastSeenExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmUpdateLastSeen.defaultExpectation.params != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmUpdateLastSeen.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastSeen.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateLastSeenParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.message = &message
-	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+	mmUpdateLastSeen.defaultExpectation.paramPtrs.username = &username
+	mmUpdateLastSeen.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUpdateLastSeen
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, message *model.Message)) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UpdateLastSeen
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Inspect(f func(ctx context.Context, username string)) *mChatRepositoryMockUpdateLastSeen {
+	if mmUpdateLastSeen.mock.inspectFuncUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UpdateLastSeen")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmUpdateLastSeen.mock.inspectFuncUpdateLastSeen = f
 
-	return mmSendMessage
+	return mmUpdateLastSeen
 }
 
-// Return sets up results that will be returned by ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Return(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.UpdateLastSeen
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Return(err error) *ChatRepositoryMock {
+	if mmUpdateLastSeen.mock.funcUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmUpdateLastSeen.defaultExpectation == nil {
+		mmUpdateLastSeen.defaultExpectation = &ChatRepositoryMockUpdateLastSeenExpectation{mock: mmUpdateLastSeen.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{ep1, err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmUpdateLastSeen.defaultExpectation.results = &ChatRepositoryMockUpdateLastSeenResults{err}
+	mmUpdateLastSeen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastSeen.mock
 }
 
-// Set uses given function f to mock the ChatRepository.SendMessage method
-func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error)) *ChatRepositoryMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+// Set uses given function f to mock the ChatRepository.UpdateLastSeen method
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Set(f func(ctx context.Context, username string) (err error)) *ChatRepositoryMock {
+	if mmUpdateLastSeen.defaultExpectation != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UpdateLastSeen method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
+	if len(mmUpdateLastSeen.expectations) > 0 {
+		mmUpdateLastSeen.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UpdateLastSeen method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmUpdateLastSeen.mock.funcUpdateLastSeen = f
+	mmUpdateLastSeen.mock.funcUpdateLastSeenOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastSeen.mock
 }
 
-// When sets expectation for the ChatRepository.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.UpdateLastSeen which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, message *model.Message) *ChatRepositoryMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) When(ctx context.Context, username string) *ChatRepositoryMockUpdateLastSeenExpectation {
+	if mmUpdateLastSeen.mock.funcUpdateLastSeen != nil {
+		mmUpdateLastSeen.mock.t.Fatalf("ChatRepositoryMock.UpdateLastSeen mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatRepositoryMockSendMessageParams{ctx, message},
-		expectationOrigins: ChatRepositoryMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockUpdateLastSeenExpectation{
+		mock:               mmUpdateLastSeen.mock,
+		params:             &ChatRepositoryMockUpdateLastSeenParams{ctx, username},
+		expectationOrigins: ChatRepositoryMockUpdateLastSeenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmUpdateLastSeen.expectations = append(mmUpdateLastSeen.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockSendMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockSendMessageResults{ep1, err}
+// Then sets up ChatRepository.UpdateLastSeen return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateLastSeenExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateLastSeenResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.SendMessage should be invoked
-func (mmSendMessage *mChatRepositoryMockSendMessage) Times(n uint64) *mChatRepositoryMockSendMessage {
+// Times sets number of times ChatRepository.UpdateLastSeen should be invoked
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Times(n uint64) *mChatRepositoryMockUpdateLastSeen {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatRepositoryMock.SendMessage mock can not be zero")
+		mmUpdateLastSeen.mock.t.Fatalf("Times of ChatRepositoryMock.UpdateLastSeen mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmUpdateLastSeen.expectedInvocations, n)
+	mmUpdateLastSeen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastSeen
 }
 
-func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) invocationsDone() bool {
+	if len(mmUpdateLastSeen.expectations) == 0 && mmUpdateLastSeen.defaultExpectation == nil && mmUpdateLastSeen.mock.funcUpdateLastSeen == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateLastSeen.mock.afterUpdateLastSeenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateLastSeen.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_repository.ChatRepository
-func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// UpdateLastSeen implements mm_repository.ChatRepository
+func (mmUpdateLastSeen *ChatRepositoryMock) UpdateLastSeen(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateLastSeen.beforeUpdateLastSeenCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateLastSeen.afterUpdateLastSeenCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmUpdateLastSeen.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	if mmUpdateLastSeen.inspectFuncUpdateLastSeen != nil {
+		mmUpdateLastSeen.inspectFuncUpdateLastSeen(ctx, username)
 	}
 
-	mm_params := ChatRepositoryMockSendMessageParams{ctx, message}
+	mm_params := ChatRepositoryMockUpdateLastSeenParams{ctx, username}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmUpdateLastSeen.UpdateLastSeenMock.mutex.Lock()
+	mmUpdateLastSeen.UpdateLastSeenMock.callArgs = append(mmUpdateLastSeen.UpdateLastSeenMock.callArgs, &mm_params)
+	mmUpdateLastSeen.UpdateLastSeenMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmUpdateLastSeen.UpdateLastSeenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ep1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockSendMessageParams{ctx, message}
+		mm_got := ChatRepositoryMockUpdateLastSeenParams{ctx, username}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateLastSeen.t.Errorf("ChatRepositoryMock.UpdateLastSeen got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmUpdateLastSeen.t.Errorf("ChatRepositoryMock.UpdateLastSeen got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateLastSeen.t.Errorf("ChatRepositoryMock.UpdateLastSeen got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmUpdateLastSeen.UpdateLastSeenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
+			mmUpdateLastSeen.t.Fatal("No results are set for the ChatRepositoryMock.UpdateLastSeen")
 		}
-		return (*mm_results).ep1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, message)
+	if mmUpdateLastSeen.funcUpdateLastSeen != nil {
+		return mmUpdateLastSeen.funcUpdateLastSeen(ctx, username)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.SendMessage. %v %v", ctx, message)
+	mmUpdateLastSeen.t.Fatalf("Unexpected call to ChatRepositoryMock.UpdateLastSeen. %v %v", ctx, username)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// UpdateLastSeenAfterCounter returns a count of finished ChatRepositoryMock.UpdateLastSeen invocations
+func (mmUpdateLastSeen *ChatRepositoryMock) UpdateLastSeenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastSeen.afterUpdateLastSeenCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// UpdateLastSeenBeforeCounter returns a count of ChatRepositoryMock.UpdateLastSeen invocations
+func (mmUpdateLastSeen *ChatRepositoryMock) UpdateLastSeenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastSeen.beforeUpdateLastSeenCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UpdateLastSeen.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatRepositoryMockSendMessage) Calls() []*ChatRepositoryMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmUpdateLastSeen *mChatRepositoryMockUpdateLastSeen) Calls() []*ChatRepositoryMockUpdateLastSeenParams {
+	mmUpdateLastSeen.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatRepositoryMockUpdateLastSeenParams, len(mmUpdateLastSeen.callArgs))
+	copy(argCopy, mmUpdateLastSeen.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmUpdateLastSeen.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockUpdateLastSeenDone returns true if the count of the UpdateLastSeen invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatRepositoryMock) MinimockUpdateLastSeenDone() bool {
+	if m.UpdateLastSeenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.UpdateLastSeenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.UpdateLastSeenMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockUpdateLastSeenInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateLastSeenInspect() {
+	for _, e := range m.UpdateLastSeenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastSeen at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterUpdateLastSeenCounter := mm_atomic.LoadUint64(&m.afterUpdateLastSeenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.UpdateLastSeenMock.defaultExpectation != nil && afterUpdateLastSeenCounter < 1 {
+		if m.UpdateLastSeenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastSeen at\n%s", m.UpdateLastSeenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastSeen at\n%s with params: %#v", m.UpdateLastSeenMock.defaultExpectation.expectationOrigins.origin, *m.UpdateLastSeenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcUpdateLastSeen != nil && afterUpdateLastSeenCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastSeen at\n%s", m.funcUpdateLastSeenOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.UpdateLastSeenMock.invocationsDone() && afterUpdateLastSeenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UpdateLastSeen at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateLastSeenMock.expectedInvocations), m.UpdateLastSeenMock.expectedInvocationsOrigin, afterUpdateLastSeenCounter)
 	}
 }
 
@@ -1833,15 +10952,63 @@ func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
 func (m *ChatRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddChatMembersInspect()
+
+			m.MinimockAddDeliveriesInspect()
+
+			m.MinimockAddReactionInspect()
+
+			m.MinimockAddReadReceiptsInspect()
+
 			m.MinimockCheckChatInspect()
 
+			m.MinimockCountChatMembersInspect()
+
+			m.MinimockCountPinnedMessagesInspect()
+
 			m.MinimockCreateChatInspect()
 
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockGetChatMessagesInspect()
+
+			m.MinimockGetLastSeenInspect()
+
+			m.MinimockGetMemberRoleInspect()
+
+			m.MinimockGetMessageInspect()
+
+			m.MinimockGetMessageStatusInspect()
+
+			m.MinimockGetPinnedMessagesInspect()
+
+			m.MinimockGetReadCursorInspect()
+
+			m.MinimockGetUserChatIDsInspect()
+
 			m.MinimockGetUserChatsInspect()
 
+			m.MinimockMarkReadInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockRemoveChatMemberInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockRenameChatInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMemberRoleInspect()
+
+			m.MinimockUnpinMessageInspect()
+
+			m.MinimockUpdateLastSeenInspect()
 		}
 	})
 }
@@ -1865,9 +11032,33 @@ func (m *ChatRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddChatMembersDone() &&
+		m.MinimockAddDeliveriesDone() &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockAddReadReceiptsDone() &&
 		m.MinimockCheckChatDone() &&
+		m.MinimockCountChatMembersDone() &&
+		m.MinimockCountPinnedMessagesDone() &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockGetChatMessagesDone() &&
+		m.MinimockGetLastSeenDone() &&
+		m.MinimockGetMemberRoleDone() &&
+		m.MinimockGetMessageDone() &&
+		m.MinimockGetMessageStatusDone() &&
+		m.MinimockGetPinnedMessagesDone() &&
+		m.MinimockGetReadCursorDone() &&
+		m.MinimockGetUserChatIDsDone() &&
 		m.MinimockGetUserChatsDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockMarkReadDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockRemoveChatMemberDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockRenameChatDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockUnpinMessageDone() &&
+		m.MinimockUpdateLastSeenDone()
 }
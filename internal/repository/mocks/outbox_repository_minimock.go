@@ -0,0 +1,1129 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/solumD/chat-server/internal/repository.OutboxRepository -o outbox_repository_minimock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// OutboxRepositoryMock implements mm_repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddEvent          func(ctx context.Context, event *model.OutboxEvent) (i1 int64, err error)
+	funcAddEventOrigin    string
+	inspectFuncAddEvent   func(ctx context.Context, event *model.OutboxEvent)
+	afterAddEventCounter  uint64
+	beforeAddEventCounter uint64
+	AddEventMock          mOutboxRepositoryMockAddEvent
+
+	funcGetPendingEvents          func(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error)
+	funcGetPendingEventsOrigin    string
+	inspectFuncGetPendingEvents   func(ctx context.Context, limit uint64)
+	afterGetPendingEventsCounter  uint64
+	beforeGetPendingEventsCounter uint64
+	GetPendingEventsMock          mOutboxRepositoryMockGetPendingEvents
+
+	funcMarkEventsProcessed          func(ctx context.Context, ids []int64) (err error)
+	funcMarkEventsProcessedOrigin    string
+	inspectFuncMarkEventsProcessed   func(ctx context.Context, ids []int64)
+	afterMarkEventsProcessedCounter  uint64
+	beforeMarkEventsProcessedCounter uint64
+	MarkEventsProcessedMock          mOutboxRepositoryMockMarkEventsProcessed
+}
+
+// NewOutboxRepositoryMock returns a mock for mm_repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddEventMock = mOutboxRepositoryMockAddEvent{mock: m}
+	m.AddEventMock.callArgs = []*OutboxRepositoryMockAddEventParams{}
+
+	m.GetPendingEventsMock = mOutboxRepositoryMockGetPendingEvents{mock: m}
+	m.GetPendingEventsMock.callArgs = []*OutboxRepositoryMockGetPendingEventsParams{}
+
+	m.MarkEventsProcessedMock = mOutboxRepositoryMockMarkEventsProcessed{mock: m}
+	m.MarkEventsProcessedMock.callArgs = []*OutboxRepositoryMockMarkEventsProcessedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockAddEvent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockAddEventExpectation
+	expectations       []*OutboxRepositoryMockAddEventExpectation
+
+	callArgs []*OutboxRepositoryMockAddEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockAddEventExpectation specifies expectation struct of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockAddEventParams
+	paramPtrs          *OutboxRepositoryMockAddEventParamPtrs
+	expectationOrigins OutboxRepositoryMockAddEventExpectationOrigins
+	results            *OutboxRepositoryMockAddEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockAddEventParams contains parameters of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventParams struct {
+	ctx   context.Context
+	event *model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventParamPtrs contains pointers to parameters of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventParamPtrs struct {
+	ctx   *context.Context
+	event **model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventResults contains results of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventResults struct {
+	i1  int64
+	err error
+}
+
+// OutboxRepositoryMockAddEventOrigins contains origins of expectations of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Optional() *mOutboxRepositoryMockAddEvent {
+	mmAddEvent.optional = true
+	return mmAddEvent
+}
+
+// Expect sets up expected params for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Expect(ctx context.Context, event *model.OutboxEvent) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by ExpectParams functions")
+	}
+
+	mmAddEvent.defaultExpectation.params = &OutboxRepositoryMockAddEventParams{ctx, event}
+	mmAddEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddEvent.expectations {
+		if minimock.Equal(e.params, mmAddEvent.defaultExpectation.params) {
+			mmAddEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.params != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Expect")
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs == nil {
+		mmAddEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventParamPtrs{}
+	}
+	mmAddEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddEvent
+}
+
+// ExpectEventParam2 sets up expected param event for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) ExpectEventParam2(event *model.OutboxEvent) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.params != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Expect")
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs == nil {
+		mmAddEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventParamPtrs{}
+	}
+	mmAddEvent.defaultExpectation.paramPtrs.event = &event
+	mmAddEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmAddEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Inspect(f func(ctx context.Context, event *model.OutboxEvent)) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.inspectFuncAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.AddEvent")
+	}
+
+	mmAddEvent.mock.inspectFuncAddEvent = f
+
+	return mmAddEvent
+}
+
+// Return sets up results that will be returned by OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Return(i1 int64, err error) *OutboxRepositoryMock {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{mock: mmAddEvent.mock}
+	}
+	mmAddEvent.defaultExpectation.results = &OutboxRepositoryMockAddEventResults{i1, err}
+	mmAddEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddEvent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.AddEvent method
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Set(f func(ctx context.Context, event *model.OutboxEvent) (i1 int64, err error)) *OutboxRepositoryMock {
+	if mmAddEvent.defaultExpectation != nil {
+		mmAddEvent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.AddEvent method")
+	}
+
+	if len(mmAddEvent.expectations) > 0 {
+		mmAddEvent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.AddEvent method")
+	}
+
+	mmAddEvent.mock.funcAddEvent = f
+	mmAddEvent.mock.funcAddEventOrigin = minimock.CallerInfo(1)
+	return mmAddEvent.mock
+}
+
+// When sets expectation for the OutboxRepository.AddEvent which will trigger the result defined by the following
+// Then helper
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) When(ctx context.Context, event *model.OutboxEvent) *OutboxRepositoryMockAddEventExpectation {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockAddEventExpectation{
+		mock:               mmAddEvent.mock,
+		params:             &OutboxRepositoryMockAddEventParams{ctx, event},
+		expectationOrigins: OutboxRepositoryMockAddEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddEvent.expectations = append(mmAddEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.AddEvent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockAddEventExpectation) Then(i1 int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockAddEventResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.AddEvent should be invoked
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Times(n uint64) *mOutboxRepositoryMockAddEvent {
+	if n == 0 {
+		mmAddEvent.mock.t.Fatalf("Times of OutboxRepositoryMock.AddEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddEvent.expectedInvocations, n)
+	mmAddEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddEvent
+}
+
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) invocationsDone() bool {
+	if len(mmAddEvent.expectations) == 0 && mmAddEvent.defaultExpectation == nil && mmAddEvent.mock.funcAddEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddEvent.mock.afterAddEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddEvent implements mm_repository.OutboxRepository
+func (mmAddEvent *OutboxRepositoryMock) AddEvent(ctx context.Context, event *model.OutboxEvent) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmAddEvent.beforeAddEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddEvent.afterAddEventCounter, 1)
+
+	mmAddEvent.t.Helper()
+
+	if mmAddEvent.inspectFuncAddEvent != nil {
+		mmAddEvent.inspectFuncAddEvent(ctx, event)
+	}
+
+	mm_params := OutboxRepositoryMockAddEventParams{ctx, event}
+
+	// Record call args
+	mmAddEvent.AddEventMock.mutex.Lock()
+	mmAddEvent.AddEventMock.callArgs = append(mmAddEvent.AddEventMock.callArgs, &mm_params)
+	mmAddEvent.AddEventMock.mutex.Unlock()
+
+	for _, e := range mmAddEvent.AddEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmAddEvent.AddEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddEvent.AddEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddEvent.AddEventMock.defaultExpectation.params
+		mm_want_ptrs := mmAddEvent.AddEventMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockAddEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddEvent.AddEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddEvent.t.Fatal("No results are set for the OutboxRepositoryMock.AddEvent")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmAddEvent.funcAddEvent != nil {
+		return mmAddEvent.funcAddEvent(ctx, event)
+	}
+	mmAddEvent.t.Fatalf("Unexpected call to OutboxRepositoryMock.AddEvent. %v %v", ctx, event)
+	return
+}
+
+// AddEventAfterCounter returns a count of finished OutboxRepositoryMock.AddEvent invocations
+func (mmAddEvent *OutboxRepositoryMock) AddEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvent.afterAddEventCounter)
+}
+
+// AddEventBeforeCounter returns a count of OutboxRepositoryMock.AddEvent invocations
+func (mmAddEvent *OutboxRepositoryMock) AddEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvent.beforeAddEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.AddEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Calls() []*OutboxRepositoryMockAddEventParams {
+	mmAddEvent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockAddEventParams, len(mmAddEvent.callArgs))
+	copy(argCopy, mmAddEvent.callArgs)
+
+	mmAddEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddEventDone returns true if the count of the AddEvent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockAddEventDone() bool {
+	if m.AddEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddEventMock.invocationsDone()
+}
+
+// MinimockAddEventInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockAddEventInspect() {
+	for _, e := range m.AddEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddEventCounter := mm_atomic.LoadUint64(&m.afterAddEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddEventMock.defaultExpectation != nil && afterAddEventCounter < 1 {
+		if m.AddEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s", m.AddEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s with params: %#v", m.AddEventMock.defaultExpectation.expectationOrigins.origin, *m.AddEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddEvent != nil && afterAddEventCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s", m.funcAddEventOrigin)
+	}
+
+	if !m.AddEventMock.invocationsDone() && afterAddEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.AddEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddEventMock.expectedInvocations), m.AddEventMock.expectedInvocationsOrigin, afterAddEventCounter)
+	}
+}
+
+type mOutboxRepositoryMockGetPendingEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockGetPendingEventsExpectation
+	expectations       []*OutboxRepositoryMockGetPendingEventsExpectation
+
+	callArgs []*OutboxRepositoryMockGetPendingEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockGetPendingEventsExpectation specifies expectation struct of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockGetPendingEventsParams
+	paramPtrs          *OutboxRepositoryMockGetPendingEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockGetPendingEventsExpectationOrigins
+	results            *OutboxRepositoryMockGetPendingEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsParams contains parameters of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsParamPtrs contains pointers to parameters of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsResults contains results of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsResults struct {
+	opa1 []*model.OutboxEvent
+	err  error
+}
+
+// OutboxRepositoryMockGetPendingEventsOrigins contains origins of expectations of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Optional() *mOutboxRepositoryMockGetPendingEvents {
+	mmGetPendingEvents.optional = true
+	return mmGetPendingEvents
+}
+
+// Expect sets up expected params for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Expect(ctx context.Context, limit uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by ExpectParams functions")
+	}
+
+	mmGetPendingEvents.defaultExpectation.params = &OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPendingEvents.expectations {
+		if minimock.Equal(e.params, mmGetPendingEvents.defaultExpectation.params) {
+			mmGetPendingEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPendingEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPendingEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.params != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Expect")
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmGetPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetPendingEventsParamPtrs{}
+	}
+	mmGetPendingEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPendingEvents
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) ExpectLimitParam2(limit uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.params != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Expect")
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmGetPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetPendingEventsParamPtrs{}
+	}
+	mmGetPendingEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetPendingEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Inspect(f func(ctx context.Context, limit uint64)) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.inspectFuncGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.GetPendingEvents")
+	}
+
+	mmGetPendingEvents.mock.inspectFuncGetPendingEvents = f
+
+	return mmGetPendingEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Return(opa1 []*model.OutboxEvent, err error) *OutboxRepositoryMock {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{mock: mmGetPendingEvents.mock}
+	}
+	mmGetPendingEvents.defaultExpectation.results = &OutboxRepositoryMockGetPendingEventsResults{opa1, err}
+	mmGetPendingEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.GetPendingEvents method
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Set(f func(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error)) *OutboxRepositoryMock {
+	if mmGetPendingEvents.defaultExpectation != nil {
+		mmGetPendingEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.GetPendingEvents method")
+	}
+
+	if len(mmGetPendingEvents.expectations) > 0 {
+		mmGetPendingEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.GetPendingEvents method")
+	}
+
+	mmGetPendingEvents.mock.funcGetPendingEvents = f
+	mmGetPendingEvents.mock.funcGetPendingEventsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.GetPendingEvents which will trigger the result defined by the following
+// Then helper
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) When(ctx context.Context, limit uint64) *OutboxRepositoryMockGetPendingEventsExpectation {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockGetPendingEventsExpectation{
+		mock:               mmGetPendingEvents.mock,
+		params:             &OutboxRepositoryMockGetPendingEventsParams{ctx, limit},
+		expectationOrigins: OutboxRepositoryMockGetPendingEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPendingEvents.expectations = append(mmGetPendingEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.GetPendingEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockGetPendingEventsExpectation) Then(opa1 []*model.OutboxEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockGetPendingEventsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.GetPendingEvents should be invoked
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Times(n uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if n == 0 {
+		mmGetPendingEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.GetPendingEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPendingEvents.expectedInvocations, n)
+	mmGetPendingEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents
+}
+
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) invocationsDone() bool {
+	if len(mmGetPendingEvents.expectations) == 0 && mmGetPendingEvents.defaultExpectation == nil && mmGetPendingEvents.mock.funcGetPendingEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPendingEvents.mock.afterGetPendingEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPendingEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPendingEvents implements mm_repository.OutboxRepository
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEvents(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmGetPendingEvents.beforeGetPendingEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPendingEvents.afterGetPendingEventsCounter, 1)
+
+	mmGetPendingEvents.t.Helper()
+
+	if mmGetPendingEvents.inspectFuncGetPendingEvents != nil {
+		mmGetPendingEvents.inspectFuncGetPendingEvents(ctx, limit)
+	}
+
+	mm_params := OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+
+	// Record call args
+	mmGetPendingEvents.GetPendingEventsMock.mutex.Lock()
+	mmGetPendingEvents.GetPendingEventsMock.callArgs = append(mmGetPendingEvents.GetPendingEventsMock.callArgs, &mm_params)
+	mmGetPendingEvents.GetPendingEventsMock.mutex.Unlock()
+
+	for _, e := range mmGetPendingEvents.GetPendingEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmGetPendingEvents.GetPendingEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPendingEvents.t.Fatal("No results are set for the OutboxRepositoryMock.GetPendingEvents")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmGetPendingEvents.funcGetPendingEvents != nil {
+		return mmGetPendingEvents.funcGetPendingEvents(ctx, limit)
+	}
+	mmGetPendingEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.GetPendingEvents. %v %v", ctx, limit)
+	return
+}
+
+// GetPendingEventsAfterCounter returns a count of finished OutboxRepositoryMock.GetPendingEvents invocations
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingEvents.afterGetPendingEventsCounter)
+}
+
+// GetPendingEventsBeforeCounter returns a count of OutboxRepositoryMock.GetPendingEvents invocations
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingEvents.beforeGetPendingEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.GetPendingEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Calls() []*OutboxRepositoryMockGetPendingEventsParams {
+	mmGetPendingEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockGetPendingEventsParams, len(mmGetPendingEvents.callArgs))
+	copy(argCopy, mmGetPendingEvents.callArgs)
+
+	mmGetPendingEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPendingEventsDone returns true if the count of the GetPendingEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockGetPendingEventsDone() bool {
+	if m.GetPendingEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPendingEventsMock.invocationsDone()
+}
+
+// MinimockGetPendingEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockGetPendingEventsInspect() {
+	for _, e := range m.GetPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPendingEventsCounter := mm_atomic.LoadUint64(&m.afterGetPendingEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPendingEventsMock.defaultExpectation != nil && afterGetPendingEventsCounter < 1 {
+		if m.GetPendingEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s", m.GetPendingEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s with params: %#v", m.GetPendingEventsMock.defaultExpectation.expectationOrigins.origin, *m.GetPendingEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPendingEvents != nil && afterGetPendingEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s", m.funcGetPendingEventsOrigin)
+	}
+
+	if !m.GetPendingEventsMock.invocationsDone() && afterGetPendingEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.GetPendingEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPendingEventsMock.expectedInvocations), m.GetPendingEventsMock.expectedInvocationsOrigin, afterGetPendingEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkEventsProcessed struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkEventsProcessedExpectation
+	expectations       []*OutboxRepositoryMockMarkEventsProcessedExpectation
+
+	callArgs []*OutboxRepositoryMockMarkEventsProcessedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockMarkEventsProcessedExpectation specifies expectation struct of the OutboxRepository.MarkEventsProcessed
+type OutboxRepositoryMockMarkEventsProcessedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockMarkEventsProcessedParams
+	paramPtrs          *OutboxRepositoryMockMarkEventsProcessedParamPtrs
+	expectationOrigins OutboxRepositoryMockMarkEventsProcessedExpectationOrigins
+	results            *OutboxRepositoryMockMarkEventsProcessedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockMarkEventsProcessedParams contains parameters of the OutboxRepository.MarkEventsProcessed
+type OutboxRepositoryMockMarkEventsProcessedParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// OutboxRepositoryMockMarkEventsProcessedParamPtrs contains pointers to parameters of the OutboxRepository.MarkEventsProcessed
+type OutboxRepositoryMockMarkEventsProcessedParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// OutboxRepositoryMockMarkEventsProcessedResults contains results of the OutboxRepository.MarkEventsProcessed
+type OutboxRepositoryMockMarkEventsProcessedResults struct {
+	err error
+}
+
+// OutboxRepositoryMockMarkEventsProcessedOrigins contains origins of expectations of the OutboxRepository.MarkEventsProcessed
+type OutboxRepositoryMockMarkEventsProcessedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Optional() *mOutboxRepositoryMockMarkEventsProcessed {
+	mmMarkEventsProcessed.optional = true
+	return mmMarkEventsProcessed
+}
+
+// Expect sets up expected params for OutboxRepository.MarkEventsProcessed
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Expect(ctx context.Context, ids []int64) *mOutboxRepositoryMockMarkEventsProcessed {
+	if mmMarkEventsProcessed.mock.funcMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Set")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation == nil {
+		mmMarkEventsProcessed.defaultExpectation = &OutboxRepositoryMockMarkEventsProcessedExpectation{}
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation.paramPtrs != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEventsProcessed.defaultExpectation.params = &OutboxRepositoryMockMarkEventsProcessedParams{ctx, ids}
+	mmMarkEventsProcessed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkEventsProcessed.expectations {
+		if minimock.Equal(e.params, mmMarkEventsProcessed.defaultExpectation.params) {
+			mmMarkEventsProcessed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEventsProcessed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEventsProcessed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkEventsProcessed
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkEventsProcessed {
+	if mmMarkEventsProcessed.mock.funcMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Set")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation == nil {
+		mmMarkEventsProcessed.defaultExpectation = &OutboxRepositoryMockMarkEventsProcessedExpectation{}
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation.params != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Expect")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation.paramPtrs == nil {
+		mmMarkEventsProcessed.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventsProcessedParamPtrs{}
+	}
+	mmMarkEventsProcessed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkEventsProcessed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkEventsProcessed
+}
+
+// ExpectIdsParam2 sets up expected param ids for OutboxRepository.MarkEventsProcessed
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) ExpectIdsParam2(ids []int64) *mOutboxRepositoryMockMarkEventsProcessed {
+	if mmMarkEventsProcessed.mock.funcMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Set")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation == nil {
+		mmMarkEventsProcessed.defaultExpectation = &OutboxRepositoryMockMarkEventsProcessedExpectation{}
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation.params != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Expect")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation.paramPtrs == nil {
+		mmMarkEventsProcessed.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventsProcessedParamPtrs{}
+	}
+	mmMarkEventsProcessed.defaultExpectation.paramPtrs.ids = &ids
+	mmMarkEventsProcessed.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmMarkEventsProcessed
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkEventsProcessed
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Inspect(f func(ctx context.Context, ids []int64)) *mOutboxRepositoryMockMarkEventsProcessed {
+	if mmMarkEventsProcessed.mock.inspectFuncMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkEventsProcessed")
+	}
+
+	mmMarkEventsProcessed.mock.inspectFuncMarkEventsProcessed = f
+
+	return mmMarkEventsProcessed
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkEventsProcessed
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Return(err error) *OutboxRepositoryMock {
+	if mmMarkEventsProcessed.mock.funcMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Set")
+	}
+
+	if mmMarkEventsProcessed.defaultExpectation == nil {
+		mmMarkEventsProcessed.defaultExpectation = &OutboxRepositoryMockMarkEventsProcessedExpectation{mock: mmMarkEventsProcessed.mock}
+	}
+	mmMarkEventsProcessed.defaultExpectation.results = &OutboxRepositoryMockMarkEventsProcessedResults{err}
+	mmMarkEventsProcessed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkEventsProcessed.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkEventsProcessed method
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Set(f func(ctx context.Context, ids []int64) (err error)) *OutboxRepositoryMock {
+	if mmMarkEventsProcessed.defaultExpectation != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkEventsProcessed method")
+	}
+
+	if len(mmMarkEventsProcessed.expectations) > 0 {
+		mmMarkEventsProcessed.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkEventsProcessed method")
+	}
+
+	mmMarkEventsProcessed.mock.funcMarkEventsProcessed = f
+	mmMarkEventsProcessed.mock.funcMarkEventsProcessedOrigin = minimock.CallerInfo(1)
+	return mmMarkEventsProcessed.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkEventsProcessed which will trigger the result defined by the following
+// Then helper
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) When(ctx context.Context, ids []int64) *OutboxRepositoryMockMarkEventsProcessedExpectation {
+	if mmMarkEventsProcessed.mock.funcMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsProcessed mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkEventsProcessedExpectation{
+		mock:               mmMarkEventsProcessed.mock,
+		params:             &OutboxRepositoryMockMarkEventsProcessedParams{ctx, ids},
+		expectationOrigins: OutboxRepositoryMockMarkEventsProcessedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkEventsProcessed.expectations = append(mmMarkEventsProcessed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkEventsProcessed return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkEventsProcessedExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkEventsProcessedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkEventsProcessed should be invoked
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Times(n uint64) *mOutboxRepositoryMockMarkEventsProcessed {
+	if n == 0 {
+		mmMarkEventsProcessed.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkEventsProcessed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEventsProcessed.expectedInvocations, n)
+	mmMarkEventsProcessed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkEventsProcessed
+}
+
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) invocationsDone() bool {
+	if len(mmMarkEventsProcessed.expectations) == 0 && mmMarkEventsProcessed.defaultExpectation == nil && mmMarkEventsProcessed.mock.funcMarkEventsProcessed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEventsProcessed.mock.afterMarkEventsProcessedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEventsProcessed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEventsProcessed implements mm_repository.OutboxRepository
+func (mmMarkEventsProcessed *OutboxRepositoryMock) MarkEventsProcessed(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkEventsProcessed.beforeMarkEventsProcessedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEventsProcessed.afterMarkEventsProcessedCounter, 1)
+
+	mmMarkEventsProcessed.t.Helper()
+
+	if mmMarkEventsProcessed.inspectFuncMarkEventsProcessed != nil {
+		mmMarkEventsProcessed.inspectFuncMarkEventsProcessed(ctx, ids)
+	}
+
+	mm_params := OutboxRepositoryMockMarkEventsProcessedParams{ctx, ids}
+
+	// Record call args
+	mmMarkEventsProcessed.MarkEventsProcessedMock.mutex.Lock()
+	mmMarkEventsProcessed.MarkEventsProcessedMock.callArgs = append(mmMarkEventsProcessed.MarkEventsProcessedMock.callArgs, &mm_params)
+	mmMarkEventsProcessed.MarkEventsProcessedMock.mutex.Unlock()
+
+	for _, e := range mmMarkEventsProcessed.MarkEventsProcessedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkEventsProcessedParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEventsProcessed.t.Errorf("OutboxRepositoryMock.MarkEventsProcessed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmMarkEventsProcessed.t.Errorf("OutboxRepositoryMock.MarkEventsProcessed got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEventsProcessed.t.Errorf("OutboxRepositoryMock.MarkEventsProcessed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEventsProcessed.MarkEventsProcessedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEventsProcessed.t.Fatal("No results are set for the OutboxRepositoryMock.MarkEventsProcessed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkEventsProcessed.funcMarkEventsProcessed != nil {
+		return mmMarkEventsProcessed.funcMarkEventsProcessed(ctx, ids)
+	}
+	mmMarkEventsProcessed.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkEventsProcessed. %v %v", ctx, ids)
+	return
+}
+
+// MarkEventsProcessedAfterCounter returns a count of finished OutboxRepositoryMock.MarkEventsProcessed invocations
+func (mmMarkEventsProcessed *OutboxRepositoryMock) MarkEventsProcessedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventsProcessed.afterMarkEventsProcessedCounter)
+}
+
+// MarkEventsProcessedBeforeCounter returns a count of OutboxRepositoryMock.MarkEventsProcessed invocations
+func (mmMarkEventsProcessed *OutboxRepositoryMock) MarkEventsProcessedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventsProcessed.beforeMarkEventsProcessedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkEventsProcessed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEventsProcessed *mOutboxRepositoryMockMarkEventsProcessed) Calls() []*OutboxRepositoryMockMarkEventsProcessedParams {
+	mmMarkEventsProcessed.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkEventsProcessedParams, len(mmMarkEventsProcessed.callArgs))
+	copy(argCopy, mmMarkEventsProcessed.callArgs)
+
+	mmMarkEventsProcessed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEventsProcessedDone returns true if the count of the MarkEventsProcessed invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkEventsProcessedDone() bool {
+	if m.MarkEventsProcessedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkEventsProcessedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkEventsProcessedMock.invocationsDone()
+}
+
+// MinimockMarkEventsProcessedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkEventsProcessedInspect() {
+	for _, e := range m.MarkEventsProcessedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsProcessed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkEventsProcessedCounter := mm_atomic.LoadUint64(&m.afterMarkEventsProcessedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkEventsProcessedMock.defaultExpectation != nil && afterMarkEventsProcessedCounter < 1 {
+		if m.MarkEventsProcessedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsProcessed at\n%s", m.MarkEventsProcessedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsProcessed at\n%s with params: %#v", m.MarkEventsProcessedMock.defaultExpectation.expectationOrigins.origin, *m.MarkEventsProcessedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkEventsProcessed != nil && afterMarkEventsProcessedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsProcessed at\n%s", m.funcMarkEventsProcessedOrigin)
+	}
+
+	if !m.MarkEventsProcessedMock.invocationsDone() && afterMarkEventsProcessedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkEventsProcessed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEventsProcessedMock.expectedInvocations), m.MarkEventsProcessedMock.expectedInvocationsOrigin, afterMarkEventsProcessedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddEventInspect()
+
+			m.MinimockGetPendingEventsInspect()
+
+			m.MinimockMarkEventsProcessedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddEventDone() &&
+		m.MinimockGetPendingEventsDone() &&
+		m.MinimockMarkEventsProcessedDone()
+}
@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/repository"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	// название таблицы
+	outboxTable = "outbox"
+
+	// названия колонок
+	idColumn          = "id"
+	eventTypeColumn   = "event_type"
+	chatIDColumn      = "chat_id"
+	messageIDColumn   = "message_id"
+	createdAtColumn   = "created_at"
+	processedAtColumn = "processed_at"
+)
+
+// Структура репо с клиентом базы данных (интерфейсом)
+type repo struct {
+	db db.Client
+}
+
+// NewRepository возвращает новый объект репо слоя outbox
+func NewRepository(db db.Client) repository.OutboxRepository {
+	return &repo{
+		db: db,
+	}
+}
+
+// AddEvent сохраняет событие в outbox. Должен вызываться в транзакции,
+// в которой сохраняется (изменяется) само сообщение. Событие без типа
+// считается событием о новом сообщении
+func (r *repo) AddEvent(ctx context.Context, event *model.OutboxEvent) (int64, error) {
+	eventType := event.Type
+	if len(eventType) == 0 {
+		eventType = model.OutboxEventMessage
+	}
+
+	query, args, err := sq.Insert(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(eventTypeColumn, chatIDColumn, messageIDColumn).
+		Values(eventType, event.ChatID, event.MessageID).
+		Suffix("RETURNING " + idColumn).
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.AddEvent",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetPendingEvents получает из БД необработанные события в порядке их добавления.
+// Выбранные строки блокируются до конца транзакции, а заблокированные другими
+// транзакциями пропускаются, поэтому несколько экземпляров сервера не
+// обрабатывают одно событие одновременно
+func (r *repo) GetPendingEvents(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error) {
+	query, args, err := sq.Select(idColumn, eventTypeColumn, chatIDColumn, messageIDColumn, createdAtColumn).
+		From(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{processedAtColumn: nil}).
+		OrderBy(idColumn + " ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.GetPendingEvents",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*model.OutboxEvent{}
+	for rows.Next() {
+		event := &model.OutboxEvent{}
+		if err := rows.Scan(&event.ID, &event.Type, &event.ChatID, &event.MessageID, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// MarkEventsProcessed помечает события обработанными
+func (r *repo) MarkEventsProcessed(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.Update(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(processedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: ids}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.MarkEventsProcessed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
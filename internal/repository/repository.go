@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/solumD/chat-server/internal/model"
 
@@ -13,6 +14,37 @@ type ChatRepository interface {
 	CreateChat(ctx context.Context, chat *model.Chat) (int64, error)
 	DeleteChat(ctx context.Context, chatID int64) (*emptypb.Empty, error)
 	GetUserChats(ctx context.Context, username string) ([]*model.Chat, error)
-	SendMessage(ctx context.Context, message *model.Message) (*emptypb.Empty, error)
+	SendMessage(ctx context.Context, message *model.Message) (*model.Message, error)
 	CheckChat(ctx context.Context, chatID int64, username string) error
+	GetChatMessages(ctx context.Context, filter *model.MessagesFilter) ([]*model.Message, error)
+	GetMessage(ctx context.Context, messageID int64) (*model.Message, error)
+	EditMessage(ctx context.Context, messageID int64, text string) (bool, error)
+	DeleteMessage(ctx context.Context, messageID int64) (bool, error)
+	AddReaction(ctx context.Context, messageID int64, username string, emoji string) (bool, error)
+	RemoveReaction(ctx context.Context, messageID int64, username string, emoji string) (bool, error)
+	PinMessage(ctx context.Context, chatID int64, messageID int64, username string) (bool, error)
+	UnpinMessage(ctx context.Context, chatID int64, messageID int64) (bool, error)
+	CountPinnedMessages(ctx context.Context, chatID int64) (int, error)
+	GetPinnedMessages(ctx context.Context, chatID int64) ([]*model.Message, error)
+	AddChatMembers(ctx context.Context, chatID int64, usernames []string) ([]string, error)
+	RemoveChatMember(ctx context.Context, chatID int64, username string) (bool, error)
+	RenameChat(ctx context.Context, chatID int64, name string) error
+	GetMemberRole(ctx context.Context, chatID int64, username string) (model.Role, error)
+	SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) error
+	UpdateLastSeen(ctx context.Context, username string) error
+	GetLastSeen(ctx context.Context, usernames []string) (map[string]time.Time, error)
+	GetUserChatIDs(ctx context.Context, username string) ([]int64, error)
+	MarkRead(ctx context.Context, chatID int64, username string, messageID int64) (bool, error)
+	CountChatMembers(ctx context.Context, chatID int64) (int, error)
+	AddDeliveries(ctx context.Context, deliveries []*model.Delivery) error
+	GetReadCursor(ctx context.Context, chatID int64, username string) (int64, error)
+	AddReadReceipts(ctx context.Context, chatID int64, username string, afterID int64, upToID int64, readAt time.Time) error
+	GetMessageStatus(ctx context.Context, messageID int64) ([]*model.MessageStatus, error)
+}
+
+// OutboxRepository - интерфейс репо слоя событий outbox
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event *model.OutboxEvent) (int64, error)
+	GetPendingEvents(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error)
+	MarkEventsProcessed(ctx context.Context, ids []int64) error
 }
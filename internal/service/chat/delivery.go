@@ -0,0 +1,126 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+)
+
+// handleEvent обрабатывает событие pub/sub в зависимости от его типа. События
+// неизвестных типов публикуют более новые экземпляры сервера, например во время
+// выкатки. Такие события пропускаются, а не рассылаются как новые сообщения
+func (s *srv) handleEvent(ctx context.Context, event *pubsub.Event) {
+	switch event.Type {
+	case pubsub.EventTypeMessage, "":
+		// события без типа публикуют экземпляры, которые рассылали только новые сообщения
+		s.deliverMessage(ctx, event, model.ChatEventMessage)
+	case pubsub.EventTypeMemberAdded:
+		s.chatHub.Publish(event.ChatID, &model.ChatEvent{
+			Type:     model.ChatEventMemberAdded,
+			ChatID:   event.ChatID,
+			Username: event.Username,
+		})
+	case pubsub.EventTypeMemberRemoved:
+		s.chatHub.Disconnect(event.ChatID, event.Username, hub.ErrRemovedFromChat)
+		s.typingTracker.Set(event.ChatID, event.Username, false)
+		s.chatHub.Publish(event.ChatID, &model.ChatEvent{
+			Type:     model.ChatEventMemberRemoved,
+			ChatID:   event.ChatID,
+			Username: event.Username,
+		})
+	case pubsub.EventTypeTyping:
+		s.handleTyping(event)
+	case pubsub.EventTypePresence:
+		s.handlePresence(event)
+	case pubsub.EventTypeRead:
+		s.handleRead(event)
+	case pubsub.EventTypeMessageEdited:
+		s.deliverMessage(ctx, event, model.ChatEventMessageEdited)
+	case pubsub.EventTypeMessageDeleted:
+		s.deliverMessage(ctx, event, model.ChatEventMessageDeleted)
+	case pubsub.EventTypeReactionsChanged:
+		s.deliverMessage(ctx, event, model.ChatEventReactionsChanged)
+	case pubsub.EventTypeMessagePinned:
+		s.deliverMessage(ctx, event, model.ChatEventMessagePinned)
+	case pubsub.EventTypeMessageUnpinned:
+		s.deliverMessage(ctx, event, model.ChatEventMessageUnpinned)
+	default:
+		logger.Warn("dropping pubsub event of unknown type", zap.String("type", string(event.Type)),
+			zap.Int64("chatID", event.ChatID))
+	}
+}
+
+// deliverMessage загружает сообщение из события pub/sub и рассылает его
+// подписчикам чата, подключенным к этому экземпляру сервера. О новом,
+// измененном, удаленном, закрепленном и открепленном сообщении и об изменении
+// реакций на сообщение рассылается его текущее состояние
+func (s *srv) deliverMessage(ctx context.Context, event *pubsub.Event, eventType model.ChatEventType) {
+	if !s.chatHub.HasSubscribers(event.ChatID) {
+		return
+	}
+
+	var msg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		msg, errTx = s.chatRepository.GetMessage(ctx, event.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		// новое сообщение подписчики догрузят из истории, а об остальных
+		// изменениях подписчики stream'а событий узнают из события resync
+		logger.Error("failed to load published message", zap.Int64("chatID", event.ChatID),
+			zap.Int64("messageID", event.MessageID), zap.Error(err))
+		s.chatHub.Resync()
+		return
+	}
+
+	s.chatHub.Publish(msg.ChatID, &model.ChatEvent{
+		Type:    eventType,
+		ChatID:  msg.ChatID,
+		Message: msg,
+	})
+}
+
+// resync заставляет всех подписчиков догрузить пропущенные сообщения из истории
+// и сообщить клиентам о возможной потере остальных событий
+func (s *srv) resync(_ context.Context) {
+	logger.Warn("pubsub events may have been lost, resyncing subscribers")
+	s.chatHub.Resync()
+}
+
+// latestMessageID возвращает id последнего сообщения чата (0, если сообщений нет)
+func (s *srv) latestMessageID(ctx context.Context, chatID int64) (int64, error) {
+	var messages []*model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		messages, errTx = s.chatRepository.GetChatMessages(ctx, &model.MessagesFilter{
+			ChatID: chatID,
+			Limit:  1,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	return messages[0].ID, nil
+}
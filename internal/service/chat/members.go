@@ -0,0 +1,198 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddChatMembers добавляет пользователей в чат от имени его владельца или
+// администратора. Пользователи, уже состоящие в чате, пропускаются. Подключенные
+// к чату пользователи получают событие о каждом действительно добавленном участнике
+func (s *srv) AddChatMembers(ctx context.Context, chatID int64, actor string, usernames []string) (*emptypb.Empty, error) {
+	members := uniqueUsernames(usernames)
+	if len(members) == 0 {
+		return nil, errs.InvalidArgument("usernames", "usernames can't be empty")
+	}
+
+	var added []string
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, strings.TrimSpace(actor), actionManageMembers)
+		if errTx != nil {
+			return errTx
+		}
+
+		added, errTx = s.chatRepository.AddChatMembers(ctx, chatID, members)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, username := range added {
+		err = s.pubSub.Publish(ctx, &pubsub.Event{
+			Type:     pubsub.EventTypeMemberAdded,
+			ChatID:   chatID,
+			Username: username,
+		})
+		if err != nil {
+			// событие нужно только для уведомления подключенных пользователей
+			logger.Error("failed to publish member addition", zap.Int64("chatID", chatID),
+				zap.String("username", username), zap.Error(err))
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveChatMember удаляет пользователя из чата и отключает все его сессии в
+// этом чате. Удалить можно только участника с меньшими правами: владелец удаляет
+// администраторов и участников, администратор - только участников
+func (s *srv) RemoveChatMember(ctx context.Context, chatID int64, actor string, username string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+	username = strings.TrimSpace(username)
+	if len(username) == 0 {
+		return nil, errs.InvalidArgument("member", "member can't be empty")
+	}
+
+	if username == actor {
+		return s.LeaveChat(ctx, chatID, actor)
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		actorRole, errTx := s.authorize(ctx, chatID, actor, actionManageMembers)
+		if errTx != nil {
+			return errTx
+		}
+
+		role, errTx := s.chatRepository.GetMemberRole(ctx, chatID, username)
+		if isNotMember(errTx) {
+			// пользователь уже не состоит в чате
+			return nil
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		if role <= actorRole {
+			return errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't remove user %s from chat %d",
+				actor, username, chatID)
+		}
+
+		_, errTx = s.chatRepository.RemoveChatMember(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.disconnectMember(ctx, chatID, username)
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveChat выводит пользователя из чата и отключает все его сессии в этом чате.
+// Повторный выход из чата не считается ошибкой. Владелец может покинуть чат
+// только после передачи владения
+func (s *srv) LeaveChat(ctx context.Context, chatID int64, username string) (*emptypb.Empty, error) {
+	username = strings.TrimSpace(username)
+	if len(username) == 0 {
+		return nil, errs.InvalidArgument("username", "username can't be empty")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		role, errTx := s.chatRepository.GetMemberRole(ctx, chatID, username)
+		if isNotMember(errTx) {
+			return nil
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == model.RoleOwner {
+			return errs.FailedPrecondition("OWNER_CANNOT_LEAVE",
+				"owner must transfer ownership of chat %d before leaving it", chatID)
+		}
+
+		_, errTx = s.chatRepository.RemoveChatMember(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.disconnectMember(ctx, chatID, username)
+
+	return &emptypb.Empty{}, nil
+}
+
+// disconnectMember отключает сессии пользователя в чате на этом экземпляре
+// сервера и через pub/sub на остальных. Отключение выполняется, даже если
+// пользователь уже не состоял в чате: повторный запрос исправит потерянное событие
+func (s *srv) disconnectMember(ctx context.Context, chatID int64, username string) {
+	disconnected := s.chatHub.Disconnect(chatID, username, hub.ErrRemovedFromChat)
+
+	err := s.pubSub.Publish(ctx, &pubsub.Event{
+		Type:     pubsub.EventTypeMemberRemoved,
+		ChatID:   chatID,
+		Username: username,
+	})
+	if err != nil {
+		logger.Error("failed to publish member removal", zap.Int64("chatID", chatID),
+			zap.String("username", username), zap.Error(err))
+	}
+
+	logger.Info("removed user from chat", zap.Int64("chatID", chatID),
+		zap.String("username", username), zap.Int("disconnectedSessions", disconnected))
+}
+
+// isNotMember проверяет, что ошибка репо означает отсутствие пользователя в чате
+func isNotMember(err error) bool {
+	e, ok := errs.As(err)
+	return ok && e.Kind == errs.KindNotFound && e.Subject == "member"
+}
+
+// uniqueUsernames возвращает непустые имена пользователей без повторов
+func uniqueUsernames(usernames []string) []string {
+	seen := make(map[string]struct{}, len(usernames))
+	unique := make([]string, 0, len(usernames))
+
+	for _, username := range usernames {
+		username = strings.TrimSpace(username)
+		if len(username) == 0 {
+			continue
+		}
+
+		if _, ok := seen[username]; ok {
+			continue
+		}
+
+		seen[username] = struct{}{}
+		unique = append(unique, username)
+	}
+
+	return unique
+}
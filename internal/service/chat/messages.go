@@ -0,0 +1,149 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// EditMessage меняет текст сообщения от имени его автора. Подключенные к чату
+// пользователи получают измененное сообщение через outbox
+func (s *srv) EditMessage(ctx context.Context, chatID int64, messageID int64, actor string, text string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+	if len(text) == 0 {
+		return nil, errs.InvalidArgument("text", "message's text can't be empty")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.actorRole(ctx, chatID, actor)
+		if errTx != nil {
+			return errTx
+		}
+
+		msg, errTx := s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if msg.From != actor {
+			return errs.PermissionDenied("NOT_MESSAGE_AUTHOR", "user %s can't edit message %d of another user", actor, messageID)
+		}
+
+		if msg.Deleted {
+			return errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID)
+		}
+
+		edited, errTx := s.chatRepository.EditMessage(ctx, messageID, text)
+		if errTx != nil {
+			return errTx
+		}
+
+		// сообщение удалили после его загрузки
+		if !edited {
+			return errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID)
+		}
+
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessageEdited,
+			ChatID:    chatID,
+			MessageID: messageID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteMessage помечает сообщение удаленным от имени его автора, владельца или
+// администратора чата. Повторное удаление сообщения не считается ошибкой.
+// Закрепленное сообщение при удалении открепляется
+func (s *srv) DeleteMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		role, errTx := s.actorRole(ctx, chatID, actor)
+		if errTx != nil {
+			return errTx
+		}
+
+		msg, errTx := s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if msg.From != actor && role > permissions[actionDeleteMessages] {
+			return errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't %s %d", actor, actionDeleteMessages, chatID)
+		}
+
+		if msg.Deleted {
+			return nil
+		}
+
+		deleted, errTx := s.chatRepository.DeleteMessage(ctx, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// сообщение уже удалено параллельным запросом, событие о нем отправлено
+		if !deleted {
+			return nil
+		}
+
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessageDeleted,
+			ChatID:    chatID,
+			MessageID: messageID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		unpinned, errTx := s.chatRepository.UnpinMessage(ctx, chatID, messageID)
+		if errTx != nil || !unpinned {
+			return errTx
+		}
+
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessageUnpinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// chatMessage загружает сообщение и проверяет, что оно принадлежит чату
+func (s *srv) chatMessage(ctx context.Context, chatID int64, messageID int64) (*model.Message, error) {
+	msg, err := s.chatRepository.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.ChatID != chatID {
+		return nil, errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,135 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxPinnedMessages максимальное количество закрепленных сообщений в чате
+const maxPinnedMessages = 50
+
+// PinMessage закрепляет сообщение чата от имени его владельца или администратора.
+// Подключенные к чату пользователи получают закрепленное сообщение через outbox.
+// Повторное закрепление сообщения не считается ошибкой
+func (s *srv) PinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, actor, actionPinMessages)
+		if errTx != nil {
+			return errTx
+		}
+
+		msg, errTx := s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if msg.Deleted {
+			return errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID)
+		}
+
+		// количество считается до закрепления под блокировкой чата, поэтому
+		// параллельные закрепления не превысят ограничение
+		pinnedCount, errTx := s.chatRepository.CountPinnedMessages(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		pinned, errTx := s.chatRepository.PinMessage(ctx, chatID, messageID, actor)
+		if errTx != nil || !pinned {
+			return errTx
+		}
+
+		// ограничение проверяется после закрепления, чтобы повторное закрепление
+		// в заполненном чате не было ошибкой. Ошибка откатывает транзакцию
+		if pinnedCount >= maxPinnedMessages {
+			return errs.FailedPrecondition("TOO_MANY_PINNED_MESSAGES",
+				"chat %d can't have more than %d pinned messages", chatID, maxPinnedMessages)
+		}
+
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessagePinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnpinMessage открепляет сообщение чата от имени его владельца или администратора.
+// Открепление незакрепленного сообщения не считается ошибкой
+func (s *srv) UnpinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, actor, actionPinMessages)
+		if errTx != nil {
+			return errTx
+		}
+
+		unpinned, errTx := s.chatRepository.UnpinMessage(ctx, chatID, messageID)
+		if errTx != nil || !unpinned {
+			return errTx
+		}
+
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessageUnpinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPinnedMessages проверяет, что пользователь состоит в чате, и возвращает
+// закрепленные сообщения чата, начиная с закрепленных последними
+func (s *srv) ListPinnedMessages(ctx context.Context, chatID int64, username string) ([]*model.Message, error) {
+	username = strings.TrimSpace(username)
+
+	var messages []*model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		messages, errTx = s.chatRepository.GetPinnedMessages(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,219 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+)
+
+// presenceQueryTimeout сколько ждать списка чатов пользователя при рассылке
+// изменения его подключения
+const presenceQueryTimeout = 5 * time.Second
+
+// GetPresence возвращает, подключены ли пользователи к какому-либо чату на любом
+// экземпляре сервера, и время их последнего отключения. Пользователи
+// возвращаются в порядке запроса без повторов
+func (s *srv) GetPresence(ctx context.Context, usernames []string) ([]*model.Presence, error) {
+	usernames = uniqueUsernames(usernames)
+	if len(usernames) == 0 {
+		return nil, errs.InvalidArgument("usernames", "usernames can't be empty")
+	}
+
+	var lastSeen map[string]time.Time
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		lastSeen, errTx = s.chatRepository.GetLastSeen(ctx, usernames)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	presences := make([]*model.Presence, 0, len(usernames))
+	for _, username := range usernames {
+		seenAt, ok := lastSeen[username]
+		presences = append(presences, &model.Presence{
+			Username:   username,
+			Online:     s.presenceRegistry.IsOnline(username),
+			LastSeenAt: sql.NullTime{Time: seenAt, Valid: ok},
+		})
+	}
+
+	return presences, nil
+}
+
+// announceLock мьютекс объявлений одного пользователя и количество его ожидающих
+type announceLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lockAnnounce блокирует объявления пользователя и возвращает функцию разблокировки
+func (s *srv) lockAnnounce(username string) func() {
+	s.announceMu.Lock()
+	if s.announceLocks == nil {
+		s.announceLocks = make(map[string]*announceLock)
+	}
+	l, ok := s.announceLocks[username]
+	if !ok {
+		l = &announceLock{}
+		s.announceLocks[username] = l
+	}
+	l.refs++
+	s.announceMu.Unlock()
+
+	l.mu.Lock()
+
+	return func() {
+		l.mu.Unlock()
+
+		s.announceMu.Lock()
+		defer s.announceMu.Unlock()
+
+		l.refs--
+		if l.refs == 0 {
+			delete(s.announceLocks, username)
+		}
+	}
+}
+
+// userConnected объявляет другим экземплярам сервера, что пользователь подключен к этому
+func (s *srv) userConnected(ctx context.Context, username string) {
+	unlock := s.lockAnnounce(username)
+	defer unlock()
+
+	err := s.presenceAnnouncer.Announce(ctx, []string{username}, true)
+	if err != nil {
+		// подключение объявится при следующем периодическом объявлении
+		logger.Error("failed to announce connected user", zap.String("username", username), zap.Error(err))
+	}
+}
+
+// userDisconnected запоминает время отключения пользователя и объявляет другим
+// экземплярам сервера, что он отключен от этого. Вызывается после завершения
+// последней сессии пользователя на этом экземпляре
+func (s *srv) userDisconnected(ctx context.Context, username string) {
+	// stream уже закрыт, но отключение нужно сохранить
+	ctx = context.WithoutCancel(ctx)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.chatRepository.UpdateLastSeen(ctx, username)
+	})
+	if err != nil {
+		logger.Error("failed to update user's last seen time", zap.String("username", username), zap.Error(err))
+	}
+
+	unlock := s.lockAnnounce(username)
+	defer unlock()
+
+	// пока сохранялось время отключения, пользователь мог подключиться снова.
+	// Его новая сессия уже объявила или объявит подключение, и отключение,
+	// объявленное после него, оставило бы пользователя не в сети
+	if s.chatHub.IsOnline(username) {
+		return
+	}
+
+	err = s.presenceAnnouncer.Announce(ctx, []string{username}, false)
+	if err != nil {
+		// подключение истечет в реестрах остальных экземпляров само
+		logger.Error("failed to announce disconnected user", zap.String("username", username), zap.Error(err))
+	}
+}
+
+// handlePresence передает объявление экземпляра сервера в реестр подключенных пользователей
+func (s *srv) handlePresence(event *pubsub.Event) {
+	for _, username := range event.Usernames {
+		s.presenceRegistry.Set(event.InstanceID, username, event.IsOnline)
+	}
+}
+
+// presenceChanged рассылает подписчикам общих с пользователем чатов на этом
+// экземпляре сервера, что пользователь подключился или отключился от всех чатов.
+// Вызывается из горутины обработчика pub/sub, поэтому чаты пользователя
+// запрашиваются в отдельной горутине
+func (s *srv) presenceChanged(username string, online bool) {
+	presence := &model.Presence{
+		Username: username,
+		Online:   online,
+	}
+
+	s.presenceMu.Lock()
+	if s.presenceVersions == nil {
+		s.presenceVersions = make(map[string]uint64)
+	}
+	s.presenceSeq++
+	version := s.presenceSeq
+	s.presenceVersions[username] = version
+	s.presenceMu.Unlock()
+
+	go s.publishPresence(presence, version)
+}
+
+// publishPresence рассылает изменение подключения пользователя, если за время
+// запроса его чатов не произошло более нового изменения: иначе рассылкой
+// займется оно, и подписчики не получат устаревшее состояние после актуального.
+// Время отключения берется из БД, как и в GetPresence
+func (s *srv) publishPresence(presence *model.Presence, version uint64) {
+	username := presence.Username
+
+	ctx, cancel := context.WithTimeout(context.Background(), presenceQueryTimeout)
+	defer cancel()
+
+	var chatIDs []int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		chatIDs, errTx = s.chatRepository.GetUserChatIDs(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		if presence.Online || len(chatIDs) == 0 {
+			return nil
+		}
+
+		lastSeen, errTx := s.chatRepository.GetLastSeen(ctx, []string{username})
+		if errTx != nil {
+			return errTx
+		}
+
+		seenAt, ok := lastSeen[username]
+		presence.LastSeenAt = sql.NullTime{Time: seenAt, Valid: ok}
+
+		return nil
+	})
+
+	s.presenceMu.Lock()
+	defer s.presenceMu.Unlock()
+
+	if s.presenceVersions[username] != version {
+		return
+	}
+	delete(s.presenceVersions, username)
+
+	if err != nil {
+		logger.Error("failed to get user's chats or last seen time", zap.String("username", username), zap.Error(err))
+		return
+	}
+
+	for _, chatID := range chatIDs {
+		s.chatHub.Publish(chatID, &model.ChatEvent{
+			Type:     model.ChatEventPresence,
+			ChatID:   chatID,
+			Username: username,
+			Presence: presence,
+		})
+	}
+}
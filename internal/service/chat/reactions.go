@@ -0,0 +1,116 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxEmojiLength максимальная длина реакции в символах. Эмодзи из нескольких
+// кодовых точек (флаги, семьи, оттенки кожи) занимают до 10 символов
+const maxEmojiLength = 16
+
+// AddReaction ставит реакцию участника чата на сообщение. Подключенные к чату
+// пользователи получают сообщение с новыми реакциями через outbox. Повторная
+// реакция не считается ошибкой
+func (s *srv) AddReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+	emoji, err := validateEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, actor)
+		if errTx != nil {
+			return errTx
+		}
+
+		msg, errTx := s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if msg.Deleted {
+			return errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID)
+		}
+
+		added, errTx := s.chatRepository.AddReaction(ctx, messageID, actor, emoji)
+		if errTx != nil || !added {
+			return errTx
+		}
+
+		return s.reactionsChanged(ctx, chatID, messageID)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveReaction убирает реакцию участника чата с сообщения. Удаление
+// реакции, которую участник не ставил, не считается ошибкой
+func (s *srv) RemoveReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+	emoji, err := validateEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, actor)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		removed, errTx := s.chatRepository.RemoveReaction(ctx, messageID, actor, emoji)
+		if errTx != nil || !removed {
+			return errTx
+		}
+
+		return s.reactionsChanged(ctx, chatID, messageID)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// reactionsChanged сохраняет в outbox событие об изменении реакций на сообщение
+func (s *srv) reactionsChanged(ctx context.Context, chatID int64, messageID int64) error {
+	_, err := s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+		Type:      model.OutboxEventReactionsChanged,
+		ChatID:    chatID,
+		MessageID: messageID,
+	})
+
+	return err
+}
+
+// validateEmoji проверяет реакцию и возвращает ее без пробелов по краям
+func validateEmoji(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if len(emoji) == 0 {
+		return "", errs.InvalidArgument("emoji", "emoji can't be empty")
+	}
+
+	if utf8.RuneCountInString(emoji) > maxEmojiLength {
+		return "", errs.InvalidArgument("emoji", "emoji can't be longer than %d characters", maxEmojiLength)
+	}
+
+	return emoji, nil
+}
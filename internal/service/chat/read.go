@@ -0,0 +1,107 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxReadReceiptMembers максимальное количество участников чата, которым
+// рассылаются события о прочтении. В больших чатах их слишком много
+const maxReadReceiptMembers = 20
+
+// MarkRead отмечает сообщения чата до upToMessageID включительно прочитанными
+// пользователем и сохраняет время прочтения каждого из них. Курсор прочтения
+// не сдвигается назад, поэтому повторная или запоздавшая отметка ничего не меняет
+func (s *srv) MarkRead(ctx context.Context, chatID int64, username string, upToMessageID int64) (*emptypb.Empty, error) {
+	username = strings.TrimSpace(username)
+	if len(username) == 0 {
+		return nil, errs.InvalidArgument("username", "username can't be empty")
+	}
+
+	if upToMessageID <= 0 {
+		return nil, errs.InvalidArgument("up_to_message_id", "up_to_message_id must be positive")
+	}
+
+	var (
+		advanced bool
+		members  int
+		// время прочтения берется с тех же часов и в той же зоне, что и время доставки
+		readAt = time.Now().UTC()
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.chatMessage(ctx, chatID, upToMessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		prevCursor, errTx := s.chatRepository.GetReadCursor(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		advanced, errTx = s.chatRepository.MarkRead(ctx, chatID, username, upToMessageID)
+		if errTx != nil || !advanced {
+			return errTx
+		}
+
+		// отметки о прочтении сохраняются одним запросом на все сообщения,
+		// прочитанные с прошлого сдвига курсора
+		errTx = s.chatRepository.AddReadReceipts(ctx, chatID, username, prevCursor, upToMessageID, readAt)
+		if errTx != nil {
+			return errTx
+		}
+
+		members, errTx = s.chatRepository.CountChatMembers(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if advanced && members <= maxReadReceiptMembers {
+		err = s.pubSub.Publish(ctx, &pubsub.Event{
+			Type:      pubsub.EventTypeRead,
+			ChatID:    chatID,
+			MessageID: upToMessageID,
+			Username:  username,
+		})
+		if err != nil {
+			// курсор уже сохранен, событие о прочтении не обязательно
+			logger.Error("failed to publish read receipt", zap.Int64("chatID", chatID),
+				zap.String("username", username), zap.Error(err))
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// handleRead рассылает подписчикам чата на этом экземпляре сервера, что
+// участник прочитал сообщения. Событие получают и другие сессии самого
+// участника, чтобы отметить сообщения прочитанными на всех устройствах
+func (s *srv) handleRead(event *pubsub.Event) {
+	s.chatHub.Publish(event.ChatID, &model.ChatEvent{
+		Type:     model.ChatEventRead,
+		ChatID:   event.ChatID,
+		Username: event.Username,
+		ReadUpTo: event.MessageID,
+	})
+}
@@ -0,0 +1,90 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// sentWindow на сколько id назад от последнего отправленного сообщения
+// подключение помнит отправленные сообщения
+const sentWindow = 1000
+
+// sentMessages id сообщений, уже отправленных в stream. Одно и то же сообщение
+// может прийти и из истории, и из живой доставки, а outbox доставляет
+// сообщения at-least-once
+type sentMessages map[int64]struct{}
+
+func (m sentMessages) has(id int64) bool {
+	_, ok := m[id]
+	return ok
+}
+
+// add запоминает id и забывает слишком старые id, чтобы множество не росло
+// все время подключения
+func (m sentMessages) add(id int64) {
+	m[id] = struct{}{}
+
+	if len(m) <= 2*sentWindow {
+		return
+	}
+
+	for sentID := range m {
+		if sentID < id-sentWindow {
+			delete(m, sentID)
+		}
+	}
+}
+
+// replayHistory постранично отправляет через send сохраненные сообщения чата с id
+// больше afterID и возвращает id последнего отправленного сообщения. Id отправленных
+// сообщений сохраняются в sent, чтобы при живой доставке не отправить их повторно
+func (s *srv) replayHistory(ctx context.Context, chatID int64, afterID int64,
+	send eventSender, sent sentMessages,
+) (int64, error) {
+	lastID := afterID
+
+	for {
+		var messages []*model.Message
+		err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			var errTx error
+			messages, errTx = s.chatRepository.GetChatMessages(ctx, &model.MessagesFilter{
+				ChatID:  chatID,
+				AfterID: lastID,
+				Limit:   maxMessagesLimit,
+			})
+			if errTx != nil {
+				return errTx
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return lastID, err
+		}
+
+		for _, msg := range messages {
+			lastID = msg.ID
+			if sent.has(msg.ID) {
+				continue
+			}
+
+			err := send(&model.ChatEvent{
+				Type:    model.ChatEventMessage,
+				ChatID:  chatID,
+				Message: msg,
+			})
+			if err != nil {
+				return lastID, err
+			}
+			sent.add(msg.ID)
+		}
+
+		if len(messages) < maxMessagesLimit {
+			break
+		}
+	}
+
+	return lastID, nil
+}
@@ -0,0 +1,173 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// action действие в чате, для которого нужна определенная роль
+type action string
+
+const (
+	actionDeleteChat     action = "delete chat"
+	actionRenameChat     action = "rename chat"
+	actionManageMembers  action = "manage members of chat"
+	actionManageRoles    action = "manage roles in chat"
+	actionDeleteMessages action = "delete messages of other users in chat"
+	actionPinMessages    action = "pin messages in chat"
+)
+
+// permissions минимальная роль, необходимая для действия
+var permissions = map[action]model.Role{
+	actionDeleteChat:     model.RoleOwner,
+	actionRenameChat:     model.RoleAdmin,
+	actionManageMembers:  model.RoleAdmin,
+	actionManageRoles:    model.RoleOwner,
+	actionDeleteMessages: model.RoleAdmin,
+	actionPinMessages:    model.RoleAdmin,
+}
+
+// authorize проверяет, что роли пользователя в чате достаточно для действия, и возвращает ее
+func (s *srv) authorize(ctx context.Context, chatID int64, username string, act action) (model.Role, error) {
+	role, err := s.actorRole(ctx, chatID, username)
+	if err != nil {
+		return 0, err
+	}
+
+	if role > permissions[act] {
+		return 0, errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't %s %d", username, act, chatID)
+	}
+
+	return role, nil
+}
+
+// actorRole возвращает роль пользователя, выполняющего действие в чате.
+// У пользователя, не состоящего в чате, нет прав на действия в нем
+func (s *srv) actorRole(ctx context.Context, chatID int64, username string) (model.Role, error) {
+	role, err := s.chatRepository.GetMemberRole(ctx, chatID, username)
+	if isNotMember(err) {
+		return 0, errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+	}
+
+	return role, err
+}
+
+// RenameChat меняет название чата от имени его владельца или администратора
+func (s *srv) RenameChat(ctx context.Context, chatID int64, actor string, name string) (*emptypb.Empty, error) {
+	if len(name) == 0 {
+		return nil, errs.InvalidArgument("name", "chat's name can't be empty")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, strings.TrimSpace(actor), actionRenameChat)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.RenameChat(ctx, chatID, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// TransferOwnership передает владение чатом другому участнику.
+// Прежний владелец становится администратором
+func (s *srv) TransferOwnership(ctx context.Context, chatID int64, actor string, newOwner string) (*emptypb.Empty, error) {
+	actor = strings.TrimSpace(actor)
+	newOwner = strings.TrimSpace(newOwner)
+	if len(newOwner) == 0 {
+		return nil, errs.InvalidArgument("new_owner", "new owner can't be empty")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, actor, actionManageRoles)
+		if errTx != nil {
+			return errTx
+		}
+
+		if newOwner == actor {
+			return nil
+		}
+
+		_, errTx = s.chatRepository.GetMemberRole(ctx, chatID, newOwner)
+		if errTx != nil {
+			return errTx
+		}
+
+		// у чата может быть только один владелец, поэтому сначала
+		// понижаем прежнего владельца
+		errTx = s.chatRepository.SetMemberRole(ctx, chatID, actor, model.RoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.SetMemberRole(ctx, chatID, newOwner, model.RoleOwner)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetMemberRole назначает участнику чата роль администратора или обычного участника.
+// Роль владельца передается только через TransferOwnership
+func (s *srv) SetMemberRole(ctx context.Context, chatID int64, actor string, member string, role model.Role) (*emptypb.Empty, error) {
+	member = strings.TrimSpace(member)
+	if len(member) == 0 {
+		return nil, errs.InvalidArgument("member", "member can't be empty")
+	}
+
+	if role != model.RoleAdmin && role != model.RoleMember {
+		return nil, errs.InvalidArgument("role", "role must be admin or member")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.authorize(ctx, chatID, strings.TrimSpace(actor), actionManageRoles)
+		if errTx != nil {
+			return errTx
+		}
+
+		current, errTx := s.chatRepository.GetMemberRole(ctx, chatID, member)
+		if errTx != nil {
+			return errTx
+		}
+
+		if current == model.RoleOwner {
+			return errs.FailedPrecondition("OWNER_ROLE", "owner's role can be changed only by transferring ownership of chat %d", chatID)
+		}
+
+		errTx = s.chatRepository.SetMemberRole(ctx, chatID, member, role)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
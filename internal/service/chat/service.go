@@ -2,69 +2,175 @@ package chat
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/converter"
+	"github.com/solumD/chat-server/internal/delivery"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
 	"github.com/solumD/chat-server/internal/logger"
 	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/presence"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
 	"github.com/solumD/chat-server/internal/repository"
 	"github.com/solumD/chat-server/internal/service"
+	"github.com/solumD/chat-server/internal/typing"
 	"github.com/solumD/chat-server/pkg/chat_v1"
 	"go.uber.org/zap"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// Структура сервисного слоя с объектами репо слоя
-// и транзакционного менеджера
-type srv struct {
-	chatRepository repository.ChatRepository
-	txManager      db.TxManager
+const (
+	defaultMessagesLimit = 50  // размер страницы истории по умолчанию
+	maxMessagesLimit     = 100 // максимальный размер страницы истории
+)
 
-	chatStreams map[int64]map[string]chat_v1.ChatV1_ConnectChatServer
-	msgChans    map[int64]chan *chat_v1.Message
-	mu          *sync.RWMutex
+// Структура сервисного слоя с объектами репо слоя, транзакционного менеджера,
+// hub'а подписчиков чатов, pub/sub для доставки сообщений между экземплярами,
+// индикаторов набора текста, подключенных пользователей и доставок сообщений
+type srv struct {
+	chatRepository   repository.ChatRepository
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	chatHub          *hub.Hub
+	pubSub           pubsub.PubSub
+	typingTracker    *typing.Tracker
+	typingLimiter    *typing.Limiter
+
+	presenceRegistry  *presence.Registry
+	presenceAnnouncer *presence.Announcer
+
+	// presenceVersions номер последнего изменения подключения пользователя,
+	// рассылка которого еще не завершена
+	presenceMu       sync.Mutex
+	presenceSeq      uint64
+	presenceVersions map[string]uint64
+
+	// announceLocks упорядочивают объявления подключения и отключения пользователя
+	announceMu    sync.Mutex
+	announceLocks map[string]*announceLock
+
+	deliveryRecorder *delivery.Recorder
 }
 
 // NewService возвращает объект сервисного слоя
-func NewService(chatRepository repository.ChatRepository, txManager db.TxManager) service.ChatService {
-	return &srv{
-		chatRepository: chatRepository,
-		txManager:      txManager,
-		chatStreams:    make(map[int64]map[string]chat_v1.ChatV1_ConnectChatServer),
-		msgChans:       make(map[int64]chan *chat_v1.Message),
-		mu:             &sync.RWMutex{},
+func NewService(chatRepository repository.ChatRepository, outboxRepository repository.OutboxRepository,
+	txManager db.TxManager, chatHub *hub.Hub, pubSub pubsub.PubSub,
+	typingTracker *typing.Tracker, typingLimiter *typing.Limiter,
+	presenceRegistry *presence.Registry, presenceAnnouncer *presence.Announcer,
+	deliveryRecorder *delivery.Recorder,
+) service.ChatService {
+	s := &srv{
+		chatRepository:   chatRepository,
+		outboxRepository: outboxRepository,
+		txManager:        txManager,
+		chatHub:          chatHub,
+		pubSub:           pubSub,
+		typingTracker:    typingTracker,
+		typingLimiter:    typingLimiter,
+
+		presenceRegistry:  presenceRegistry,
+		presenceAnnouncer: presenceAnnouncer,
+
+		deliveryRecorder: deliveryRecorder,
 	}
+
+	pubSub.Subscribe(s.handleEvent, s.resync)
+	typingTracker.OnExpire(s.typingExpired)
+	presenceRegistry.OnChange(s.presenceChanged)
+
+	return s
 }
 
 // NewMockService возвращает объект мока сервисного слоя
 func NewMockService(deps ...interface{}) service.ChatService {
-	serv := srv{}
+	serv := srv{
+		chatHub:       hub.New(hub.DefaultQueueSize, hub.PolicyDropOldest),
+		pubSub:        memory.New(),
+		typingTracker: typing.New(typing.DefaultTTL),
+		typingLimiter: typing.NewLimiter(typing.DefaultRateLimit, typing.DefaultRateWindow),
+
+		presenceRegistry: presence.New(presence.DefaultTTL),
+	}
 
 	for _, v := range deps {
 		switch s := v.(type) {
 		case repository.ChatRepository:
 			serv.chatRepository = s
+		case repository.OutboxRepository:
+			serv.outboxRepository = s
 		case db.TxManager:
 			serv.txManager = s
+		case *hub.Hub:
+			serv.chatHub = s
+		case pubsub.PubSub:
+			serv.pubSub = s
+		case *typing.Tracker:
+			serv.typingTracker = s
+		case *typing.Limiter:
+			serv.typingLimiter = s
+		case *presence.Registry:
+			serv.presenceRegistry = s
+		case *presence.Announcer:
+			serv.presenceAnnouncer = s
+		case *delivery.Recorder:
+			serv.deliveryRecorder = s
 		}
 	}
 
+	if serv.presenceAnnouncer == nil {
+		serv.presenceAnnouncer = presence.NewAnnouncer(presence.NewInstanceID(), serv.pubSub,
+			serv.chatHub.OnlineUsers, presence.DefaultHeartbeatInterval)
+	}
+	if serv.deliveryRecorder == nil {
+		serv.deliveryRecorder = delivery.NewRecorder(serv.chatRepository, serv.txManager,
+			delivery.DefaultFlushInterval, delivery.DefaultBatchSize)
+	}
+
+	serv.pubSub.Subscribe(serv.handleEvent, serv.resync)
+	serv.typingTracker.OnExpire(serv.typingExpired)
+	serv.presenceRegistry.OnChange(serv.presenceChanged)
+
 	return &serv
 }
 
-// CreateChat отправляет запрос в репо слой на создание чата
+// CreateChat отправляет запрос в репо слой на создание чата. Создатель чата
+// становится его владельцем. Если создатель не указан, владельцем становится
+// первый пользователь из списка
 func (s *srv) CreateChat(ctx context.Context, chat *model.Chat) (int64, error) {
 	if len(chat.Name) == 0 {
-		return 0, fmt.Errorf("chat's name can't be empty")
+		return 0, errs.InvalidArgument("name", "chat's name can't be empty")
+	}
+
+	newChat := *chat
+	newChat.Usernames = uniqueUsernames(chat.Usernames)
+	newChat.Creator = strings.TrimSpace(chat.Creator)
+	if len(newChat.Creator) == 0 && len(newChat.Usernames) != 0 {
+		newChat.Creator = newChat.Usernames[0]
+	}
+
+	// чат без участников не создавался и раньше: репо не может сохранить пустой
+	// список участников. Теперь об этом сообщается ошибкой валидации
+	if len(newChat.Creator) == 0 {
+		return 0, errs.InvalidArgument("username", "chat's creator can't be empty")
+	}
+
+	if !slices.Contains(newChat.Usernames, newChat.Creator) {
+		newChat.Usernames = append([]string{newChat.Creator}, newChat.Usernames...)
 	}
 
 	var chatID int64
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
-		chatID, errTx = s.chatRepository.CreateChat(ctx, chat)
+		chatID, errTx = s.chatRepository.CreateChat(ctx, &newChat)
 		if errTx != nil {
 			return errTx
 		}
@@ -79,10 +185,14 @@ func (s *srv) CreateChat(ctx context.Context, chat *model.Chat) (int64, error) {
 	return chatID, nil
 }
 
-// DeleteChat отправляет запрос в репо слой на удаление чата
-func (s *srv) DeleteChat(ctx context.Context, chatID int64) (*emptypb.Empty, error) {
+// DeleteChat отправляет запрос в репо слой на удаление чата от имени его владельца
+func (s *srv) DeleteChat(ctx context.Context, chatID int64, actor string) (*emptypb.Empty, error) {
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		var errTx error
+		_, errTx := s.authorize(ctx, chatID, strings.TrimSpace(actor), actionDeleteChat)
+		if errTx != nil {
+			return errTx
+		}
+
 		_, errTx = s.chatRepository.DeleteChat(ctx, chatID)
 		if errTx != nil {
 			return errTx
@@ -117,14 +227,87 @@ func (s *srv) GetUserChats(ctx context.Context, username string) ([]*model.Chat,
 	return chatsInfo, nil
 }
 
-// ConnectChat подключает пользователя к чату по id
-func (s *srv) ConnectChat(ctx context.Context, chatID int64, username string,
+// ConnectChat подключает пользователя к чату по id и отправляет в stream новые,
+// измененные и удаленные сообщения. Если указан sinceMessageID, то перед живой
+// доставкой пользователю отправляются сохраненные сообщения после него. Если
+// подписчик отстал, ему догружаются только новые сообщения: изменения и удаления,
+// потерянные за это время, в этот stream не попадут. Сообщить о такой потере
+// может только ConnectChatEvents
+func (s *srv) ConnectChat(ctx context.Context, chatID int64, username string, sinceMessageID int64,
 	stream chat_v1.ChatV1_ConnectChatServer,
+) error {
+	return s.connect(ctx, chatID, username, sinceMessageID, stream.Context(), func(event *model.ChatEvent) error {
+		// stream сообщений передает только новые, измененные и удаленные сообщения:
+		// события реакций и закрепления тоже несут сообщение, но старые клиенты
+		// приняли бы его за новое
+		switch event.Type {
+		case model.ChatEventMessage, model.ChatEventMessageEdited, model.ChatEventMessageDeleted:
+		default:
+			return nil
+		}
+
+		return stream.Send(converter.ToDescMessageFromService(event.Message))
+	})
+}
+
+// ConnectChatEvents подключает пользователя к чату по id и отправляет в stream
+// все события чата. Догрузка истории работает так же, как в ConnectChat
+func (s *srv) ConnectChatEvents(ctx context.Context, chatID int64, username string, sinceMessageID int64,
+	stream chat_v1.ChatV1_ConnectChatEventsServer,
+) error {
+	return s.connect(ctx, chatID, username, sinceMessageID, stream.Context(), func(event *model.ChatEvent) error {
+		return stream.Send(converter.ToDescChatEventFromService(event))
+	})
+}
+
+// eventSender отправляет событие чата в stream подключения
+type eventSender func(event *model.ChatEvent) error
+
+// recordDeliveries возвращает eventSender, который запоминает доставку новых
+// сообщений других участников, успешно отправленных пользователю
+func (s *srv) recordDeliveries(username string, send eventSender) eventSender {
+	return func(event *model.ChatEvent) error {
+		err := send(event)
+		if err != nil {
+			return err
+		}
+
+		if event.Type == model.ChatEventMessage && event.Message.From != username {
+			s.deliveryRecorder.Record(event.Message.ID, username)
+		}
+
+		return nil
+	}
+}
+
+// connect подписывает пользователя на события чата и отправляет их через send,
+// пока не закроется streamCtx или пользователя не отключат от чата
+func (s *srv) connect(ctx context.Context, chatID int64, username string, sinceMessageID int64,
+	streamCtx context.Context, send eventSender,
 ) error {
 	logger.Info("connecting user to chat", zap.Int64("chatID", chatID), zap.String("username", username))
 
 	username = strings.TrimSpace(username)
 
+	// подписываемся до догрузки истории, чтобы не потерять сообщения,
+	// отправленные во время нее, и до проверки членства, чтобы сессию
+	// отключило удаление пользователя из чата сразу после проверки. У каждого
+	// подключения своя сессия, поэтому отключение одного устройства не
+	// затрагивает остальные
+	sub := s.chatHub.Subscribe(chatID, username)
+	connected := false
+	defer func() {
+		s.chatHub.Unsubscribe(sub)
+		logger.Info("disconnected user from chat", zap.Int64("chatID", chatID),
+			zap.String("username", username), zap.Int64("sessionID", sub.SessionID()))
+
+		// пользователь отключается, только когда на этом экземпляре
+		// у него не осталось сессий ни в одном чате
+		if connected && !s.chatHub.IsOnline(username) {
+			s.userDisconnected(ctx, username)
+		}
+	}()
+
 	// проверка, что чат есть в базе, а пользователь в нем состоит
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		errTx := s.chatRepository.CheckChat(ctx, chatID, username)
@@ -140,83 +323,179 @@ func (s *srv) ConnectChat(ctx context.Context, chatID int64, username string,
 		return err
 	}
 
-	s.mu.Lock()
-	// проверяем, соединялся ли уже кто-то с чатом, если нет, то создаем соединение и канал для сообщений
-	if _, exist := s.chatStreams[chatID]; !exist {
-		s.chatStreams[chatID] = make(map[string]chat_v1.ChatV1_ConnectChatServer)
-		s.msgChans[chatID] = make(chan *chat_v1.Message, 100)
-	}
+	logger.Info("connected user to chat", zap.Int64("chatID", chatID),
+		zap.String("username", username), zap.Int64("sessionID", sub.SessionID()))
+
+	connected = true
+	s.userConnected(ctx, username)
 
-	// добавляем или заменяем стрим пользователя и получаем канал с сообщениями
-	s.chatStreams[chatID][username] = stream
-	chatMsgChan := s.msgChans[chatID]
-	s.mu.Unlock()
+	send = s.recordDeliveries(username, send)
 
-	logger.Info("connected user to chat", zap.Int64("chatID", chatID), zap.String("username", username))
+	sent := sentMessages{}
+	lastID := sinceMessageID
+	if sinceMessageID == 0 {
+		// запоминаем последнее сообщение на момент подключения, чтобы при
+		// догрузке пропущенных сообщений не отправлять всю историю чата
+		lastID, err = s.latestMessageID(ctx, chatID)
+		if err != nil {
+			logger.Error("failed to get latest chat message", zap.Int64("chatID", chatID), zap.Error(err))
+			return err
+		}
+	} else {
+		lastID, err = s.replayHistory(ctx, chatID, lastID, send, sent)
+		if err != nil {
+			logger.Error("failed to replay chat history", zap.Int64("chatID", chatID), zap.Error(err))
+			return err
+		}
+	}
 
+	// горутина подключения - единственный писатель в свой stream
 	for {
 		select {
-		// если от кого-то пришло сообщение, то отправляем его всем подлюченным пользователям
-		case msg, ok := <-chatMsgChan:
-			if !ok {
-				return nil
+		case event := <-sub.Events():
+			// новое сообщение уже было отправлено при догрузке истории или
+			// повторно доставлено из outbox. Остальные события отправляются
+			// всегда, клиент применяет их повторно без последствий
+			isNew := event.Type == model.ChatEventMessage
+			if isNew && sent.has(event.Message.ID) {
+				continue
 			}
 
-			for _, st := range s.chatStreams[chatID] {
-				if err := st.Send(msg); err != nil {
-					return err
+			// свой набор текста и подключение пользователю не показываются
+			isOwn := event.Type == model.ChatEventTyping || event.Type == model.ChatEventPresence
+			if isOwn && event.Username == username {
+				continue
+			}
+
+			err = send(event)
+			if err != nil {
+				return err
+			}
+
+			if isNew {
+				sent.add(event.Message.ID)
+				if event.Message.ID > lastID {
+					lastID = event.Message.ID
 				}
 			}
 
-		case <-stream.Context().Done():
+		case <-sub.Lagged():
+			// часть сообщений не поместилась в очередь - догружаем их из истории
+			logger.Warn("subscriber lagged behind, replaying chat history", zap.Int64("chatID", chatID),
+				zap.String("username", username), zap.Int64("sessionID", sub.SessionID()), zap.Int64("lastID", lastID))
 
-			s.mu.Lock()
-			// удаляем подключение пользователя
-			delete(s.chatStreams[chatID], username)
+			lastID, err = s.replayHistory(ctx, chatID, lastID, send, sent)
+			if err != nil {
+				logger.Error("failed to replay chat history", zap.Int64("chatID", chatID), zap.Error(err))
+				return err
+			}
 
-			// если подключений не осталось, то удаляем из мапы чат и канал с его сообщениями
-			if len(s.chatStreams[chatID]) == 0 {
-				delete(s.chatStreams, chatID)
-				delete(s.msgChans, chatID)
+			// история восстанавливает только новые сообщения. Изменения, удаления,
+			// реакции и закрепления уже показанных сообщений могли потеряться,
+			// поэтому клиент загружает их заново
+			err = send(&model.ChatEvent{Type: model.ChatEventResync, ChatID: chatID})
+			if err != nil {
+				return err
+			}
+
+		case <-sub.Done():
+			if errors.Is(sub.Err(), hub.ErrSlowConsumer) {
+				logger.Warn("disconnected slow subscriber", zap.Int64("chatID", chatID), zap.String("username", username),
+					zap.Int64("sessionID", sub.SessionID()), zap.Any("hubMetrics", s.chatHub.Metrics()))
+
+				return status.Error(codes.ResourceExhausted, sub.Err().Error())
+			}
+
+			if errors.Is(sub.Err(), hub.ErrRemovedFromChat) {
+				return errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s was removed from chat %d", username, chatID)
 			}
-			s.mu.Unlock()
 
 			return nil
+
+		case <-streamCtx.Done():
+			return nil
 		}
 	}
-
 }
 
-// SendMessage сохраняет сообщение в репо и отправляет его в чат через stream
+// SendMessage сохраняет сообщение в репо вместе с событием outbox, которое затем
+// доставит подписчикам обработчик outbox. Отправителю достаточно состоять в чате,
+// подключение к нему не требуется
 func (s *srv) SendMessage(ctx context.Context, message *model.Message) (*emptypb.Empty, error) {
-	// проверяем, существует ли канал для сообщений чата
-	s.mu.RLock()
-	chatMsgChan, exist := s.msgChans[message.ChatID]
-	s.mu.RUnlock()
+	if len(message.From) == 0 {
+		return nil, errs.InvalidArgument("from", "from can't be empty")
+	}
+	if len(message.Text) == 0 {
+		return nil, errs.InvalidArgument("text", "message's text can't be empty")
+	}
+
+	// сообщение и событие о нем сохраняются атомарно, поэтому сохраненное
+	// сообщение не может потеряться для подписчиков. Если к чату никто не
+	// подключен, сообщение остается только в истории
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		saved, errTx := s.chatRepository.SendMessage(ctx, message)
+		if errTx != nil {
+			return errTx
+		}
 
-	if !exist {
-		return nil, fmt.Errorf("chat's %d connection not exist. connect to create it", message.ChatID)
+		_, errTx = s.outboxRepository.AddEvent(ctx, &model.OutboxEvent{
+			Type:      model.OutboxEventMessage,
+			ChatID:    saved.ChatID,
+			MessageID: saved.ID,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	// проверяем, подключен ли пользователь к чату
-	s.mu.RLock()
-	_, exist = s.chatStreams[message.ChatID][message.From]
-	s.mu.RUnlock()
+	return &emptypb.Empty{}, nil
+}
+
+// GetChatMessages проверяет, что пользователь состоит в чате, и
+// возвращает страницу истории сообщений чата
+func (s *srv) GetChatMessages(ctx context.Context, filter *model.MessagesFilter) (*model.MessagesPage, error) {
+	return s.messagesPage(ctx, filter, func(ctx context.Context, filter *model.MessagesFilter) error {
+		return s.chatRepository.CheckChat(ctx, filter.ChatID, filter.Username)
+	})
+}
 
-	if !exist {
-		return nil, fmt.Errorf("user %s is not connected to chat. connect to chat", message.From)
+// messagesPage возвращает страницу сообщений, выбранных по фильтру, если
+// проверка доступа check, выполняемая в той же транзакции, прошла успешно
+func (s *srv) messagesPage(ctx context.Context, filter *model.MessagesFilter,
+	check func(ctx context.Context, filter *model.MessagesFilter) error,
+) (*model.MessagesPage, error) {
+	if filter.BeforeID > 0 && filter.AfterID > 0 {
+		return nil, errs.InvalidArgument("before_id", "before_id and after_id can't be set together")
 	}
 
-	if len(message.From) == 0 {
-		return nil, fmt.Errorf("from can't be empty")
+	if filter.Limit > maxMessagesLimit {
+		return nil, errs.InvalidArgument("limit", "limit can't be greater than %d", maxMessagesLimit)
 	}
-	if len(message.Text) == 0 {
-		return nil, fmt.Errorf("message's text can't be empty")
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultMessagesLimit
 	}
 
+	// запрашиваем на одно сообщение больше, чтобы понять, есть ли еще страницы
+	repoFilter := *filter
+	repoFilter.Username = strings.TrimSpace(filter.Username)
+	repoFilter.Limit = limit + 1
+
+	var messages []*model.Message
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		var errTx error
-		_, errTx = s.chatRepository.SendMessage(ctx, message)
+		errTx := check(ctx, &repoFilter)
+		if errTx != nil {
+			return errTx
+		}
+
+		messages, errTx = s.chatRepository.GetChatMessages(ctx, &repoFilter)
 		if errTx != nil {
 			return errTx
 		}
@@ -228,10 +507,19 @@ func (s *srv) SendMessage(ctx context.Context, message *model.Message) (*emptypb
 		return nil, err
 	}
 
-	chatMsgChan <- &chat_v1.Message{
-		From: message.From,
-		Text: message.Text,
+	page := &model.MessagesPage{
+		Messages: messages,
 	}
 
-	return &emptypb.Empty{}, nil
+	// лишнее сообщение находится со стороны, противоположной курсору
+	if uint64(len(messages)) > limit {
+		page.HasMore = true
+		if filter.AfterID > 0 {
+			page.Messages = messages[:limit]
+		} else {
+			page.Messages = messages[1:]
+		}
+	}
+
+	return page, nil
 }
@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// GetMessageStatus возвращает автору сообщения, доставлено ли оно в живой stream
+// каждого из остальных участников чата и прочитали ли они его. Доставки
+// сохраняются батчами, поэтому статус доставки может отставать на несколько секунд
+func (s *srv) GetMessageStatus(ctx context.Context, chatID int64, messageID int64, actor string) ([]*model.MessageStatus, error) {
+	actor = strings.TrimSpace(actor)
+
+	var statuses []*model.MessageStatus
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, actor)
+		if errTx != nil {
+			return errTx
+		}
+
+		msg, errTx := s.chatMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if msg.From != actor {
+			return errs.PermissionDenied("NOT_MESSAGE_AUTHOR",
+				"user %s can't get status of message %d of another user", actor, messageID)
+		}
+
+		statuses, errTx = s.chatRepository.GetMessageStatus(ctx, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
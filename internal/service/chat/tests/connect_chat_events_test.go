@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// eventStreamMock заглушка stream'а событий чата, запоминающая отправленные события
+type eventStreamMock struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	sent []*chat_v1.ChatEvent
+}
+
+func newEventStreamMock() *eventStreamMock {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &eventStreamMock{ctx: ctx, cancel: cancel}
+}
+
+func (s *eventStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStreamMock) Send(event *chat_v1.ChatEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, event)
+	return nil
+}
+
+func (s *eventStreamMock) events() []*chat_v1.ChatEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*chat_v1.ChatEvent(nil), s.sent...)
+}
+
+func TestConnectChatEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		carol  = gofakeit.Username()
+		text   = gofakeit.Fruit()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	// bob подключен к stream'у событий, а alice - к stream'у сообщений
+	eventStream, messageStream := newEventStreamMock(), newStreamMock()
+	eventsErr, messagesErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		eventsErr <- s.ConnectChatEvents(eventStream.Context(), chatID, bob, 0, eventStream)
+	}()
+	go func() {
+		messagesErr <- s.ConnectChat(messageStream.Context(), chatID, alice, 0, messageStream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, bob) && chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	// каждое действие дожидается своего события, потому что события о сообщениях
+	// доставляются через outbox, а об участниках - сразу через pub/sub
+	waitEvents := func(n int) *chat_v1.ChatEvent {
+		require.Eventually(t, func() bool {
+			return len(eventStream.events()) == n
+		}, time.Second, time.Millisecond)
+
+		return eventStream.events()[n-1]
+	}
+
+	_, err := s.SendMessage(context.Background(), &model.Message{ChatID: chatID, From: alice, Text: gofakeit.Fruit()})
+	require.NoError(t, err)
+	msg := waitEvents(1).GetMessage()
+	require.NotNil(t, msg)
+	require.Equal(t, alice, msg.GetFrom())
+
+	_, err = s.EditMessage(context.Background(), chatID, msg.GetId(), alice, text)
+	require.NoError(t, err)
+	edited := waitEvents(2).GetMessageEdited()
+	require.NotNil(t, edited)
+	require.Equal(t, msg.GetId(), edited.GetId())
+	require.Equal(t, text, edited.GetText())
+
+	_, err = s.DeleteMessage(context.Background(), chatID, msg.GetId(), alice)
+	require.NoError(t, err)
+	require.Equal(t, msg.GetId(), waitEvents(3).GetMessageDeleted().GetId())
+
+	_, err = s.AddChatMembers(context.Background(), chatID, alice, []string{carol})
+	require.NoError(t, err)
+	require.Equal(t, carol, waitEvents(4).GetMemberAdded().GetUsername())
+
+	_, err = s.LeaveChat(context.Background(), chatID, carol)
+	require.NoError(t, err)
+	require.Equal(t, carol, waitEvents(5).GetMemberRemoved().GetUsername())
+
+	// stream сообщений получает только события о сообщениях
+	require.Eventually(t, func() bool {
+		return len(messageStream.messages()) == 3
+	}, time.Second, time.Millisecond)
+
+	messages := messageStream.messages()
+	require.Equal(t, text, messages[1].GetText())
+	require.True(t, messages[2].GetDeleted())
+
+	eventStream.cancel()
+	messageStream.cancel()
+	require.NoError(t, <-eventsErr)
+	require.NoError(t, <-messagesErr)
+}
+
+func TestConnectChatEventsResync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		text   = gofakeit.Fruit()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := &lossyPubSub{PubSub: memory.New()}
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock(mc), ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock(mc), chatHub, ps)
+
+	// published дожидается, пока обработчик outbox опубликует все события
+	published := func() {
+		require.Eventually(t, func() bool {
+			pending, err := outboxRepo.GetPendingEvents(context.Background(), 1)
+			return err == nil && len(pending) == 0
+		}, time.Second, time.Millisecond)
+	}
+
+	stream := newEventStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChatEvents(stream.Context(), chatID, alice, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.SendMessage(context.Background(), &model.Message{ChatID: chatID, From: alice, Text: gofakeit.Fruit()})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 1
+	}, time.Second, time.Millisecond)
+	msg := stream.events()[0].GetMessage()
+
+	// изменение теряется, пока pub/sub недоступен, и из истории не догружается
+	ps.mu.Lock()
+	ps.lossy = true
+	ps.mu.Unlock()
+
+	_, err = s.EditMessage(context.Background(), chatID, msg.GetId(), alice, text)
+	require.NoError(t, err)
+	published()
+
+	// после переподключения клиент узнает, что показанные сообщения нужно загрузить заново
+	ps.reconnect()
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 2
+	}, time.Second, time.Millisecond)
+	require.NotNil(t, stream.events()[1].GetResync())
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestConnectChatEventsUnknownType(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+
+		msg = &model.Message{ID: gofakeit.Int64(), ChatID: chatID, From: alice, Text: gofakeit.Fruit()}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetMessageMock.Return(msg, nil)
+
+	logger.MockInit()
+
+	ps := memory.New()
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, txManagerMock(mc), chatHub, ps)
+
+	stream := newEventStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChatEvents(stream.Context(), chatID, alice, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	// событие нового типа от более нового экземпляра не выдается за новое сообщение,
+	// а событие без типа от более старого экземпляра - новое сообщение
+	for _, eventType := range []pubsub.EventType{"message_forwarded", ""} {
+		err := ps.Publish(context.Background(), &pubsub.Event{Type: eventType, ChatID: chatID, MessageID: msg.ID})
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, msg.ID, stream.events()[0].GetMessage().GetId())
+	require.Equal(t, uint64(1), chatRepoMock.GetMessageAfterCounter())
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,862 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/converter"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/outbox"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service"
+	"github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamMock заглушка stream'а подключения к чату, запоминающая отправленные сообщения
+type streamMock struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	sent []*chat_v1.Message
+}
+
+func newStreamMock() *streamMock {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &streamMock{ctx: ctx, cancel: cancel}
+}
+
+func (s *streamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamMock) Send(msg *chat_v1.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func (s *streamMock) messages() []*chat_v1.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*chat_v1.Message(nil), s.sent...)
+}
+
+// blockingStreamMock заглушка stream'а, отправка в который блокируется до вызова release
+type blockingStreamMock struct {
+	*streamMock
+	unblock chan struct{}
+}
+
+func (s *blockingStreamMock) Send(msg *chat_v1.Message) error {
+	<-s.unblock
+	return s.streamMock.Send(msg)
+}
+
+func (s *blockingStreamMock) release() {
+	close(s.unblock)
+}
+
+// historyRepositoryMock мок репо, который хранит сообщения в памяти
+func historyRepositoryMock(mc *minimock.Controller) repository.ChatRepository {
+	var (
+		mu      sync.Mutex
+		history []*model.Message
+	)
+
+	mock := repoMocks.NewChatRepositoryMock(mc)
+	mock.CheckChatMock.Optional().Return(nil)
+	mock.SendMessageMock.Optional().Set(func(ctx context.Context, message *model.Message) (*model.Message, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		saved := *message
+		saved.ID = int64(len(history) + 1)
+		history = append(history, &saved)
+
+		return &saved, nil
+	})
+	mock.GetMessageMock.Optional().Set(func(ctx context.Context, messageID int64) (*model.Message, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if messageID < 1 || messageID > int64(len(history)) {
+			return nil, errs.NotFound("message", "message %d doesn't exist", messageID)
+		}
+
+		return history[messageID-1], nil
+	})
+	mock.GetMemberRoleMock.Optional().Return(model.RoleAdmin, nil)
+	mock.AddChatMembersMock.Optional().Set(func(_ context.Context, _ int64, usernames []string) ([]string, error) {
+		return usernames, nil
+	})
+	mock.RemoveChatMemberMock.Optional().Return(true, nil)
+	// события о подключении проверяются в presence_test
+	mock.UpdateLastSeenMock.Optional().Return(nil)
+	mock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	// измененные сообщения заменяются копиями, потому что ранее
+	// загруженные сообщения могут читаться параллельно
+	mock.EditMessageMock.Optional().Set(func(ctx context.Context, messageID int64, text string) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		edited := *history[messageID-1]
+		if edited.Deleted {
+			return false, nil
+		}
+
+		edited.Text = text
+		edited.EditedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		history[messageID-1] = &edited
+
+		return true, nil
+	})
+	mock.DeleteMessageMock.Optional().Set(func(ctx context.Context, messageID int64) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		deleted := *history[messageID-1]
+		if deleted.Deleted {
+			return false, nil
+		}
+
+		deleted.Text = ""
+		deleted.Deleted = true
+		history[messageID-1] = &deleted
+
+		return true, nil
+	})
+	mock.UnpinMessageMock.Optional().Return(false, nil)
+	mock.GetChatMessagesMock.Optional().Set(func(ctx context.Context, filter *model.MessagesFilter) ([]*model.Message, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// без after_id возвращаются последние сообщения
+		if filter.AfterID == 0 {
+			from := len(history) - int(filter.Limit)
+			if from < 0 {
+				from = 0
+			}
+			return append([]*model.Message{}, history[from:]...), nil
+		}
+
+		page := []*model.Message{}
+		for _, msg := range history {
+			if msg.ID > filter.AfterID && uint64(len(page)) < filter.Limit {
+				page = append(page, msg)
+			}
+		}
+
+		return page, nil
+	})
+
+	return mock
+}
+
+// outboxRepositoryMock мок репо outbox, который хранит события в памяти
+func outboxRepositoryMock(mc *minimock.Controller) repository.OutboxRepository {
+	var (
+		mu        sync.Mutex
+		events    []*model.OutboxEvent
+		processed = make(map[int64]struct{})
+	)
+
+	mock := repoMocks.NewOutboxRepositoryMock(mc)
+	mock.AddEventMock.Optional().Set(func(ctx context.Context, event *model.OutboxEvent) (int64, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		saved := *event
+		saved.ID = int64(len(events) + 1)
+		events = append(events, &saved)
+
+		return saved.ID, nil
+	})
+	mock.GetPendingEventsMock.Optional().Set(func(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		pending := []*model.OutboxEvent{}
+		for _, event := range events {
+			if _, ok := processed[event.ID]; !ok && uint64(len(pending)) < limit {
+				pending = append(pending, event)
+			}
+		}
+
+		return pending, nil
+	})
+	mock.MarkEventsProcessedMock.Optional().Set(func(ctx context.Context, ids []int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, id := range ids {
+			processed[id] = struct{}{}
+		}
+
+		return nil
+	})
+
+	return mock
+}
+
+// startRelay запускает обработчик outbox до конца теста
+func startRelay(t *testing.T, outboxRepo repository.OutboxRepository, txManager db.TxManager, ps pubsub.PubSub) {
+	relay := outbox.NewRelay(outboxRepo, txManager, ps, time.Millisecond, 100)
+	relay.Start()
+	t.Cleanup(func() {
+		require.NoError(t, relay.Close())
+	})
+}
+
+func TestConnectChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		chatID         int64
+		username       string
+		sinceMessageID int64
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+		sinceID  = int64(gofakeit.IntRange(100, 1000))
+
+		repoErr  = fmt.Errorf("repo error")
+		checkErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s not in chat %d", username, chatID)
+
+		history = []*model.Message{
+			{ID: sinceID + 1, ChatID: chatID, From: username, Text: gofakeit.Fruit(), CreatedAt: gofakeit.Date()},
+			{ID: sinceID + 2, ChatID: chatID, From: username, Text: gofakeit.Fruit(), CreatedAt: gofakeit.Date()},
+		}
+
+		live = &model.Message{
+			ID:        sinceID + 3,
+			ChatID:    chatID,
+			From:      username,
+			Text:      gofakeit.Fruit(),
+			CreatedAt: gofakeit.Date(),
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	txManagerMock := func(mc *minimock.Controller) db.TxManager {
+		mock := mocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+			return f(ctx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name               string
+		args               args
+		sendLive           bool
+		want               []*chat_v1.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success replay history then live",
+			args: args{
+				chatID:         chatID,
+				username:       username,
+				sinceMessageID: sinceID,
+			},
+			sendLive: true,
+			want: []*chat_v1.Message{
+				converter.ToDescMessageFromService(history[0]),
+				converter.ToDescMessageFromService(history[1]),
+				converter.ToDescMessageFromService(live),
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(minimock.AnyContext, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Expect(minimock.AnyContext, &model.MessagesFilter{
+					ChatID:  chatID,
+					AfterID: sinceID,
+					Limit:   100,
+				}).Return(history, nil)
+				mock.SendMessageMock.Return(live, nil)
+				mock.GetMessageMock.Expect(minimock.AnyContext, live.ID).Return(live, nil)
+				mock.GetUserChatIDsMock.Optional().Expect(minimock.AnyContext, username).Return([]int64{chatID}, nil)
+				mock.GetLastSeenMock.Optional().Return(map[string]time.Time{}, nil)
+				mock.UpdateLastSeenMock.Expect(minimock.AnyContext, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "error replay from repo",
+			args: args{
+				chatID:         chatID,
+				username:       username,
+				sinceMessageID: sinceID,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(minimock.AnyContext, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Return(nil, repoErr)
+				mock.GetUserChatIDsMock.Optional().Expect(minimock.AnyContext, username).Return([]int64{chatID}, nil)
+				mock.GetLastSeenMock.Optional().Return(map[string]time.Time{}, nil)
+				mock.UpdateLastSeenMock.Expect(minimock.AnyContext, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{
+				chatID:         chatID,
+				username:       username,
+				sinceMessageID: sinceID,
+			},
+			want: nil,
+			err:  checkErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(minimock.AnyContext, chatID, username).Return(checkErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManager := txManagerMock(mc)
+			outboxRepo := outboxRepositoryMock(mc)
+			ps := memory.New()
+			startRelay(t, outboxRepo, txManager, ps)
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), outboxRepo, txManager, ps)
+			stream := newStreamMock()
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- service.ConnectChat(stream.Context(), tt.args.chatID, tt.args.username, tt.args.sinceMessageID, stream)
+			}()
+
+			if tt.sendLive {
+				require.Eventually(t, func() bool {
+					return len(stream.messages()) == len(history)
+				}, time.Second, 10*time.Millisecond)
+
+				_, err := service.SendMessage(context.Background(), &model.Message{
+					ChatID: tt.args.chatID,
+					From:   tt.args.username,
+					Text:   live.Text,
+				})
+				require.NoError(t, err)
+
+				require.Eventually(t, func() bool {
+					return len(stream.messages()) == len(tt.want)
+				}, time.Second, 10*time.Millisecond)
+
+				stream.cancel()
+			}
+
+			require.Equal(t, tt.err, <-errCh)
+			require.Equal(t, tt.want, stream.messages())
+		})
+	}
+}
+
+func TestConnectChatConcurrent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		members      = 10
+		churners     = 5
+		perMember    = 30
+		totalMessage = members * perMember
+	)
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	// сохраненные сообщения, из которых медленные подписчики догружают пропущенное
+	chatRepoMock := historyRepositoryMock(mc)
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	// очередь заведомо меньше трафика: пропущенное догружается из истории
+	chatHub := hub.New(4, hub.PolicySpill)
+	service := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	connect := func(username string) (*streamMock, chan error) {
+		stream := newStreamMock()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- service.ConnectChat(stream.Context(), chatID, username, 0, stream)
+		}()
+
+		return stream, errCh
+	}
+
+	usernames := make([]string, 0, members)
+	streams := make([]*streamMock, 0, members)
+	errChs := make([]chan error, 0, members)
+	for i := 0; i < members; i++ {
+		username := fmt.Sprintf("member%d", i)
+		stream, errCh := connect(username)
+
+		usernames = append(usernames, username)
+		streams = append(streams, stream)
+		errChs = append(errChs, errCh)
+	}
+
+	for _, username := range usernames {
+		username := username
+		require.Eventually(t, func() bool {
+			return chatHub.IsSubscribed(chatID, username)
+		}, time.Second, time.Millisecond)
+	}
+
+	// пользователи, которые постоянно подключаются и отключаются во время рассылки
+	stop := make(chan struct{})
+	churnWG := sync.WaitGroup{}
+	for i := 0; i < churners; i++ {
+		churnWG.Add(1)
+		go func(username string) {
+			defer churnWG.Done()
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				stream, errCh := connect(username)
+				time.Sleep(time.Millisecond)
+				stream.cancel()
+				require.NoError(t, <-errCh)
+			}
+		}(fmt.Sprintf("churner%d", i))
+	}
+
+	sendWG := sync.WaitGroup{}
+	for _, username := range usernames {
+		sendWG.Add(1)
+		go func(username string) {
+			defer sendWG.Done()
+
+			for i := 0; i < perMember; i++ {
+				_, err := service.SendMessage(context.Background(), &model.Message{
+					ChatID: chatID,
+					From:   username,
+					Text:   gofakeit.Fruit(),
+				})
+				require.NoError(t, err)
+			}
+		}(username)
+	}
+	sendWG.Wait()
+
+	// каждый постоянно подключенный пользователь получает каждое сообщение ровно один раз
+	for i, stream := range streams {
+		stream := stream
+		require.Eventually(t, func() bool {
+			return len(stream.messages()) >= totalMessage
+		}, 5*time.Second, 10*time.Millisecond)
+
+		seen := make(map[int64]struct{}, totalMessage)
+		for _, msg := range stream.messages() {
+			_, dup := seen[msg.GetId()]
+			require.False(t, dup, "duplicate message %d", msg.GetId())
+			seen[msg.GetId()] = struct{}{}
+		}
+		require.Len(t, seen, totalMessage)
+
+		stream.cancel()
+		require.NoError(t, <-errChs[i])
+	}
+
+	close(stop)
+	churnWG.Wait()
+}
+
+func TestConnectChatSlowConsumerDisconnect(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	chatHub := hub.New(1, hub.PolicyDisconnect)
+	service := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	stream := &blockingStreamMock{streamMock: newStreamMock(), unblock: make(chan struct{})}
+	defer stream.cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- service.ConnectChat(stream.Context(), chatID, username, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, username)
+	}, time.Second, time.Millisecond)
+
+	// подписчик не читает сообщения, но отправка не блокируется
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for i := 0; i < 10; i++ {
+			_, err := service.SendMessage(context.Background(), &model.Message{
+				ChatID: chatID,
+				From:   username,
+				Text:   gofakeit.Fruit(),
+			})
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SendMessage blocked on a slow subscriber")
+	}
+
+	require.Eventually(t, func() bool {
+		return chatHub.Metrics().DisconnectedSubscribers == 1
+	}, 5*time.Second, time.Millisecond)
+
+	stream.release()
+
+	err := <-errCh
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.False(t, chatHub.IsSubscribed(chatID, username))
+}
+
+func TestConnectChatMultipleSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	service := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	// пользователь подключается к чату с двух устройств
+	phone, laptop := newStreamMock(), newStreamMock()
+	defer laptop.cancel()
+
+	phoneErr, laptopErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		phoneErr <- service.ConnectChat(phone.Context(), chatID, username, 0, phone)
+	}()
+	go func() {
+		laptopErr <- service.ConnectChat(laptop.Context(), chatID, username, 0, laptop)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(chatHub.Sessions(chatID, username)) == 2
+	}, time.Second, time.Millisecond)
+
+	send := func() {
+		_, err := service.SendMessage(context.Background(), &model.Message{
+			ChatID: chatID,
+			From:   username,
+			Text:   gofakeit.Fruit(),
+		})
+		require.NoError(t, err)
+	}
+
+	// оба устройства получают сообщение
+	send()
+	require.Eventually(t, func() bool {
+		return len(phone.messages()) == 1 && len(laptop.messages()) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, phone.messages(), laptop.messages())
+
+	// отключение телефона не затрагивает сессию ноутбука
+	phone.cancel()
+	require.NoError(t, <-phoneErr)
+	require.Len(t, chatHub.Sessions(chatID, username), 1)
+	require.True(t, chatHub.IsSubscribed(chatID, username))
+
+	send()
+	require.Eventually(t, func() bool {
+		return len(laptop.messages()) == 2
+	}, time.Second, time.Millisecond)
+	require.Len(t, phone.messages(), 1)
+
+	laptop.cancel()
+	require.NoError(t, <-laptopErr)
+	require.False(t, chatHub.IsSubscribed(chatID, username))
+}
+
+// lossyPubSub pub/sub, который теряет события, пока включен режим потерь
+type lossyPubSub struct {
+	pubsub.PubSub
+
+	mu     sync.Mutex
+	lossy  bool
+	resync []pubsub.ResyncHandler
+}
+
+func (p *lossyPubSub) Publish(ctx context.Context, event *pubsub.Event) error {
+	p.mu.Lock()
+	lossy := p.lossy
+	p.mu.Unlock()
+
+	if lossy {
+		return nil
+	}
+
+	return p.PubSub.Publish(ctx, event)
+}
+
+func (p *lossyPubSub) Subscribe(handler pubsub.Handler, resync pubsub.ResyncHandler) {
+	p.mu.Lock()
+	p.resync = append(p.resync, resync)
+	p.mu.Unlock()
+
+	p.PubSub.Subscribe(handler, resync)
+}
+
+// reconnect выключает режим потерь и сообщает подписчикам о возможной потере событий
+func (p *lossyPubSub) reconnect() {
+	p.mu.Lock()
+	p.lossy = false
+	handlers := p.resync
+	p.mu.Unlock()
+
+	for _, resync := range handlers {
+		resync(context.Background())
+	}
+}
+
+func TestConnectChatCrossInstance(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	// два экземпляра сервера с общей БД и общим pub/sub, у каждого свой hub
+	chatRepoMock := historyRepositoryMock(mc)
+	ps := memory.New()
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	// у каждого экземпляра свой обработчик общего outbox
+	outboxRepo := outboxRepositoryMock(mc)
+	startRelay(t, outboxRepo, txManagerMock, ps)
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	hubA := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	hubB := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	instanceA := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, hubA, ps)
+	instanceB := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, hubB, ps)
+
+	aliceStream, bobStream := newStreamMock(), newStreamMock()
+	aliceErr, bobErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		aliceErr <- instanceA.ConnectChat(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	go func() {
+		bobErr <- instanceB.ConnectChat(bobStream.Context(), chatID, bob, 0, bobStream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return hubA.IsSubscribed(chatID, alice) && hubB.IsSubscribed(chatID, bob)
+	}, time.Second, time.Millisecond)
+
+	// сообщения, отправленные через любой экземпляр, получают подписчики обоих
+	for _, instance := range []service.ChatService{instanceA, instanceB} {
+		_, err := instance.SendMessage(context.Background(), &model.Message{
+			ChatID: chatID,
+			From:   alice,
+			Text:   gofakeit.Fruit(),
+		})
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(aliceStream.messages()) == 2 && len(bobStream.messages()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, aliceStream.messages(), bobStream.messages())
+
+	aliceStream.cancel()
+	bobStream.cancel()
+	require.NoError(t, <-aliceErr)
+	require.NoError(t, <-bobErr)
+}
+
+func TestConnectChatResync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+	ps := &lossyPubSub{PubSub: memory.New()}
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	outboxRepo := outboxRepositoryMock(mc)
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	service := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	// send отправляет сообщение и дожидается, пока обработчик outbox его опубликует
+	send := func() {
+		_, err := service.SendMessage(context.Background(), &model.Message{
+			ChatID: chatID,
+			From:   username,
+			Text:   gofakeit.Fruit(),
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			pending, err := outboxRepo.GetPendingEvents(context.Background(), 1)
+			return err == nil && len(pending) == 0
+		}, time.Second, time.Millisecond)
+	}
+
+	// сообщение, отправленное до подключения, не догружается при resync
+	send()
+
+	stream := newStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- service.ConnectChat(stream.Context(), chatID, username, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, username)
+	}, time.Second, time.Millisecond)
+
+	// события теряются, пока pub/sub недоступен
+	ps.mu.Lock()
+	ps.lossy = true
+	ps.mu.Unlock()
+
+	send()
+	send()
+	require.Empty(t, stream.messages())
+
+	// после переподключения подписчик догружает потерянные сообщения из истории
+	ps.reconnect()
+	send()
+
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 3
+	}, time.Second, time.Millisecond)
+
+	ids := []int64{}
+	for _, msg := range stream.messages() {
+		ids = append(ids, msg.GetId())
+	}
+	require.Equal(t, []int64{2, 3, 4}, ids)
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/solumD/chat-server/internal/client/db"
 	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
 	"github.com/solumD/chat-server/internal/logger"
 	"github.com/solumD/chat-server/internal/model"
 	"github.com/solumD/chat-server/internal/repository"
@@ -37,13 +38,37 @@ func TestCreateChat(t *testing.T) {
 		id        = gofakeit.Int64()
 
 		repoErr      = fmt.Errorf("repo error")
-		emptyNameErr = fmt.Errorf("chat's name can't be empty")
+		emptyNameErr = errs.InvalidArgument("name", "chat's name can't be empty")
+
+		creator = gofakeit.Username()
 
 		req = &model.Chat{
 			Name:      name,
 			Usernames: usernames,
+			Creator:   creator,
+		}
+
+		// создатель добавляется в участники чата
+		repoReq = &model.Chat{
+			Name:      name,
+			Usernames: append([]string{creator}, usernames...),
+			Creator:   creator,
 		}
 
+		// клиент, не передающий создателя
+		legacyReq = &model.Chat{
+			Name:      name,
+			Usernames: usernames,
+		}
+
+		legacyRepoReq = &model.Chat{
+			Name:      name,
+			Usernames: usernames,
+			Creator:   usernames[0],
+		}
+
+		noCreatorErr = errs.InvalidArgument("username", "chat's creator can't be empty")
+
 		emptyNameReq = &model.Chat{
 			Name:      "",
 			Usernames: usernames,
@@ -70,7 +95,7 @@ func TestCreateChat(t *testing.T) {
 			err:  nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx, req).Return(res, nil)
+				mock.CreateChatMock.Expect(ctx, repoReq).Return(res, nil)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -81,6 +106,42 @@ func TestCreateChat(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "success first user becomes owner without creator",
+			args: args{
+				ctx: ctx,
+				req: legacyReq,
+			},
+			want: id,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CreateChatMock.Expect(ctx, legacyRepoReq).Return(res, nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error chat without creator and users is invalid",
+			args: args{
+				ctx: ctx,
+				req: &model.Chat{Name: name},
+			},
+			want: 0,
+			err:  noCreatorErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return mocks.NewTxManagerMock(mc)
+			},
+		},
 		{
 			name: "error from repo",
 			args: args{
@@ -91,7 +152,7 @@ func TestCreateChat(t *testing.T) {
 			err:  repoErr,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx, req).Return(0, repoErr)
+				mock.CreateChatMock.Expect(ctx, repoReq).Return(0, repoErr)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
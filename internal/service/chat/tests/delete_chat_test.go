@@ -7,7 +7,9 @@ import (
 
 	"github.com/solumD/chat-server/internal/client/db"
 	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
 	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
 	"github.com/solumD/chat-server/internal/repository"
 	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
 	"github.com/solumD/chat-server/internal/service/chat"
@@ -24,17 +26,20 @@ func TestDeleteChat(t *testing.T) {
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
 
 	type args struct {
-		ctx context.Context
-		req int64
+		ctx   context.Context
+		req   int64
+		actor string
 	}
 
 	var (
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id = gofakeit.Int64()
+		id    = gofakeit.Int64()
+		actor = gofakeit.Username()
 
-		repoErr = fmt.Errorf("repo error")
+		repoErr     = fmt.Errorf("repo error")
+		notOwnerErr = errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't delete chat %d", actor, id)
 
 		req = id
 		res = &emptypb.Empty{}
@@ -52,13 +57,15 @@ func TestDeleteChat(t *testing.T) {
 		{
 			name: "success from repo",
 			args: args{
-				ctx: ctx,
-				req: req,
+				ctx:   ctx,
+				req:   req,
+				actor: actor,
 			},
 			want: res,
 			err:  nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, id, actor).Return(model.RoleOwner, nil)
 				mock.DeleteChatMock.Expect(ctx, req).Return(res, nil)
 				return mock
 			},
@@ -73,13 +80,15 @@ func TestDeleteChat(t *testing.T) {
 		{
 			name: "error from repo",
 			args: args{
-				ctx: ctx,
-				req: req,
+				ctx:   ctx,
+				req:   req,
+				actor: actor,
 			},
 			want: nil,
 			err:  repoErr,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, id, actor).Return(model.RoleOwner, nil)
 				mock.DeleteChatMock.Expect(ctx, req).Return(nil, repoErr)
 				return mock
 			},
@@ -91,6 +100,28 @@ func TestDeleteChat(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "error actor is not owner",
+			args: args{
+				ctx:   ctx,
+				req:   req,
+				actor: actor,
+			},
+			want: nil,
+			err:  notOwnerErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, id, actor).Return(model.RoleAdmin, nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
 	}
 
 	logger.MockInit()
@@ -105,7 +136,7 @@ func TestDeleteChat(t *testing.T) {
 
 			service := chat.NewMockService(authRepoMock, txManagerMock)
 
-			newID, err := service.DeleteChat(tt.args.ctx, tt.args.req)
+			newID, err := service.DeleteChat(tt.args.ctx, tt.args.req, tt.args.actor)
 			require.Equal(t, tt.err, err)
 			require.Equal(t, tt.want, newID)
 		})
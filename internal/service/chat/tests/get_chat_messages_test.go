@@ -0,0 +1,281 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChatMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+
+	type args struct {
+		ctx context.Context
+		req *model.MessagesFilter
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+		cursorID = int64(gofakeit.IntRange(100, 1000))
+
+		repoErr       = fmt.Errorf("repo error")
+		checkErr      = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s not in chat %d", username, chatID)
+		bothCursorErr = errs.InvalidArgument("before_id", "before_id and after_id can't be set together")
+		limitErr      = errs.InvalidArgument("limit", "limit can't be greater than 100")
+
+		messages = []*model.Message{
+			{ID: cursorID - 3, ChatID: chatID, From: username, Text: gofakeit.Fruit()},
+			{ID: cursorID - 2, ChatID: chatID, From: username, Text: gofakeit.Fruit()},
+			{ID: cursorID - 1, ChatID: chatID, From: username, Text: gofakeit.Fruit()},
+		}
+
+		beforeReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			BeforeID: cursorID,
+			Limit:    2,
+		}
+		beforeRepoReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			BeforeID: cursorID,
+			Limit:    3,
+		}
+
+		afterReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			AfterID:  cursorID - 4,
+			Limit:    2,
+		}
+		afterRepoReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			AfterID:  cursorID - 4,
+			Limit:    3,
+		}
+
+		defaultReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+		}
+		defaultRepoReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			Limit:    51,
+		}
+
+		bothCursorReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			BeforeID: cursorID,
+			AfterID:  cursorID - 4,
+		}
+
+		bigLimitReq = &model.MessagesFilter{
+			ChatID:   chatID,
+			Username: username,
+			Limit:    101,
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.MessagesPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+		txManagerMock      txManagerMockFunc
+	}{
+		{
+			name: "success before cursor with more pages",
+			args: args{
+				ctx: ctx,
+				req: beforeReq,
+			},
+			want: &model.MessagesPage{
+				Messages: messages[1:],
+				HasMore:  true,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Expect(ctx, beforeRepoReq).Return(messages, nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "success after cursor with more pages",
+			args: args{
+				ctx: ctx,
+				req: afterReq,
+			},
+			want: &model.MessagesPage{
+				Messages: messages[:2],
+				HasMore:  true,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Expect(ctx, afterRepoReq).Return(messages, nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "success default limit without more pages",
+			args: args{
+				ctx: ctx,
+				req: defaultReq,
+			},
+			want: &model.MessagesPage{
+				Messages: messages,
+				HasMore:  false,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Expect(ctx, defaultRepoReq).Return(messages, nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{
+				ctx: ctx,
+				req: beforeReq,
+			},
+			want: nil,
+			err:  checkErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(checkErr)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{
+				ctx: ctx,
+				req: beforeReq,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetChatMessagesMock.Expect(ctx, beforeRepoReq).Return(nil, repoErr)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error both cursors set",
+			args: args{
+				ctx: ctx,
+				req: bothCursorReq,
+			},
+			want: nil,
+			err:  bothCursorErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "error limit too big",
+			args: args{
+				ctx: ctx,
+				req: bigLimitReq,
+			},
+			want: nil,
+			err:  limitErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepoMock := tt.chatRepositoryMock(mc)
+			txManagerMock := tt.txManagerMock(mc)
+
+			service := chat.NewMockService(chatRepoMock, txManagerMock)
+
+			page, err := service.GetChatMessages(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, page)
+		})
+	}
+}
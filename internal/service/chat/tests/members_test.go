@@ -0,0 +1,485 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// txManagerMock мок транзакционного менеджера, выполняющий обработчик без транзакции.
+// Запросы, не прошедшие валидацию, до транзакции не доходят
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := mocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+	return mock
+}
+
+// expectRoles задает роли участников чата в моке репо. Пользователи
+// не из списка в чате не состоят
+func expectRoles(mock *repoMocks.ChatRepositoryMock, chatID int64, roles map[string]model.Role) {
+	mock.GetMemberRoleMock.Set(func(ctx context.Context, id int64, username string) (model.Role, error) {
+		role, ok := roles[username]
+		if !ok || id != chatID {
+			return 0, errs.NotFound("member", "user %s not in chat %d", username, id)
+		}
+
+		return role, nil
+	})
+}
+
+func TestAddChatMembers(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		actor     string
+		usernames []string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		actor  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		carol  = gofakeit.Username()
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", actor, chatID)
+		emptyErr     = errs.InvalidArgument("usernames", "usernames can't be empty")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *emptypb.Empty
+		wantAdded          []string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success duplicates and empty names are skipped",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{" " + bob, bob, "", carol},
+			},
+			want:      res,
+			wantAdded: []string{bob, carol},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin})
+				mock.AddChatMembersMock.Expect(ctx, chatID, []string{bob, carol}).Return([]string{bob, carol}, nil)
+				return mock
+			},
+		},
+		{
+			name: "success existing members are not announced",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{bob, carol},
+			},
+			want:      res,
+			wantAdded: []string{carol},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin})
+				mock.AddChatMembersMock.Expect(ctx, chatID, []string{bob, carol}).Return([]string{carol}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error empty usernames",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{" "},
+			},
+			err: emptyErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor not in chat",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{bob},
+			},
+			err: notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, nil)
+				return mock
+			},
+		},
+		{
+			name: "error actor is regular member",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{bob},
+			},
+			err: errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't manage members of chat %d", actor, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				actor:     actor,
+				usernames: []string{bob},
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin})
+				mock.AddChatMembersMock.Expect(ctx, chatID, []string{bob}).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ps := &publishedEvents{PubSub: memory.New(), events: make(chan *pubsub.Event, 2)}
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc), ps)
+
+			got, err := service.AddChatMembers(tt.args.ctx, tt.args.chatID, tt.args.actor, tt.args.usernames)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			close(ps.events)
+			added := []string{}
+			for event := range ps.events {
+				require.Equal(t, pubsub.EventTypeMemberAdded, event.Type)
+				added = append(added, event.Username)
+			}
+			if tt.wantAdded == nil {
+				require.Empty(t, added)
+				return
+			}
+			require.Equal(t, tt.wantAdded, added)
+		})
+	}
+}
+
+func TestRemoveChatMember(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx    context.Context
+		chatID int64
+		actor  string
+		member string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		actor  = gofakeit.Username()
+		member = gofakeit.Username()
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", actor, chatID)
+		emptyErr     = errs.InvalidArgument("member", "member can't be empty")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *emptypb.Empty
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success member removed",
+			args: args{
+				ctx:    ctx,
+				chatID: chatID,
+				actor:  actor,
+				member: member,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin, member: model.RoleMember})
+				mock.RemoveChatMemberMock.Expect(ctx, chatID, member).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "success user is not a member",
+			args: args{
+				ctx:    ctx,
+				chatID: chatID,
+				actor:  actor,
+				member: member,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin})
+				return mock
+			},
+		},
+		{
+			name: "error empty member",
+			args: args{
+				ctx:    ctx,
+				chatID: chatID,
+				actor:  actor,
+			},
+			err: emptyErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor not in chat",
+			args: args{
+				ctx:    ctx,
+				chatID: chatID,
+				actor:  actor,
+				member: member,
+			},
+			err: notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{member: model.RoleMember})
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{
+				ctx:    ctx,
+				chatID: chatID,
+				actor:  actor,
+				member: member,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleOwner, member: model.RoleAdmin})
+				mock.RemoveChatMemberMock.Expect(ctx, chatID, member).Return(false, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.RemoveChatMember(tt.args.ctx, tt.args.chatID, tt.args.actor, tt.args.member)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLeaveChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		notFoundErr = errs.NotFound("chat", "chat %d doesn't exist", chatID)
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		username           string
+		want               *emptypb.Empty
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:     "success",
+			username: username,
+			want:     res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{username: model.RoleAdmin})
+				mock.RemoveChatMemberMock.Expect(ctx, chatID, username).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:     "success repeated leave",
+			username: username,
+			want:     res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, nil)
+				return mock
+			},
+		},
+		{
+			name:     "error owner can't leave",
+			username: username,
+			err: errs.FailedPrecondition("OWNER_CANNOT_LEAVE",
+				"owner must transfer ownership of chat %d before leaving it", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{username: model.RoleOwner})
+				return mock
+			},
+		},
+		{
+			name:     "error chat not found",
+			username: username,
+			err:      notFoundErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, username).Return(0, notFoundErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.LeaveChat(ctx, chatID, tt.username)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRemoveChatMemberDisconnectsStreams(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	expectRoles(chatRepoMock, chatID, map[string]model.Role{alice: model.RoleOwner, bob: model.RoleMember})
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.RemoveChatMemberMock.Expect(minimock.AnyContext, chatID, bob).Return(true, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+
+	logger.MockInit()
+
+	// alice подключена к первому экземпляру, bob - ко второму с двух устройств
+	ps := memory.New()
+	hubA := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	hubB := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	instanceA := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubA, ps)
+	instanceB := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubB, ps)
+
+	aliceStream, bobPhone, bobLaptop := newStreamMock(), newStreamMock(), newStreamMock()
+	defer aliceStream.cancel()
+
+	aliceErr, bobErr := make(chan error, 1), make(chan error, 2)
+	go func() {
+		aliceErr <- instanceA.ConnectChat(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	for _, stream := range []*streamMock{bobPhone, bobLaptop} {
+		stream := stream
+		go func() {
+			bobErr <- instanceB.ConnectChat(stream.Context(), chatID, bob, 0, stream)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return hubA.IsSubscribed(chatID, alice) && len(hubB.Sessions(chatID, bob)) == 2
+	}, time.Second, time.Millisecond)
+
+	_, err := instanceA.RemoveChatMember(context.Background(), chatID, alice, bob)
+	require.NoError(t, err)
+
+	// обе сессии bob на другом экземпляре закрываются с ошибкой доступа
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-bobErr:
+			require.True(t, errs.Is(err, errs.KindPermissionDenied))
+		case <-time.After(time.Second):
+			t.Fatal("removed member's stream was not closed")
+		}
+	}
+	require.False(t, hubB.IsSubscribed(chatID, bob))
+
+	// сессия alice не затронута
+	require.True(t, hubA.IsSubscribed(chatID, alice))
+	aliceStream.cancel()
+	require.NoError(t, <-aliceErr)
+}
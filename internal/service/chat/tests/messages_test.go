@@ -0,0 +1,621 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+		text      string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		bob       = gofakeit.Username()
+		text      = gofakeit.Fruit()
+
+		msg = &model.Message{
+			ID:     messageID,
+			ChatID: chatID,
+			From:   actor,
+			Text:   gofakeit.Fruit(),
+		}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventMessageEdited,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr    = fmt.Errorf("repo error")
+		deletedErr = errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID)
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.EditMessageMock.Expect(ctx, messageID, text).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "error empty text",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+			},
+			err: errs.InvalidArgument("text", "message's text can't be empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor not in chat",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			err: errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", actor, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message from another chat",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			err: errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{
+					ID:     messageID,
+					ChatID: chatID + 1,
+					From:   actor,
+				}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor is not author",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     bob,
+				text:      text,
+			},
+			err: errs.PermissionDenied("NOT_MESSAGE_AUTHOR", "user %s can't edit message %d of another user", bob, messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				// даже владелец чата не может менять чужие сообщения
+				expectRoles(mock, chatID, map[string]model.Role{bob: model.RoleOwner})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message is deleted",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			err: deletedErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{
+					ID:      messageID,
+					ChatID:  chatID,
+					From:    actor,
+					Deleted: true,
+				}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message deleted concurrently",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			err: deletedErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.EditMessageMock.Expect(ctx, messageID, text).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+				text:      text,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.EditMessageMock.Expect(ctx, messageID, text).Return(false, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.EditMessage(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor, tt.args.text)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		author    = gofakeit.Username()
+		actor     = gofakeit.Username()
+
+		msg = &model.Message{
+			ID:     messageID,
+			ChatID: chatID,
+			From:   author,
+			Text:   gofakeit.Fruit(),
+		}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventMessageDeleted,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+		unpinEvent = &model.OutboxEvent{
+			Type:      model.OutboxEventMessageUnpinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success author deletes message",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(true, nil)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success admin deletes message of another user",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleAdmin})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(true, nil)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success pinned message is unpinned",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(true, nil)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.When(ctx, event).Then(gofakeit.Int64(), nil)
+				mock.AddEventMock.When(ctx, unpinEvent).Then(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success message already deleted",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{
+					ID:      messageID,
+					ChatID:  chatID,
+					From:    author,
+					Deleted: true,
+				}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "success message deleted concurrently",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error regular member deletes message of another user",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     actor,
+			},
+			err: errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't delete messages of other users in chat %d", actor, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{actor: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor not in chat",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			err: errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", author, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message not found",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			err: errs.NotFound("message", "message %d doesn't exist", messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).
+					Return(nil, errs.NotFound("message", "message %d doesn't exist", messageID))
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error from outbox repo",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(0, repoErr)
+				return mock
+			},
+		},
+		{
+			name: "error unpinning message",
+			args: args{
+				ctx:       ctx,
+				chatID:    chatID,
+				messageID: messageID,
+				actor:     author,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, map[string]model.Role{author: model.RoleMember})
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.DeleteMessageMock.Expect(ctx, messageID).Return(true, nil)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(false, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.DeleteMessage(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConnectChatMessageUpdates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		text   = gofakeit.Fruit()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := historyRepositoryMock(mc)
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock, ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock, chatHub, ps)
+
+	stream := newStreamMock()
+	connectErr := make(chan error, 1)
+	go func() {
+		connectErr <- s.ConnectChat(stream.Context(), chatID, bob, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, bob)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.SendMessage(context.Background(), &model.Message{ChatID: chatID, From: alice, Text: gofakeit.Fruit()})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 1
+	}, time.Second, time.Millisecond)
+
+	// изменение уже отправленного сообщения приходит с тем же id
+	sent := stream.messages()[0]
+	_, err = s.EditMessage(context.Background(), chatID, sent.GetId(), alice, text)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 2
+	}, time.Second, time.Millisecond)
+
+	edited := stream.messages()[1]
+	require.Equal(t, sent.GetId(), edited.GetId())
+	require.Equal(t, text, edited.GetText())
+	require.NotNil(t, edited.GetEditedAt())
+	require.False(t, edited.GetDeleted())
+
+	_, err = s.DeleteMessage(context.Background(), chatID, sent.GetId(), alice)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 3
+	}, time.Second, time.Millisecond)
+
+	deleted := stream.messages()[2]
+	require.Equal(t, sent.GetId(), deleted.GetId())
+	require.Empty(t, deleted.GetText())
+	require.True(t, deleted.GetDeleted())
+
+	stream.cancel()
+	require.NoError(t, <-connectErr)
+}
@@ -0,0 +1,524 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		admin     = gofakeit.Username()
+		member    = gofakeit.Username()
+
+		roles = map[string]model.Role{admin: model.RoleAdmin, member: model.RoleMember}
+
+		msg   = &model.Message{ID: messageID, ChatID: chatID, From: member}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventMessagePinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: " " + admin},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.CountPinnedMessagesMock.Expect(ctx, chatID).Return(49, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, admin).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success message already pinned in full chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: admin},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.CountPinnedMessagesMock.Expect(ctx, chatID).Return(50, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, admin).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error too many pinned messages",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: admin},
+			err: errs.FailedPrecondition("TOO_MANY_PINNED_MESSAGES",
+				"chat %d can't have more than %d pinned messages", chatID, 50),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.CountPinnedMessagesMock.Expect(ctx, chatID).Return(50, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, admin).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error regular member pins message",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: member},
+			err:  errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't %s %d", member, "pin messages in chat", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message is deleted",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: admin},
+			err:  errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID, Deleted: true}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message from another chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: admin},
+			err:  errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID + 1}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: admin},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.CountPinnedMessagesMock.Expect(ctx, chatID).Return(0, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.PinMessage(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnpinMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		owner     = gofakeit.Username()
+		member    = gofakeit.Username()
+
+		roles = map[string]model.Role{owner: model.RoleOwner, member: model.RoleMember}
+
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventMessageUnpinned,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		actor                string
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name:  "success",
+			actor: owner,
+			want:  res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name:  "success message not pinned",
+			actor: owner,
+			want:  res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name:  "error regular member unpins message",
+			actor: member,
+			err:   errs.PermissionDenied("INSUFFICIENT_ROLE", "user %s can't %s %d", member, "pin messages in chat", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name:  "error from repo",
+			actor: owner,
+			err:   repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				expectRoles(mock, chatID, roles)
+				mock.UnpinMessageMock.Expect(ctx, chatID, messageID).Return(false, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.UnpinMessage(ctx, chatID, messageID, tt.actor)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListPinnedMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		pinned = []*model.Message{
+			{ID: gofakeit.Int64(), ChatID: chatID, From: username, Text: gofakeit.Fruit()},
+		}
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		want               []*model.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			want: pinned,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetPinnedMessagesMock.Expect(ctx, chatID).Return(pinned, nil)
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			err:  notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetPinnedMessagesMock.Expect(ctx, chatID).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.ListPinnedMessages(ctx, chatID, " "+username)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConnectChatPinEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		admin  = gofakeit.Username()
+
+		msg = &model.Message{ID: gofakeit.Int64(), ChatID: chatID, From: alice, Text: gofakeit.Fruit()}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	expectRoles(chatRepoMock, chatID, map[string]model.Role{alice: model.RoleMember, admin: model.RoleAdmin})
+	chatRepoMock.GetMessageMock.Return(msg, nil)
+	chatRepoMock.CountPinnedMessagesMock.Return(0, nil)
+	chatRepoMock.PinMessageMock.Return(true, nil)
+	chatRepoMock.UnpinMessageMock.Return(true, nil)
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock(mc), ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock(mc), chatHub, ps)
+
+	stream := newEventStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChatEvents(stream.Context(), chatID, alice, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.PinMessage(context.Background(), chatID, msg.ID, admin)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, msg.Text, stream.events()[0].GetMessagePinned().GetText())
+
+	_, err = s.UnpinMessage(context.Background(), chatID, msg.ID, admin)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, msg.ID, stream.events()[1].GetMessageUnpinned().GetId())
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestConnectChatDeletePinnedMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		admin  = gofakeit.Username()
+
+		msg = &model.Message{ID: gofakeit.Int64(), ChatID: chatID, From: alice, Text: gofakeit.Fruit()}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	expectRoles(chatRepoMock, chatID, map[string]model.Role{
+		alice: model.RoleMember,
+		bob:   model.RoleMember,
+		admin: model.RoleAdmin,
+	})
+	chatRepoMock.GetMessageMock.Return(msg, nil)
+	chatRepoMock.CountPinnedMessagesMock.Return(0, nil)
+	chatRepoMock.PinMessageMock.Return(true, nil)
+	chatRepoMock.UnpinMessageMock.Return(true, nil)
+	chatRepoMock.DeleteMessageMock.Return(true, nil)
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock(mc), ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock(mc), chatHub, ps)
+
+	aliceStream := newEventStreamMock()
+	aliceErr := make(chan error, 1)
+	go func() {
+		aliceErr <- s.ConnectChatEvents(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+
+	// bob подключен к stream'у сообщений
+	bobStream := newStreamMock()
+	bobErr := make(chan error, 1)
+	go func() {
+		bobErr <- s.ConnectChat(bobStream.Context(), chatID, bob, 0, bobStream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice) && chatHub.IsSubscribed(chatID, bob)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.PinMessage(context.Background(), chatID, msg.ID, admin)
+	require.NoError(t, err)
+	_, err = s.UnpinMessage(context.Background(), chatID, msg.ID, admin)
+	require.NoError(t, err)
+	_, err = s.PinMessage(context.Background(), chatID, msg.ID, admin)
+	require.NoError(t, err)
+
+	// удаленное сообщение открепляется
+	_, err = s.DeleteMessage(context.Background(), chatID, msg.ID, alice)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(aliceStream.events()) == 5
+	}, time.Second, time.Millisecond)
+	events := aliceStream.events()
+	require.Equal(t, msg.ID, events[3].GetMessageDeleted().GetId())
+	require.Equal(t, msg.ID, events[4].GetMessageUnpinned().GetId())
+
+	// события закрепления в stream сообщений не попадают
+	require.Eventually(t, func() bool {
+		return len(bobStream.messages()) == 1
+	}, time.Second, time.Millisecond)
+
+	aliceStream.cancel()
+	require.NoError(t, <-aliceErr)
+	bobStream.cancel()
+	require.NoError(t, <-bobErr)
+
+	require.Len(t, bobStream.messages(), 1)
+	require.Equal(t, msg.ID, bobStream.messages()[0].GetId())
+}
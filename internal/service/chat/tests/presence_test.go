@@ -0,0 +1,367 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/presence"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPresence(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		alice    = gofakeit.Username()
+		bob      = gofakeit.Username()
+		lastSeen = gofakeit.Date()
+
+		repoErr = fmt.Errorf("repo error")
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		usernames          []string
+		want               []*model.Presence
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:      "success",
+			usernames: []string{" " + alice, bob, alice},
+			want: []*model.Presence{
+				{Username: alice, Online: true, LastSeenAt: sql.NullTime{Time: lastSeen, Valid: true}},
+				{Username: bob},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetLastSeenMock.Expect(ctx, []string{alice, bob}).Return(map[string]time.Time{alice: lastSeen}, nil)
+				return mock
+			},
+		},
+		{
+			name:      "error empty usernames",
+			usernames: []string{" "},
+			err:       errs.InvalidArgument("usernames", "usernames can't be empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name:      "error from repo",
+			usernames: []string{alice},
+			err:       repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetLastSeenMock.Expect(ctx, []string{alice}).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// alice подключена к другому экземпляру сервера
+			registry := presence.New(time.Minute)
+			registry.Set("other", alice, true)
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc), registry)
+
+			got, err := service.GetPresence(ctx, tt.usernames)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConnectChatPresenceEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+
+		mu           sync.Mutex
+		disconnected []string
+		lastSeen     = map[string]time.Time{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{chatID}, nil)
+	chatRepoMock.UpdateLastSeenMock.Set(func(ctx context.Context, username string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		disconnected = append(disconnected, username)
+		lastSeen[username] = time.Now().Truncate(time.Second)
+		return nil
+	})
+	// время отключения в событии совпадает с сохраненным
+	chatRepoMock.GetLastSeenMock.Optional().Set(func(_ context.Context, usernames []string) (map[string]time.Time, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		seen := map[string]time.Time{}
+		for _, username := range usernames {
+			if at, ok := lastSeen[username]; ok {
+				seen[username] = at
+			}
+		}
+		return seen, nil
+	})
+
+	logger.MockInit()
+
+	// экземпляры сервера видят подключения друг друга через общий pub/sub
+	ps := memory.New()
+	hubA := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	hubB := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	instanceA := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubA, ps)
+	instanceB := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubB, ps)
+
+	aliceStream := newEventStreamMock()
+	aliceErr := make(chan error, 1)
+	go func() {
+		aliceErr <- instanceA.ConnectChatEvents(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	require.Eventually(t, func() bool {
+		return hubA.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	// bob подключается к другому экземпляру, затем еще с одного устройства
+	bobLaptop, bobPhone := newStreamMock(), newStreamMock()
+	laptopErr, phoneErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		laptopErr <- instanceB.ConnectChat(bobLaptop.Context(), chatID, bob, 0, bobLaptop)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(aliceStream.events()) == 1
+	}, time.Second, time.Millisecond)
+	online := aliceStream.events()[0].GetPresence()
+	require.Equal(t, bob, online.GetUsername())
+	require.True(t, online.GetOnline())
+	require.Nil(t, online.GetLastSeenAt())
+
+	go func() {
+		phoneErr <- instanceA.ConnectChat(bobPhone.Context(), chatID, bob, 0, bobPhone)
+	}()
+	// подключение объявляется до запроса последнего сообщения чата,
+	// который делает каждая из трех сессий
+	require.Eventually(t, func() bool {
+		return chatRepoMock.GetChatMessagesAfterCounter() == 3
+	}, time.Second, time.Millisecond)
+
+	// bob остается в сети, пока подключен хотя бы к одному экземпляру
+	bobLaptop.cancel()
+	require.NoError(t, <-laptopErr)
+	require.True(t, hubA.IsOnline(bob))
+
+	started := time.Now()
+	bobPhone.cancel()
+	require.NoError(t, <-phoneErr)
+
+	require.Eventually(t, func() bool {
+		return len(aliceStream.events()) == 2
+	}, time.Second, time.Millisecond)
+	offline := aliceStream.events()[1].GetPresence()
+	require.Equal(t, bob, offline.GetUsername())
+	require.False(t, offline.GetOnline())
+	require.False(t, offline.GetLastSeenAt().AsTime().Before(started.Truncate(time.Second)))
+
+	// время отключения сохраняет каждый экземпляр, от которого отключился bob
+	mu.Lock()
+	require.Equal(t, []string{bob, bob}, disconnected)
+	require.True(t, offline.GetLastSeenAt().AsTime().Equal(lastSeen[bob]))
+	mu.Unlock()
+
+	// свое подключение пользователю не отправляется
+	aliceStream.cancel()
+	require.NoError(t, <-aliceErr)
+	require.Len(t, aliceStream.events(), 2)
+}
+
+func TestConnectChatPresenceEventsSlowQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+
+		mu       sync.Mutex
+		blocking = true
+		release  = make(chan struct{})
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetLastSeenMock.Optional().Return(map[string]time.Time{}, nil)
+	chatRepoMock.GetUserChatIDsMock.Set(func(ctx context.Context, username string) ([]int64, error) {
+		mu.Lock()
+		block := blocking
+		mu.Unlock()
+
+		if block {
+			select {
+			case <-release:
+			case <-ctx.Done():
+			}
+		}
+		return []int64{chatID}, nil
+	})
+
+	logger.MockInit()
+
+	ps := memory.New()
+	hubA := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	hubB := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	instanceA := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubA, ps)
+	instanceB := chat.NewMockService(chatRepoMock, txManagerMock(mc), hubB, ps)
+
+	aliceStream := newEventStreamMock()
+	aliceErr := make(chan error, 1)
+	go func() {
+		aliceErr <- instanceA.ConnectChatEvents(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	require.Eventually(t, func() bool {
+		return hubA.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	// запрос чатов при подключении bob зависает
+	bobStream := newStreamMock()
+	bobErr := make(chan error, 1)
+	go func() {
+		bobErr <- instanceB.ConnectChat(bobStream.Context(), chatID, bob, 0, bobStream)
+	}()
+	require.Eventually(t, func() bool {
+		return chatRepoMock.GetUserChatIDsBeforeCounter() >= 1
+	}, time.Second, time.Millisecond)
+
+	mu.Lock()
+	blocking = false
+	mu.Unlock()
+
+	// отключение bob обрабатывается, не дожидаясь зависшего запроса
+	bobStream.cancel()
+	require.NoError(t, <-bobErr)
+	require.Eventually(t, func() bool {
+		return len(aliceStream.events()) == 1
+	}, time.Second, time.Millisecond)
+
+	// устаревшее подключение не рассылается после отключения
+	close(release)
+	require.Eventually(t, func() bool {
+		return chatRepoMock.GetUserChatIDsAfterCounter() == chatRepoMock.GetUserChatIDsBeforeCounter()
+	}, time.Second, time.Millisecond)
+
+	aliceStream.cancel()
+	require.NoError(t, <-aliceErr)
+
+	events := aliceStream.events()
+	require.Len(t, events, 1)
+	require.Equal(t, bob, events[0].GetPresence().GetUsername())
+	require.False(t, events[0].GetPresence().GetOnline())
+}
+
+func TestConnectChatReconnectDuringDisconnect(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		bob    = gofakeit.Username()
+
+		release = make(chan struct{})
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.GetLastSeenMock.Optional().Return(map[string]time.Time{}, nil)
+	// сохранение времени отключения зависает, пока bob подключается снова
+	chatRepoMock.UpdateLastSeenMock.Set(func(_ context.Context, _ string) error {
+		<-release
+		return nil
+	})
+
+	logger.MockInit()
+
+	ps := memory.New()
+	instanceA := chat.NewMockService(chatRepoMock, txManagerMock(mc), hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect), ps)
+	instanceB := chat.NewMockService(chatRepoMock, txManagerMock(mc), hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect), ps)
+
+	isOnline := func() bool {
+		presences, err := instanceA.GetPresence(context.Background(), []string{bob})
+		require.NoError(t, err)
+		return presences[0].Online
+	}
+
+	first := newStreamMock()
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- instanceB.ConnectChat(first.Context(), chatID, bob, 0, first)
+	}()
+	require.Eventually(t, isOnline, time.Second, time.Millisecond)
+
+	first.cancel()
+	require.Eventually(t, func() bool {
+		return chatRepoMock.UpdateLastSeenBeforeCounter() == 1
+	}, time.Second, time.Millisecond)
+
+	second := newStreamMock()
+	secondErr := make(chan error, 1)
+	go func() {
+		secondErr <- instanceB.ConnectChat(second.Context(), chatID, bob, 0, second)
+	}()
+	// подключение объявляется до запроса последнего сообщения чата
+	require.Eventually(t, func() bool {
+		return chatRepoMock.GetChatMessagesAfterCounter() == 2
+	}, time.Second, time.Millisecond)
+
+	// первая сессия завершается вместе с объявлением отключения
+	close(release)
+	require.NoError(t, <-firstErr)
+	require.True(t, isOnline())
+
+	second.cancel()
+	require.NoError(t, <-secondErr)
+	require.False(t, isOnline())
+}
@@ -0,0 +1,446 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+		emoji     string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		emoji     = gofakeit.Emoji()
+
+		msg   = &model.Message{ID: messageID, ChatID: chatID, From: gofakeit.Username()}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventReactionsChanged,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", actor, chatID)
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: " " + actor, emoji: " " + emoji},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.AddReactionMock.Expect(ctx, messageID, actor, emoji).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success reaction already added",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.AddReactionMock.Expect(ctx, messageID, actor, emoji).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error empty emoji",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: " "},
+			err:  errs.InvalidArgument("emoji", "emoji can't be empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error emoji too long",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: strings.Repeat(emoji, 17)},
+			err:  errs.InvalidArgument("emoji", "emoji can't be longer than %d characters", 16),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error actor not in chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			err:  notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(notMemberErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message is deleted",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			err:  errs.FailedPrecondition("MESSAGE_DELETED", "message %d was deleted", messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID, Deleted: true}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error from outbox repo",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.AddReactionMock.Expect(ctx, messageID, actor, emoji).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(0, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.AddReaction(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor, tt.args.emoji)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRemoveReaction(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+		emoji     string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		emoji     = gofakeit.Emoji()
+
+		msg   = &model.Message{ID: messageID, ChatID: chatID, From: gofakeit.Username()}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventReactionsChanged,
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+
+		repoErr = fmt.Errorf("repo error")
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.RemoveReactionMock.Expect(ctx, messageID, actor, emoji).Return(true, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+		},
+		{
+			name: "success reaction not added",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.RemoveReactionMock.Expect(ctx, messageID, actor, emoji).Return(false, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error message from another chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			err:  errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID + 1}, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: actor, emoji: emoji},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, actor).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.RemoveReactionMock.Expect(ctx, messageID, actor, emoji).Return(false, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.outboxRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.RemoveReaction(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor, tt.args.emoji)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConnectChatReactionEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		emoji  = gofakeit.Emoji()
+
+		msg = &model.Message{
+			ID:        gofakeit.Int64(),
+			ChatID:    chatID,
+			From:      alice,
+			Text:      gofakeit.Fruit(),
+			Reactions: []*model.Reaction{{Emoji: emoji, Count: 1}},
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetMessageMock.Return(msg, nil)
+	chatRepoMock.AddReactionMock.Return(true, nil)
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock(mc), ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock(mc), chatHub, ps)
+
+	stream := newEventStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChatEvents(stream.Context(), chatID, alice, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.AddReaction(context.Background(), chatID, msg.ID, bob, emoji)
+	require.NoError(t, err)
+
+	// подписчики получают сообщение с текущими реакциями
+	require.Eventually(t, func() bool {
+		return len(stream.events()) == 1
+	}, time.Second, time.Millisecond)
+	changed := stream.events()[0].GetReactionsChanged()
+	require.Equal(t, msg.ID, changed.GetId())
+	require.Equal(t, []*chat_v1.Reaction{{Emoji: emoji, Count: 1}}, changed.GetReactions())
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestConnectChatSkipsReactionEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+		emoji  = gofakeit.Emoji()
+
+		reacted = &model.Message{
+			ID:        gofakeit.Int64(),
+			ChatID:    chatID,
+			From:      alice,
+			Text:      gofakeit.Fruit(),
+			Reactions: []*model.Reaction{{Emoji: emoji, Count: 1}},
+		}
+		sent = &model.Message{
+			ID:     reacted.ID + 1,
+			ChatID: chatID,
+			From:   bob,
+			Text:   gofakeit.Fruit(),
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.AddReactionMock.Return(true, nil)
+	chatRepoMock.SendMessageMock.Return(sent, nil)
+	chatRepoMock.GetMessageMock.Set(func(_ context.Context, messageID int64) (*model.Message, error) {
+		if messageID == reacted.ID {
+			return reacted, nil
+		}
+		return sent, nil
+	})
+
+	outboxRepo := outboxRepositoryMock(mc)
+	ps := memory.New()
+
+	logger.MockInit()
+	startRelay(t, outboxRepo, txManagerMock(mc), ps)
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, outboxRepo, txManagerMock(mc), chatHub, ps)
+
+	stream := newStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChat(stream.Context(), chatID, alice, 0, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.AddReaction(context.Background(), chatID, reacted.ID, bob, emoji)
+	require.NoError(t, err)
+
+	_, err = s.SendMessage(context.Background(), &model.Message{ChatID: chatID, From: bob, Text: sent.Text})
+	require.NoError(t, err)
+
+	// реакция доставляется раньше сообщения, но в stream сообщений не попадает
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 1
+	}, time.Second, time.Millisecond)
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+	require.Len(t, stream.messages(), 1)
+	require.Equal(t, sent.ID, stream.messages()[0].GetId())
+}
@@ -0,0 +1,296 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		username  string
+		messageID int64
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID     = gofakeit.Int64()
+		username   = gofakeit.Username()
+		messageID  = gofakeit.Int64()
+		prevCursor = gofakeit.Int64()
+
+		msg = &model.Message{ID: messageID, ChatID: chatID}
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *emptypb.Empty
+		wantEvent          *pubsub.Event
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:      "success small chat",
+			args:      args{ctx: ctx, chatID: chatID, username: " " + username, messageID: messageID},
+			want:      res,
+			wantEvent: &pubsub.Event{Type: pubsub.EventTypeRead, ChatID: chatID, MessageID: messageID, Username: username},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetReadCursorMock.Expect(ctx, chatID, username).Return(prevCursor, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, username, messageID).Return(true, nil)
+				mock.AddReadReceiptsMock.ExpectCtxParam1(ctx).ExpectChatIDParam2(chatID).ExpectUsernameParam3(username).
+					ExpectAfterIDParam4(prevCursor).ExpectUpToIDParam5(messageID).Return(nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name: "success large chat without read receipt",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetReadCursorMock.Expect(ctx, chatID, username).Return(prevCursor, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, username, messageID).Return(true, nil)
+				mock.AddReadReceiptsMock.ExpectCtxParam1(ctx).ExpectChatIDParam2(chatID).ExpectUsernameParam3(username).
+					ExpectAfterIDParam4(prevCursor).ExpectUpToIDParam5(messageID).Return(nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(100, nil)
+				return mock
+			},
+		},
+		{
+			name: "success already read",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			want: res,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetReadCursorMock.Expect(ctx, chatID, username).Return(prevCursor, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, username, messageID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "error empty username",
+			args: args{ctx: ctx, chatID: chatID, username: " ", messageID: messageID},
+			err:  errs.InvalidArgument("username", "username can't be empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error invalid message id",
+			args: args{ctx: ctx, chatID: chatID, username: username},
+			err:  errs.InvalidArgument("up_to_message_id", "up_to_message_id must be positive"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			err:  notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name: "error message from another chat",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			err:  errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID + 1}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error add read receipts",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetReadCursorMock.Expect(ctx, chatID, username).Return(prevCursor, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, username, messageID).Return(true, nil)
+				mock.AddReadReceiptsMock.ExpectCtxParam1(ctx).ExpectChatIDParam2(chatID).ExpectUsernameParam3(username).
+					ExpectAfterIDParam4(prevCursor).ExpectUpToIDParam5(messageID).Return(repoErr)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{ctx: ctx, chatID: chatID, username: username, messageID: messageID},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetReadCursorMock.Expect(ctx, chatID, username).Return(prevCursor, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, username, messageID).Return(false, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ps := &publishedEvents{PubSub: memory.New(), events: make(chan *pubsub.Event, 1)}
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc), ps)
+
+			got, err := service.MarkRead(tt.args.ctx, tt.args.chatID, tt.args.username, tt.args.messageID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			if tt.wantEvent == nil {
+				require.Empty(t, ps.events)
+				return
+			}
+			require.Equal(t, tt.wantEvent, <-ps.events)
+		})
+	}
+}
+
+func TestMarkReadTime(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		username  = gofakeit.Username()
+		messageID = gofakeit.Int64()
+
+		readAt time.Time
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Return(nil)
+	chatRepoMock.GetMessageMock.Return(&model.Message{ID: messageID, ChatID: chatID}, nil)
+	chatRepoMock.GetReadCursorMock.Return(0, nil)
+	chatRepoMock.MarkReadMock.Return(true, nil)
+	chatRepoMock.AddReadReceiptsMock.Set(func(_ context.Context, _ int64, _ string, _ int64, _ int64, at time.Time) error {
+		readAt = at
+		return nil
+	})
+	chatRepoMock.CountChatMembersMock.Return(100, nil)
+
+	logger.MockInit()
+
+	s := chat.NewMockService(chatRepoMock, txManagerMock(mc), memory.New())
+
+	before := time.Now()
+	_, err := s.MarkRead(ctx, chatID, username, messageID)
+	require.NoError(t, err)
+
+	// время прочтения берется в UTC с часов сервиса, как и время доставки
+	require.Equal(t, time.UTC, readAt.Location())
+	require.WithinRange(t, readAt, before, time.Now())
+}
+
+func TestConnectChatReadEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Optional().Return(nil, nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetMessageMock.Return(&model.Message{ID: 1, ChatID: chatID}, nil)
+	chatRepoMock.GetReadCursorMock.Return(0, nil)
+	chatRepoMock.MarkReadMock.Return(true, nil)
+	chatRepoMock.AddReadReceiptsMock.Return(nil)
+	chatRepoMock.CountChatMembersMock.Return(2, nil)
+
+	logger.MockInit()
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(chatRepoMock, txManagerMock(mc), chatHub, memory.New())
+
+	aliceStream, bobStream := newEventStreamMock(), newEventStreamMock()
+	aliceErr, bobErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		aliceErr <- s.ConnectChatEvents(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	go func() {
+		bobErr <- s.ConnectChatEvents(bobStream.Context(), chatID, bob, 0, bobStream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice) && chatHub.IsSubscribed(chatID, bob)
+	}, time.Second, time.Millisecond)
+
+	_, err := s.MarkRead(context.Background(), chatID, alice, 1)
+	require.NoError(t, err)
+
+	// событие получают все участники, включая другие устройства самого читателя
+	want := &chat_v1.ReadReceipt{Username: alice, UpToMessageId: 1}
+	for _, stream := range []*eventStreamMock{aliceStream, bobStream} {
+		stream := stream
+		require.Eventually(t, func() bool {
+			return len(stream.events()) == 1
+		}, time.Second, time.Millisecond)
+		require.Equal(t, want, stream.events()[0].GetRead())
+	}
+
+	aliceStream.cancel()
+	bobStream.cancel()
+	require.NoError(t, <-aliceErr)
+	require.NoError(t, <-bobErr)
+}
@@ -0,0 +1,207 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// noRole пользователь не состоит в чате
+const noRole model.Role = 0
+
+// chatAction действие пользователя actor над участником target в чате
+type chatAction func(ctx context.Context, s service.ChatService, chatID int64, actor, target string, targetRole model.Role) error
+
+var (
+	deleteChat chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, _ string, _ model.Role) error {
+		_, err := s.DeleteChat(ctx, chatID, actor)
+		return err
+	}
+	renameChat chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, _ string, _ model.Role) error {
+		_, err := s.RenameChat(ctx, chatID, actor, gofakeit.Username())
+		return err
+	}
+	addMembers chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, _ string, _ model.Role) error {
+		_, err := s.AddChatMembers(ctx, chatID, actor, []string{gofakeit.Username()})
+		return err
+	}
+	removeMember chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, target string, _ model.Role) error {
+		_, err := s.RemoveChatMember(ctx, chatID, actor, target)
+		return err
+	}
+	leaveChat chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, _ string, _ model.Role) error {
+		_, err := s.LeaveChat(ctx, chatID, actor)
+		return err
+	}
+	transferOwnership chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, target string, _ model.Role) error {
+		_, err := s.TransferOwnership(ctx, chatID, actor, target)
+		return err
+	}
+	// setMemberRole меняет роль участника на противоположную
+	setMemberRole chatAction = func(ctx context.Context, s service.ChatService, chatID int64, actor, target string, targetRole model.Role) error {
+		role := model.RoleAdmin
+		if targetRole == model.RoleAdmin {
+			role = model.RoleMember
+		}
+
+		_, err := s.SetMemberRole(ctx, chatID, actor, target, role)
+		return err
+	}
+)
+
+func TestAuthorizationMatrix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		action     chatAction
+		actorRole  model.Role
+		targetRole model.Role
+		wantKind   errs.Kind // 0 - действие разрешено
+	}{
+		{name: "owner deletes chat", action: deleteChat, actorRole: model.RoleOwner},
+		{name: "admin deletes chat", action: deleteChat, actorRole: model.RoleAdmin, wantKind: errs.KindPermissionDenied},
+		{name: "member deletes chat", action: deleteChat, actorRole: model.RoleMember, wantKind: errs.KindPermissionDenied},
+		{name: "outsider deletes chat", action: deleteChat, actorRole: noRole, wantKind: errs.KindPermissionDenied},
+
+		{name: "owner renames chat", action: renameChat, actorRole: model.RoleOwner},
+		{name: "admin renames chat", action: renameChat, actorRole: model.RoleAdmin},
+		{name: "member renames chat", action: renameChat, actorRole: model.RoleMember, wantKind: errs.KindPermissionDenied},
+		{name: "outsider renames chat", action: renameChat, actorRole: noRole, wantKind: errs.KindPermissionDenied},
+
+		{name: "owner adds members", action: addMembers, actorRole: model.RoleOwner},
+		{name: "admin adds members", action: addMembers, actorRole: model.RoleAdmin},
+		{name: "member adds members", action: addMembers, actorRole: model.RoleMember, wantKind: errs.KindPermissionDenied},
+		{name: "outsider adds members", action: addMembers, actorRole: noRole, wantKind: errs.KindPermissionDenied},
+
+		{name: "owner removes admin", action: removeMember, actorRole: model.RoleOwner, targetRole: model.RoleAdmin},
+		{name: "owner removes member", action: removeMember, actorRole: model.RoleOwner, targetRole: model.RoleMember},
+		{name: "admin removes member", action: removeMember, actorRole: model.RoleAdmin, targetRole: model.RoleMember},
+		{
+			name: "admin removes admin", action: removeMember, actorRole: model.RoleAdmin, targetRole: model.RoleAdmin,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "admin removes owner", action: removeMember, actorRole: model.RoleAdmin, targetRole: model.RoleOwner,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "member removes member", action: removeMember, actorRole: model.RoleMember, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "outsider removes member", action: removeMember, actorRole: noRole, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+
+		{name: "owner leaves chat", action: leaveChat, actorRole: model.RoleOwner, wantKind: errs.KindFailedPrecondition},
+		{name: "admin leaves chat", action: leaveChat, actorRole: model.RoleAdmin},
+		{name: "member leaves chat", action: leaveChat, actorRole: model.RoleMember},
+
+		{name: "owner promotes member", action: setMemberRole, actorRole: model.RoleOwner, targetRole: model.RoleMember},
+		{name: "owner demotes admin", action: setMemberRole, actorRole: model.RoleOwner, targetRole: model.RoleAdmin},
+		{
+			name: "admin promotes member", action: setMemberRole, actorRole: model.RoleAdmin, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "member promotes member", action: setMemberRole, actorRole: model.RoleMember, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "owner changes role of non member", action: setMemberRole, actorRole: model.RoleOwner, targetRole: noRole,
+			wantKind: errs.KindNotFound,
+		},
+
+		{name: "owner transfers ownership to admin", action: transferOwnership, actorRole: model.RoleOwner, targetRole: model.RoleAdmin},
+		{name: "owner transfers ownership to member", action: transferOwnership, actorRole: model.RoleOwner, targetRole: model.RoleMember},
+		{
+			name: "owner transfers ownership to non member", action: transferOwnership, actorRole: model.RoleOwner, targetRole: noRole,
+			wantKind: errs.KindNotFound,
+		},
+		{
+			name: "admin transfers ownership", action: transferOwnership, actorRole: model.RoleAdmin, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+		{
+			name: "member transfers ownership", action: transferOwnership, actorRole: model.RoleMember, targetRole: model.RoleMember,
+			wantKind: errs.KindPermissionDenied,
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx = context.Background()
+				mc  = minimock.NewController(t)
+
+				chatID = gofakeit.Int64()
+				actor  = gofakeit.Username()
+				target = gofakeit.Username()
+
+				// вызывались ли методы репо, изменяющие чат
+				changed atomic.Bool
+			)
+
+			roles := map[string]model.Role{}
+			if tt.actorRole != noRole {
+				roles[actor] = tt.actorRole
+			}
+			if tt.targetRole != noRole {
+				roles[target] = tt.targetRole
+			}
+
+			mock := repoMocks.NewChatRepositoryMock(mc)
+			expectRoles(mock, chatID, roles)
+			mock.DeleteChatMock.Optional().Set(func(_ context.Context, _ int64) (*emptypb.Empty, error) {
+				changed.Store(true)
+				return &emptypb.Empty{}, nil
+			})
+			mock.RenameChatMock.Optional().Set(func(_ context.Context, _ int64, _ string) error {
+				changed.Store(true)
+				return nil
+			})
+			mock.AddChatMembersMock.Optional().Set(func(_ context.Context, _ int64, usernames []string) ([]string, error) {
+				changed.Store(true)
+				return usernames, nil
+			})
+			mock.RemoveChatMemberMock.Optional().Set(func(_ context.Context, _ int64, _ string) (bool, error) {
+				changed.Store(true)
+				return true, nil
+			})
+			mock.SetMemberRoleMock.Optional().Set(func(_ context.Context, _ int64, _ string, _ model.Role) error {
+				changed.Store(true)
+				return nil
+			})
+
+			s := chat.NewMockService(mock, txManagerMock(mc))
+
+			err := tt.action(ctx, s, chatID, actor, target, tt.targetRole)
+			if tt.wantKind == 0 {
+				require.NoError(t, err)
+				require.True(t, changed.Load())
+				return
+			}
+
+			require.True(t, errs.Is(err, tt.wantKind), "unexpected error: %v", err)
+			require.False(t, changed.Load())
+		})
+	}
+}
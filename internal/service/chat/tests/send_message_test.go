@@ -7,6 +7,7 @@ import (
 
 	"github.com/solumD/chat-server/internal/client/db"
 	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
 	"github.com/solumD/chat-server/internal/logger"
 	"github.com/solumD/chat-server/internal/model"
 	"github.com/solumD/chat-server/internal/repository"
@@ -22,6 +23,7 @@ import (
 func TestSendMessage(t *testing.T) {
 	t.Parallel()
 	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
 
 	type args struct {
@@ -38,8 +40,10 @@ func TestSendMessage(t *testing.T) {
 		text = gofakeit.Fruit()
 
 		repoErr      = fmt.Errorf("repo error")
-		emptyFromErr = fmt.Errorf("from can't be empty")
-		emptyTextErr = fmt.Errorf("message's text can't be empty")
+		outboxErr    = fmt.Errorf("outbox error")
+		notInChatErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", from, id)
+		emptyFromErr = errs.InvalidArgument("from", "from can't be empty")
+		emptyTextErr = errs.InvalidArgument("text", "message's text can't be empty")
 
 		req = &model.Message{
 			ChatID: id,
@@ -58,17 +62,30 @@ func TestSendMessage(t *testing.T) {
 			From:   from,
 			Text:   "",
 		}
+		saved = &model.Message{
+			ID:        gofakeit.Int64(),
+			ChatID:    id,
+			From:      from,
+			Text:      text,
+			CreatedAt: gofakeit.Date(),
+		}
+		event = &model.OutboxEvent{
+			Type:      model.OutboxEventMessage,
+			ChatID:    id,
+			MessageID: saved.ID,
+		}
 		res = &emptypb.Empty{}
 	)
 	defer t.Cleanup(mc.Finish)
 
 	tests := []struct {
-		name               string
-		args               args
-		want               *emptypb.Empty
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success from repo",
@@ -80,7 +97,12 @@ func TestSendMessage(t *testing.T) {
 			err:  nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(res, nil)
+				mock.SendMessageMock.Expect(ctx, req).Return(saved, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(gofakeit.Int64(), nil)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -104,6 +126,61 @@ func TestSendMessage(t *testing.T) {
 				mock.SendMessageMock.Expect(ctx, req).Return(nil, repoErr)
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error from outbox",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  outboxErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.SendMessageMock.Expect(ctx, req).Return(saved, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(ctx, event).Return(0, outboxErr)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  notInChatErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.SendMessageMock.Expect(ctx, req).Return(nil, notInChatErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				return mock
+			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
 				mock := mocks.NewTxManagerMock(mc)
 				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
@@ -124,6 +201,10 @@ func TestSendMessage(t *testing.T) {
 				mock := repoMocks.NewChatRepositoryMock(mc)
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				return mock
+			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
 				mock := mocks.NewTxManagerMock(mc)
 				return mock
@@ -141,6 +222,10 @@ func TestSendMessage(t *testing.T) {
 				mock := repoMocks.NewChatRepositoryMock(mc)
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				return mock
+			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
 				mock := mocks.NewTxManagerMock(mc)
 				return mock
@@ -156,9 +241,10 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			authRepoMock := tt.chatRepositoryMock(mc)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(mc)
 
-			service := chat.NewMockService(authRepoMock, txManagerMock)
+			service := chat.NewMockService(authRepoMock, outboxRepoMock, txManagerMock)
 
 			newID, err := service.SendMessage(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
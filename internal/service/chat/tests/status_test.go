@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/delivery"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMessageStatus(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx       context.Context
+		chatID    int64
+		messageID int64
+		actor     string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		alice     = gofakeit.Username()
+		bob       = gofakeit.Username()
+		readAt    = gofakeit.Date()
+
+		msg = &model.Message{ID: messageID, ChatID: chatID, From: alice}
+
+		statuses = []*model.MessageStatus{
+			{Username: bob, Delivered: true, DeliveredAt: sql.NullTime{Time: readAt, Valid: true},
+				Read: true, ReadAt: sql.NullTime{Time: readAt, Valid: true}},
+		}
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", bob, chatID)
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               []*model.MessageStatus
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: " " + alice},
+			want: statuses,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, alice).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetMessageStatusMock.Expect(ctx, messageID).Return(statuses, nil)
+				return mock
+			},
+		},
+		{
+			name: "error not message author",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: bob},
+			err: errs.PermissionDenied("NOT_MESSAGE_AUTHOR",
+				"user %s can't get status of message %d of another user", bob, messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, bob).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: bob},
+			err:  notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, bob).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name: "error message from another chat",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: alice},
+			err:  errs.NotFound("message", "message %d doesn't exist in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, alice).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(&model.Message{ID: messageID, ChatID: chatID + 1, From: alice}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{ctx: ctx, chatID: chatID, messageID: messageID, actor: alice},
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, alice).Return(nil)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(msg, nil)
+				mock.GetMessageStatusMock.Expect(ctx, messageID).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.GetMessageStatus(tt.args.ctx, tt.args.chatID, tt.args.messageID, tt.args.actor)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConnectChatRecordsDeliveries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+
+		mu        sync.Mutex
+		delivered []*model.Delivery
+	)
+	defer t.Cleanup(mc.Finish)
+
+	history := []*model.Message{
+		{ID: 2, ChatID: chatID, From: bob, Text: gofakeit.Sentence(3)},
+		{ID: 3, ChatID: chatID, From: alice, Text: gofakeit.Sentence(3)},
+	}
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.CheckChatMock.Optional().Return(nil)
+	chatRepoMock.GetUserChatIDsMock.Optional().Return([]int64{}, nil)
+	chatRepoMock.UpdateLastSeenMock.Optional().Return(nil)
+	chatRepoMock.GetChatMessagesMock.Set(func(_ context.Context, filter *model.MessagesFilter) ([]*model.Message, error) {
+		if filter.AfterID == 1 {
+			return history, nil
+		}
+		return nil, nil
+	})
+	chatRepoMock.AddDeliveriesMock.Set(func(_ context.Context, deliveries []*model.Delivery) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		delivered = append(delivered, deliveries...)
+		return nil
+	})
+
+	logger.MockInit()
+
+	recorder := delivery.NewRecorder(chatRepoMock, txManagerMock(mc), time.Hour, delivery.DefaultBatchSize)
+	recorder.Start()
+	s := chat.NewMockService(chatRepoMock, txManagerMock(mc), recorder)
+
+	stream := newStreamMock()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ConnectChat(stream.Context(), chatID, alice, 1, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 2
+	}, time.Second, time.Millisecond)
+
+	stream.cancel()
+	require.NoError(t, <-errCh)
+	require.NoError(t, recorder.Close())
+
+	// собственные сообщения пользователю не доставляются
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, delivered, 1)
+	require.Equal(t, int64(2), delivered[0].MessageID)
+	require.Equal(t, alice, delivered[0].Username)
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetThread(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+		parentID = int64(gofakeit.IntRange(100, 1000))
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %s not in chat %d", username, chatID)
+
+		parent  = &model.Message{ID: parentID, ChatID: chatID, From: username, ReplyCount: 3}
+		replies = []*model.Message{
+			{ID: parentID + 1, ChatID: chatID, From: username, Text: gofakeit.Fruit(), ReplyToMessageID: parentID},
+			{ID: parentID + 2, ChatID: chatID, From: username, Text: gofakeit.Fruit(), ReplyToMessageID: parentID},
+			{ID: parentID + 3, ChatID: chatID, From: username, Text: gofakeit.Fruit(), ReplyToMessageID: parentID},
+		}
+
+		req = &model.MessagesFilter{
+			ChatID:           chatID,
+			Username:         " " + username,
+			AfterID:          parentID,
+			Limit:            2,
+			ReplyToMessageID: parentID,
+		}
+		repoReq = &model.MessagesFilter{
+			ChatID:           chatID,
+			Username:         username,
+			AfterID:          parentID,
+			Limit:            3,
+			ReplyToMessageID: parentID,
+		}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		req                *model.MessagesFilter
+		want               *model.MessagesPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success with more pages",
+			req:  req,
+			want: &model.MessagesPage{Messages: replies[:2], HasMore: true},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, parentID).Return(parent, nil)
+				mock.GetChatMessagesMock.Expect(ctx, repoReq).Return(replies, nil)
+				return mock
+			},
+		},
+		{
+			name: "error invalid message id",
+			req:  &model.MessagesFilter{ChatID: chatID, Username: username},
+			err:  errs.InvalidArgument("message_id", "message_id must be positive"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error both cursors",
+			req:  &model.MessagesFilter{ChatID: chatID, Username: username, BeforeID: 1, AfterID: 1, ReplyToMessageID: parentID},
+			err:  errs.InvalidArgument("before_id", "before_id and after_id can't be set together"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error user not in chat",
+			req:  req,
+			err:  notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name: "error message from another chat",
+			req:  req,
+			err:  errs.NotFound("message", "message %d doesn't exist in chat %d", parentID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, parentID).Return(&model.Message{ID: parentID, ChatID: chatID + 1}, nil)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			req:  req,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				mock.GetMessageMock.Expect(ctx, parentID).Return(parent, nil)
+				mock.GetChatMessagesMock.Expect(ctx, repoReq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc))
+
+			got, err := service.GetThread(ctx, tt.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/client/db"
+	"github.com/solumD/chat-server/internal/client/db/mocks"
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/hub"
+	"github.com/solumD/chat-server/internal/logger"
+	"github.com/solumD/chat-server/internal/pubsub"
+	"github.com/solumD/chat-server/internal/pubsub/memory"
+	"github.com/solumD/chat-server/internal/repository"
+	repoMocks "github.com/solumD/chat-server/internal/repository/mocks"
+	"github.com/solumD/chat-server/internal/service/chat"
+	"github.com/solumD/chat-server/internal/typing"
+	"github.com/solumD/chat-server/pkg/chat_v1"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// publishedEvents pub/sub в памяти, запоминающий опубликованные события
+type publishedEvents struct {
+	pubsub.PubSub
+	events chan *pubsub.Event
+}
+
+func (p *publishedEvents) Publish(ctx context.Context, event *pubsub.Event) error {
+	p.events <- event
+	return p.PubSub.Publish(ctx, event)
+}
+
+func TestSetTyping(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx      context.Context
+		chatID   int64
+		username string
+		isTyping bool
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		repoErr      = fmt.Errorf("repo error")
+		notMemberErr = errs.PermissionDenied("NOT_CHAT_MEMBER", "user %v not in chat %d", username, chatID)
+
+		res = &emptypb.Empty{}
+	)
+	defer t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name               string
+		args               args
+		used               int // сколько обновлений пользователь уже отправил в текущем окне
+		wantUsed           int // сколько обновлений засчитано после запроса
+		want               *emptypb.Empty
+		wantEvent          *pubsub.Event
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success start typing",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: " " + username,
+				isTyping: true,
+			},
+			want: res,
+			wantEvent: &pubsub.Event{
+				Type:     pubsub.EventTypeTyping,
+				ChatID:   chatID,
+				Username: username,
+				IsTyping: true,
+			},
+			wantUsed: 1,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "success stop typing",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: username,
+			},
+			want: res,
+			wantEvent: &pubsub.Event{
+				Type:     pubsub.EventTypeTyping,
+				ChatID:   chatID,
+				Username: username,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "success stop typing is not rate limited",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: username,
+			},
+			used:     typing.DefaultRateLimit,
+			wantUsed: typing.DefaultRateLimit,
+			want:     res,
+			wantEvent: &pubsub.Event{
+				Type:     pubsub.EventTypeTyping,
+				ChatID:   chatID,
+				Username: username,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "error empty username",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: " ",
+				isTyping: true,
+			},
+			err: errs.InvalidArgument("username", "username can't be empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "error rate limit exceeded",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: username,
+				isTyping: true,
+			},
+			used:     typing.DefaultRateLimit,
+			wantUsed: typing.DefaultRateLimit,
+			err:      errs.ResourceExhausted("typing", "too many typing updates from user %s", username),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "error user not in chat",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: username,
+				isTyping: true,
+			},
+			err: notMemberErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name: "error from repo",
+			args: args{
+				ctx:      ctx,
+				chatID:   chatID,
+				username: username,
+				isTyping: true,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.CheckChatMock.Expect(ctx, chatID, username).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	logger.MockInit()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := typing.NewLimiter(typing.DefaultRateLimit, time.Minute)
+			for i := 0; i < tt.used; i++ {
+				require.True(t, limiter.Allow(username))
+			}
+
+			ps := &publishedEvents{PubSub: memory.New(), events: make(chan *pubsub.Event, 1)}
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock(mc), limiter, ps)
+
+			got, err := service.SetTyping(tt.args.ctx, tt.args.chatID, tt.args.username, tt.args.isTyping)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			// лимит расходуют только разрешенные обновления начала набора текста
+			for i := tt.wantUsed; i < typing.DefaultRateLimit; i++ {
+				require.True(t, limiter.Allow(username))
+			}
+			require.False(t, limiter.Allow(username))
+
+			if tt.wantEvent == nil {
+				require.Empty(t, ps.events)
+				return
+			}
+			require.Equal(t, tt.wantEvent, <-ps.events)
+		})
+	}
+}
+
+func TestConnectChatTypingEvents(t *testing.T) {
+	t.Parallel()
+
+	const ttl = 100 * time.Millisecond
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		alice  = gofakeit.Username()
+		bob    = gofakeit.Username()
+	)
+	defer t.Cleanup(mc.Finish)
+
+	txManagerMock := mocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(ctx)
+	})
+
+	logger.MockInit()
+
+	chatHub := hub.New(hub.DefaultQueueSize, hub.PolicyDisconnect)
+	s := chat.NewMockService(historyRepositoryMock(mc), outboxRepositoryMock(mc), txManagerMock, chatHub,
+		memory.New(), typing.New(ttl))
+
+	aliceStream, bobStream := newEventStreamMock(), newEventStreamMock()
+	aliceErr, bobErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		aliceErr <- s.ConnectChatEvents(aliceStream.Context(), chatID, alice, 0, aliceStream)
+	}()
+	go func() {
+		bobErr <- s.ConnectChatEvents(bobStream.Context(), chatID, bob, 0, bobStream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatHub.IsSubscribed(chatID, alice) && chatHub.IsSubscribed(chatID, bob)
+	}, time.Second, time.Millisecond)
+
+	// обновления, продлевающие набор текста, не рассылаются
+	for i := 0; i < 3; i++ {
+		_, err := s.SetTyping(context.Background(), chatID, alice, true)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(bobStream.events()) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, &chat_v1.Typing{Username: alice, IsTyping: true}, bobStream.events()[0].GetTyping())
+
+	// без обновлений набор текста гаснет сам
+	require.Eventually(t, func() bool {
+		return len(bobStream.events()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, &chat_v1.Typing{Username: alice}, bobStream.events()[1].GetTyping())
+
+	// свой набор текста пользователю не отправляется
+	require.Empty(t, aliceStream.events())
+
+	aliceStream.cancel()
+	bobStream.cancel()
+	require.NoError(t, <-aliceErr)
+	require.NoError(t, <-bobErr)
+}
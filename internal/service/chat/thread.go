@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+)
+
+// GetThread проверяет, что пользователь состоит в чате, и возвращает страницу
+// ответов на сообщение чата filter.ReplyToMessageID. Ответы на удаленное
+// сообщение остаются доступны
+func (s *srv) GetThread(ctx context.Context, filter *model.MessagesFilter) (*model.MessagesPage, error) {
+	if filter.ReplyToMessageID <= 0 {
+		return nil, errs.InvalidArgument("message_id", "message_id must be positive")
+	}
+
+	return s.messagesPage(ctx, filter, func(ctx context.Context, filter *model.MessagesFilter) error {
+		errTx := s.chatRepository.CheckChat(ctx, filter.ChatID, filter.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.chatMessage(ctx, filter.ChatID, filter.ReplyToMessageID)
+		return errTx
+	})
+}
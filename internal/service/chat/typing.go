@@ -0,0 +1,83 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/solumD/chat-server/internal/errs"
+	"github.com/solumD/chat-server/internal/model"
+	"github.com/solumD/chat-server/internal/pubsub"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetTyping сообщает подключенным к чату участникам на всех экземплярах сервера,
+// что пользователь начал или закончил набирать текст. Состояние набора текста
+// не сохраняется в БД и гаснет само, если пользователь перестал его обновлять.
+// Частота обновлений начала набора текста ограничена
+func (s *srv) SetTyping(ctx context.Context, chatID int64, username string, isTyping bool) (*emptypb.Empty, error) {
+	username = strings.TrimSpace(username)
+	if len(username) == 0 {
+		return nil, errs.InvalidArgument("username", "username can't be empty")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.CheckChat(ctx, chatID, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// лимит расходуют только участники чата. Окончание набора текста не
+	// ограничивается, иначе индикатор горел бы у остальных до истечения TTL
+	if isTyping && !s.typingLimiter.Allow(username) {
+		return nil, errs.ResourceExhausted("typing", "too many typing updates from user %s", username)
+	}
+
+	err = s.pubSub.Publish(ctx, &pubsub.Event{
+		Type:     pubsub.EventTypeTyping,
+		ChatID:   chatID,
+		Username: username,
+		IsTyping: isTyping,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// handleTyping рассылает подписчикам чата на этом экземпляре сервера изменение
+// набора текста. Обновления, продлевающие набор текста, не рассылаются. Набор
+// текста отслеживается только в чатах, у которых есть подписчики
+func (s *srv) handleTyping(event *pubsub.Event) {
+	if !s.chatHub.HasSubscribers(event.ChatID) {
+		return
+	}
+
+	if !s.typingTracker.Set(event.ChatID, event.Username, event.IsTyping) {
+		return
+	}
+
+	s.chatHub.Publish(event.ChatID, &model.ChatEvent{
+		Type:     model.ChatEventTyping,
+		ChatID:   event.ChatID,
+		Username: event.Username,
+		IsTyping: event.IsTyping,
+	})
+}
+
+// typingExpired сообщает подписчикам чата, что пользователь перестал набирать текст
+func (s *srv) typingExpired(chatID int64, username string) {
+	s.chatHub.Publish(chatID, &model.ChatEvent{
+		Type:     model.ChatEventTyping,
+		ChatID:   chatID,
+		Username: username,
+	})
+}
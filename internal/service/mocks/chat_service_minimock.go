@@ -21,13 +21,34 @@ type ChatServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcConnectChat          func(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer) (err error)
+	funcAddChatMembers          func(ctx context.Context, chatID int64, actor string, usernames []string) (ep1 *emptypb.Empty, err error)
+	funcAddChatMembersOrigin    string
+	inspectFuncAddChatMembers   func(ctx context.Context, chatID int64, actor string, usernames []string)
+	afterAddChatMembersCounter  uint64
+	beforeAddChatMembersCounter uint64
+	AddChatMembersMock          mChatServiceMockAddChatMembers
+
+	funcAddReaction          func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mChatServiceMockAddReaction
+
+	funcConnectChat          func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer) (err error)
 	funcConnectChatOrigin    string
-	inspectFuncConnectChat   func(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer)
+	inspectFuncConnectChat   func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer)
 	afterConnectChatCounter  uint64
 	beforeConnectChatCounter uint64
 	ConnectChatMock          mChatServiceMockConnectChat
 
+	funcConnectChatEvents          func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer) (err error)
+	funcConnectChatEventsOrigin    string
+	inspectFuncConnectChatEvents   func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer)
+	afterConnectChatEventsCounter  uint64
+	beforeConnectChatEventsCounter uint64
+	ConnectChatEventsMock          mChatServiceMockConnectChatEvents
+
 	funcCreateChat          func(ctx context.Context, chat *model.Chat) (i1 int64, err error)
 	funcCreateChatOrigin    string
 	inspectFuncCreateChat   func(ctx context.Context, chat *model.Chat)
@@ -35,13 +56,55 @@ type ChatServiceMock struct {
 	beforeCreateChatCounter uint64
 	CreateChatMock          mChatServiceMockCreateChat
 
-	funcDeleteChat          func(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error)
+	funcDeleteChat          func(ctx context.Context, chatID int64, actor string) (ep1 *emptypb.Empty, err error)
 	funcDeleteChatOrigin    string
-	inspectFuncDeleteChat   func(ctx context.Context, chatID int64)
+	inspectFuncDeleteChat   func(ctx context.Context, chatID int64, actor string)
 	afterDeleteChatCounter  uint64
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatServiceMockDeleteChat
 
+	funcDeleteMessage          func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, chatID int64, messageID int64, actor string)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatServiceMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, chatID int64, messageID int64, actor string, text string) (ep1 *emptypb.Empty, err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, chatID int64, messageID int64, actor string, text string)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatServiceMockEditMessage
+
+	funcGetChatMessages          func(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error)
+	funcGetChatMessagesOrigin    string
+	inspectFuncGetChatMessages   func(ctx context.Context, filter *model.MessagesFilter)
+	afterGetChatMessagesCounter  uint64
+	beforeGetChatMessagesCounter uint64
+	GetChatMessagesMock          mChatServiceMockGetChatMessages
+
+	funcGetMessageStatus          func(ctx context.Context, chatID int64, messageID int64, actor string) (mpa1 []*model.MessageStatus, err error)
+	funcGetMessageStatusOrigin    string
+	inspectFuncGetMessageStatus   func(ctx context.Context, chatID int64, messageID int64, actor string)
+	afterGetMessageStatusCounter  uint64
+	beforeGetMessageStatusCounter uint64
+	GetMessageStatusMock          mChatServiceMockGetMessageStatus
+
+	funcGetPresence          func(ctx context.Context, usernames []string) (ppa1 []*model.Presence, err error)
+	funcGetPresenceOrigin    string
+	inspectFuncGetPresence   func(ctx context.Context, usernames []string)
+	afterGetPresenceCounter  uint64
+	beforeGetPresenceCounter uint64
+	GetPresenceMock          mChatServiceMockGetPresence
+
+	funcGetThread          func(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error)
+	funcGetThreadOrigin    string
+	inspectFuncGetThread   func(ctx context.Context, filter *model.MessagesFilter)
+	afterGetThreadCounter  uint64
+	beforeGetThreadCounter uint64
+	GetThreadMock          mChatServiceMockGetThread
+
 	funcGetUserChats          func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)
 	funcGetUserChatsOrigin    string
 	inspectFuncGetUserChats   func(ctx context.Context, username string)
@@ -49,12 +112,89 @@ type ChatServiceMock struct {
 	beforeGetUserChatsCounter uint64
 	GetUserChatsMock          mChatServiceMockGetUserChats
 
+	funcLeaveChat          func(ctx context.Context, chatID int64, username string) (ep1 *emptypb.Empty, err error)
+	funcLeaveChatOrigin    string
+	inspectFuncLeaveChat   func(ctx context.Context, chatID int64, username string)
+	afterLeaveChatCounter  uint64
+	beforeLeaveChatCounter uint64
+	LeaveChatMock          mChatServiceMockLeaveChat
+
+	funcListPinnedMessages          func(ctx context.Context, chatID int64, username string) (mpa1 []*model.Message, err error)
+	funcListPinnedMessagesOrigin    string
+	inspectFuncListPinnedMessages   func(ctx context.Context, chatID int64, username string)
+	afterListPinnedMessagesCounter  uint64
+	beforeListPinnedMessagesCounter uint64
+	ListPinnedMessagesMock          mChatServiceMockListPinnedMessages
+
+	funcMarkRead          func(ctx context.Context, chatID int64, username string, upToMessageID int64) (ep1 *emptypb.Empty, err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, chatID int64, username string, upToMessageID int64)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatServiceMockMarkRead
+
+	funcPinMessage          func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)
+	funcPinMessageOrigin    string
+	inspectFuncPinMessage   func(ctx context.Context, chatID int64, messageID int64, actor string)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatServiceMockPinMessage
+
+	funcRemoveChatMember          func(ctx context.Context, chatID int64, actor string, username string) (ep1 *emptypb.Empty, err error)
+	funcRemoveChatMemberOrigin    string
+	inspectFuncRemoveChatMember   func(ctx context.Context, chatID int64, actor string, username string)
+	afterRemoveChatMemberCounter  uint64
+	beforeRemoveChatMemberCounter uint64
+	RemoveChatMemberMock          mChatServiceMockRemoveChatMember
+
+	funcRemoveReaction          func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mChatServiceMockRemoveReaction
+
+	funcRenameChat          func(ctx context.Context, chatID int64, actor string, name string) (ep1 *emptypb.Empty, err error)
+	funcRenameChatOrigin    string
+	inspectFuncRenameChat   func(ctx context.Context, chatID int64, actor string, name string)
+	afterRenameChatCounter  uint64
+	beforeRenameChatCounter uint64
+	RenameChatMock          mChatServiceMockRenameChat
+
 	funcSendMessage          func(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, message *model.Message)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, actor string, member string, role model.Role) (ep1 *emptypb.Empty, err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, actor string, member string, role model.Role)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatServiceMockSetMemberRole
+
+	funcSetTyping          func(ctx context.Context, chatID int64, username string, isTyping bool) (ep1 *emptypb.Empty, err error)
+	funcSetTypingOrigin    string
+	inspectFuncSetTyping   func(ctx context.Context, chatID int64, username string, isTyping bool)
+	afterSetTypingCounter  uint64
+	beforeSetTypingCounter uint64
+	SetTypingMock          mChatServiceMockSetTyping
+
+	funcTransferOwnership          func(ctx context.Context, chatID int64, actor string, newOwner string) (ep1 *emptypb.Empty, err error)
+	funcTransferOwnershipOrigin    string
+	inspectFuncTransferOwnership   func(ctx context.Context, chatID int64, actor string, newOwner string)
+	afterTransferOwnershipCounter  uint64
+	beforeTransferOwnershipCounter uint64
+	TransferOwnershipMock          mChatServiceMockTransferOwnership
+
+	funcUnpinMessage          func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)
+	funcUnpinMessageOrigin    string
+	inspectFuncUnpinMessage   func(ctx context.Context, chatID int64, messageID int64, actor string)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mChatServiceMockUnpinMessage
 }
 
 // NewChatServiceMock returns a mock for mm_service.ChatService
@@ -65,78 +205,139 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddChatMembersMock = mChatServiceMockAddChatMembers{mock: m}
+	m.AddChatMembersMock.callArgs = []*ChatServiceMockAddChatMembersParams{}
+
+	m.AddReactionMock = mChatServiceMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ChatServiceMockAddReactionParams{}
+
 	m.ConnectChatMock = mChatServiceMockConnectChat{mock: m}
 	m.ConnectChatMock.callArgs = []*ChatServiceMockConnectChatParams{}
 
+	m.ConnectChatEventsMock = mChatServiceMockConnectChatEvents{mock: m}
+	m.ConnectChatEventsMock.callArgs = []*ChatServiceMockConnectChatEventsParams{}
+
 	m.CreateChatMock = mChatServiceMockCreateChat{mock: m}
 	m.CreateChatMock.callArgs = []*ChatServiceMockCreateChatParams{}
 
 	m.DeleteChatMock = mChatServiceMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatServiceMockDeleteChatParams{}
 
+	m.DeleteMessageMock = mChatServiceMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatServiceMockDeleteMessageParams{}
+
+	m.EditMessageMock = mChatServiceMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatServiceMockEditMessageParams{}
+
+	m.GetChatMessagesMock = mChatServiceMockGetChatMessages{mock: m}
+	m.GetChatMessagesMock.callArgs = []*ChatServiceMockGetChatMessagesParams{}
+
+	m.GetMessageStatusMock = mChatServiceMockGetMessageStatus{mock: m}
+	m.GetMessageStatusMock.callArgs = []*ChatServiceMockGetMessageStatusParams{}
+
+	m.GetPresenceMock = mChatServiceMockGetPresence{mock: m}
+	m.GetPresenceMock.callArgs = []*ChatServiceMockGetPresenceParams{}
+
+	m.GetThreadMock = mChatServiceMockGetThread{mock: m}
+	m.GetThreadMock.callArgs = []*ChatServiceMockGetThreadParams{}
+
 	m.GetUserChatsMock = mChatServiceMockGetUserChats{mock: m}
 	m.GetUserChatsMock.callArgs = []*ChatServiceMockGetUserChatsParams{}
 
+	m.LeaveChatMock = mChatServiceMockLeaveChat{mock: m}
+	m.LeaveChatMock.callArgs = []*ChatServiceMockLeaveChatParams{}
+
+	m.ListPinnedMessagesMock = mChatServiceMockListPinnedMessages{mock: m}
+	m.ListPinnedMessagesMock.callArgs = []*ChatServiceMockListPinnedMessagesParams{}
+
+	m.MarkReadMock = mChatServiceMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatServiceMockMarkReadParams{}
+
+	m.PinMessageMock = mChatServiceMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatServiceMockPinMessageParams{}
+
+	m.RemoveChatMemberMock = mChatServiceMockRemoveChatMember{mock: m}
+	m.RemoveChatMemberMock.callArgs = []*ChatServiceMockRemoveChatMemberParams{}
+
+	m.RemoveReactionMock = mChatServiceMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ChatServiceMockRemoveReactionParams{}
+
+	m.RenameChatMock = mChatServiceMockRenameChat{mock: m}
+	m.RenameChatMock.callArgs = []*ChatServiceMockRenameChatParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SetMemberRoleMock = mChatServiceMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatServiceMockSetMemberRoleParams{}
+
+	m.SetTypingMock = mChatServiceMockSetTyping{mock: m}
+	m.SetTypingMock.callArgs = []*ChatServiceMockSetTypingParams{}
+
+	m.TransferOwnershipMock = mChatServiceMockTransferOwnership{mock: m}
+	m.TransferOwnershipMock.callArgs = []*ChatServiceMockTransferOwnershipParams{}
+
+	m.UnpinMessageMock = mChatServiceMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*ChatServiceMockUnpinMessageParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatServiceMockConnectChat struct {
+type mChatServiceMockAddChatMembers struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockConnectChatExpectation
-	expectations       []*ChatServiceMockConnectChatExpectation
+	defaultExpectation *ChatServiceMockAddChatMembersExpectation
+	expectations       []*ChatServiceMockAddChatMembersExpectation
 
-	callArgs []*ChatServiceMockConnectChatParams
+	callArgs []*ChatServiceMockAddChatMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockConnectChatExpectation specifies expectation struct of the ChatService.ConnectChat
-type ChatServiceMockConnectChatExpectation struct {
+// ChatServiceMockAddChatMembersExpectation specifies expectation struct of the ChatService.AddChatMembers
+type ChatServiceMockAddChatMembersExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockConnectChatParams
-	paramPtrs          *ChatServiceMockConnectChatParamPtrs
-	expectationOrigins ChatServiceMockConnectChatExpectationOrigins
-	results            *ChatServiceMockConnectChatResults
+	params             *ChatServiceMockAddChatMembersParams
+	paramPtrs          *ChatServiceMockAddChatMembersParamPtrs
+	expectationOrigins ChatServiceMockAddChatMembersExpectationOrigins
+	results            *ChatServiceMockAddChatMembersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockConnectChatParams contains parameters of the ChatService.ConnectChat
-type ChatServiceMockConnectChatParams struct {
-	ctx      context.Context
-	chatID   int64
-	username string
-	stream   chat_v1.ChatV1_ConnectChatServer
+// ChatServiceMockAddChatMembersParams contains parameters of the ChatService.AddChatMembers
+type ChatServiceMockAddChatMembersParams struct {
+	ctx       context.Context
+	chatID    int64
+	actor     string
+	usernames []string
 }
 
-// ChatServiceMockConnectChatParamPtrs contains pointers to parameters of the ChatService.ConnectChat
-type ChatServiceMockConnectChatParamPtrs struct {
-	ctx      *context.Context
-	chatID   *int64
-	username *string
-	stream   *chat_v1.ChatV1_ConnectChatServer
+// ChatServiceMockAddChatMembersParamPtrs contains pointers to parameters of the ChatService.AddChatMembers
+type ChatServiceMockAddChatMembersParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	actor     *string
+	usernames *[]string
 }
 
-// ChatServiceMockConnectChatResults contains results of the ChatService.ConnectChat
-type ChatServiceMockConnectChatResults struct {
+// ChatServiceMockAddChatMembersResults contains results of the ChatService.AddChatMembers
+type ChatServiceMockAddChatMembersResults struct {
+	ep1 *emptypb.Empty
 	err error
 }
 
-// ChatServiceMockConnectChatOrigins contains origins of expectations of the ChatService.ConnectChat
-type ChatServiceMockConnectChatExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originChatID   string
-	originUsername string
-	originStream   string
+// ChatServiceMockAddChatMembersOrigins contains origins of expectations of the ChatService.AddChatMembers
+type ChatServiceMockAddChatMembersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originActor     string
+	originUsernames string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -144,398 +345,407 @@ type ChatServiceMockConnectChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmConnectChat *mChatServiceMockConnectChat) Optional() *mChatServiceMockConnectChat {
-	mmConnectChat.optional = true
-	return mmConnectChat
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Optional() *mChatServiceMockAddChatMembers {
+	mmAddChatMembers.optional = true
+	return mmAddChatMembers
 }
 
-// Expect sets up expected params for ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) Expect(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// Expect sets up expected params for ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Expect(ctx context.Context, chatID int64, actor string, usernames []string) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{}
 	}
 
-	if mmConnectChat.defaultExpectation.paramPtrs != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by ExpectParams functions")
+	if mmAddChatMembers.defaultExpectation.paramPtrs != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by ExpectParams functions")
 	}
 
-	mmConnectChat.defaultExpectation.params = &ChatServiceMockConnectChatParams{ctx, chatID, username, stream}
-	mmConnectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmConnectChat.expectations {
-		if minimock.Equal(e.params, mmConnectChat.defaultExpectation.params) {
-			mmConnectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChat.defaultExpectation.params)
+	mmAddChatMembers.defaultExpectation.params = &ChatServiceMockAddChatMembersParams{ctx, chatID, actor, usernames}
+	mmAddChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddChatMembers.expectations {
+		if minimock.Equal(e.params, mmAddChatMembers.defaultExpectation.params) {
+			mmAddChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddChatMembers.defaultExpectation.params)
 		}
 	}
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{}
 	}
 
-	if mmConnectChat.defaultExpectation.params != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmConnectChat.defaultExpectation.paramPtrs == nil {
-		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddChatMembersParamPtrs{}
 	}
-	mmConnectChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmConnectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// ExpectChatIDParam2 sets up expected param chatID for ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) ExpectChatIDParam2(chatID int64) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{}
 	}
 
-	if mmConnectChat.defaultExpectation.params != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmConnectChat.defaultExpectation.paramPtrs == nil {
-		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddChatMembersParamPtrs{}
 	}
-	mmConnectChat.defaultExpectation.paramPtrs.chatID = &chatID
-	mmConnectChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// ExpectUsernameParam3 sets up expected param username for ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) ExpectUsernameParam3(username string) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// ExpectActorParam3 sets up expected param actor for ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) ExpectActorParam3(actor string) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{}
 	}
 
-	if mmConnectChat.defaultExpectation.params != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmConnectChat.defaultExpectation.paramPtrs == nil {
-		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddChatMembersParamPtrs{}
 	}
-	mmConnectChat.defaultExpectation.paramPtrs.username = &username
-	mmConnectChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.actor = &actor
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// ExpectStreamParam4 sets up expected param stream for ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) ExpectStreamParam4(stream chat_v1.ChatV1_ConnectChatServer) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// ExpectUsernamesParam4 sets up expected param usernames for ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) ExpectUsernamesParam4(usernames []string) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{}
 	}
 
-	if mmConnectChat.defaultExpectation.params != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmConnectChat.defaultExpectation.paramPtrs == nil {
-		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddChatMembersParamPtrs{}
 	}
-	mmConnectChat.defaultExpectation.paramPtrs.stream = &stream
-	mmConnectChat.defaultExpectation.expectationOrigins.originStream = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.usernames = &usernames
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) Inspect(f func(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer)) *mChatServiceMockConnectChat {
-	if mmConnectChat.mock.inspectFuncConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChat")
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Inspect(f func(ctx context.Context, chatID int64, actor string, usernames []string)) *mChatServiceMockAddChatMembers {
+	if mmAddChatMembers.mock.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddChatMembers")
 	}
 
-	mmConnectChat.mock.inspectFuncConnectChat = f
+	mmAddChatMembers.mock.inspectFuncAddChatMembers = f
 
-	return mmConnectChat
+	return mmAddChatMembers
 }
 
-// Return sets up results that will be returned by ChatService.ConnectChat
-func (mmConnectChat *mChatServiceMockConnectChat) Return(err error) *ChatServiceMock {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+// Return sets up results that will be returned by ChatService.AddChatMembers
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmConnectChat.defaultExpectation == nil {
-		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{mock: mmConnectChat.mock}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatServiceMockAddChatMembersExpectation{mock: mmAddChatMembers.mock}
 	}
-	mmConnectChat.defaultExpectation.results = &ChatServiceMockConnectChatResults{err}
-	mmConnectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmConnectChat.mock
+	mmAddChatMembers.defaultExpectation.results = &ChatServiceMockAddChatMembersResults{ep1, err}
+	mmAddChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// Set uses given function f to mock the ChatService.ConnectChat method
-func (mmConnectChat *mChatServiceMockConnectChat) Set(f func(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer) (err error)) *ChatServiceMock {
-	if mmConnectChat.defaultExpectation != nil {
-		mmConnectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChat method")
+// Set uses given function f to mock the ChatService.AddChatMembers method
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Set(f func(ctx context.Context, chatID int64, actor string, usernames []string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmAddChatMembers.defaultExpectation != nil {
+		mmAddChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.AddChatMembers method")
 	}
 
-	if len(mmConnectChat.expectations) > 0 {
-		mmConnectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChat method")
+	if len(mmAddChatMembers.expectations) > 0 {
+		mmAddChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.AddChatMembers method")
 	}
 
-	mmConnectChat.mock.funcConnectChat = f
-	mmConnectChat.mock.funcConnectChatOrigin = minimock.CallerInfo(1)
-	return mmConnectChat.mock
+	mmAddChatMembers.mock.funcAddChatMembers = f
+	mmAddChatMembers.mock.funcAddChatMembersOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// When sets expectation for the ChatService.ConnectChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.AddChatMembers which will trigger the result defined by the following
 // Then helper
-func (mmConnectChat *mChatServiceMockConnectChat) When(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer) *ChatServiceMockConnectChatExpectation {
-	if mmConnectChat.mock.funcConnectChat != nil {
-		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) When(ctx context.Context, chatID int64, actor string, usernames []string) *ChatServiceMockAddChatMembersExpectation {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatServiceMock.AddChatMembers mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockConnectChatExpectation{
-		mock:               mmConnectChat.mock,
-		params:             &ChatServiceMockConnectChatParams{ctx, chatID, username, stream},
-		expectationOrigins: ChatServiceMockConnectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockAddChatMembersExpectation{
+		mock:               mmAddChatMembers.mock,
+		params:             &ChatServiceMockAddChatMembersParams{ctx, chatID, actor, usernames},
+		expectationOrigins: ChatServiceMockAddChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmConnectChat.expectations = append(mmConnectChat.expectations, expectation)
+	mmAddChatMembers.expectations = append(mmAddChatMembers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.ConnectChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockConnectChatExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockConnectChatResults{err}
+// Then sets up ChatService.AddChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddChatMembersExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddChatMembersResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.ConnectChat should be invoked
-func (mmConnectChat *mChatServiceMockConnectChat) Times(n uint64) *mChatServiceMockConnectChat {
+// Times sets number of times ChatService.AddChatMembers should be invoked
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Times(n uint64) *mChatServiceMockAddChatMembers {
 	if n == 0 {
-		mmConnectChat.mock.t.Fatalf("Times of ChatServiceMock.ConnectChat mock can not be zero")
+		mmAddChatMembers.mock.t.Fatalf("Times of ChatServiceMock.AddChatMembers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmConnectChat.expectedInvocations, n)
-	mmConnectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmConnectChat
+	mm_atomic.StoreUint64(&mmAddChatMembers.expectedInvocations, n)
+	mmAddChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers
 }
 
-func (mmConnectChat *mChatServiceMockConnectChat) invocationsDone() bool {
-	if len(mmConnectChat.expectations) == 0 && mmConnectChat.defaultExpectation == nil && mmConnectChat.mock.funcConnectChat == nil {
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) invocationsDone() bool {
+	if len(mmAddChatMembers.expectations) == 0 && mmAddChatMembers.defaultExpectation == nil && mmAddChatMembers.mock.funcAddChatMembers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmConnectChat.mock.afterConnectChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.mock.afterAddChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ConnectChat implements mm_service.ChatService
-func (mmConnectChat *ChatServiceMock) ConnectChat(ctx context.Context, chatID int64, username string, stream chat_v1.ChatV1_ConnectChatServer) (err error) {
-	mm_atomic.AddUint64(&mmConnectChat.beforeConnectChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmConnectChat.afterConnectChatCounter, 1)
+// AddChatMembers implements mm_service.ChatService
+func (mmAddChatMembers *ChatServiceMock) AddChatMembers(ctx context.Context, chatID int64, actor string, usernames []string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmAddChatMembers.beforeAddChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddChatMembers.afterAddChatMembersCounter, 1)
 
-	mmConnectChat.t.Helper()
+	mmAddChatMembers.t.Helper()
 
-	if mmConnectChat.inspectFuncConnectChat != nil {
-		mmConnectChat.inspectFuncConnectChat(ctx, chatID, username, stream)
+	if mmAddChatMembers.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.inspectFuncAddChatMembers(ctx, chatID, actor, usernames)
 	}
 
-	mm_params := ChatServiceMockConnectChatParams{ctx, chatID, username, stream}
+	mm_params := ChatServiceMockAddChatMembersParams{ctx, chatID, actor, usernames}
 
 	// Record call args
-	mmConnectChat.ConnectChatMock.mutex.Lock()
-	mmConnectChat.ConnectChatMock.callArgs = append(mmConnectChat.ConnectChatMock.callArgs, &mm_params)
-	mmConnectChat.ConnectChatMock.mutex.Unlock()
+	mmAddChatMembers.AddChatMembersMock.mutex.Lock()
+	mmAddChatMembers.AddChatMembersMock.callArgs = append(mmAddChatMembers.AddChatMembersMock.callArgs, &mm_params)
+	mmAddChatMembers.AddChatMembersMock.mutex.Unlock()
 
-	for _, e := range mmConnectChat.ConnectChatMock.expectations {
+	for _, e := range mmAddChatMembers.AddChatMembersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmConnectChat.ConnectChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmConnectChat.ConnectChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmConnectChat.ConnectChatMock.defaultExpectation.params
-		mm_want_ptrs := mmConnectChat.ConnectChatMock.defaultExpectation.paramPtrs
+	if mmAddChatMembers.AddChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddChatMembers.AddChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddChatMembers.AddChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddChatMembers.AddChatMembersMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockConnectChatParams{ctx, chatID, username, stream}
+		mm_got := ChatServiceMockAddChatMembersParams{ctx, chatID, actor, usernames}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddChatMembers.t.Errorf("ChatServiceMock.AddChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
-				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+				mmAddChatMembers.t.Errorf("ChatServiceMock.AddChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmAddChatMembers.t.Errorf("ChatServiceMock.AddChatMembers got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
 			}
 
-			if mm_want_ptrs.stream != nil && !minimock.Equal(*mm_want_ptrs.stream, mm_got.stream) {
-				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter stream, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originStream, *mm_want_ptrs.stream, mm_got.stream, minimock.Diff(*mm_want_ptrs.stream, mm_got.stream))
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmAddChatMembers.t.Errorf("ChatServiceMock.AddChatMembers got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddChatMembers.t.Errorf("ChatServiceMock.AddChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmConnectChat.ConnectChatMock.defaultExpectation.results
+		mm_results := mmAddChatMembers.AddChatMembersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmConnectChat.t.Fatal("No results are set for the ChatServiceMock.ConnectChat")
+			mmAddChatMembers.t.Fatal("No results are set for the ChatServiceMock.AddChatMembers")
 		}
-		return (*mm_results).err
+		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmConnectChat.funcConnectChat != nil {
-		return mmConnectChat.funcConnectChat(ctx, chatID, username, stream)
+	if mmAddChatMembers.funcAddChatMembers != nil {
+		return mmAddChatMembers.funcAddChatMembers(ctx, chatID, actor, usernames)
 	}
-	mmConnectChat.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChat. %v %v %v %v", ctx, chatID, username, stream)
+	mmAddChatMembers.t.Fatalf("Unexpected call to ChatServiceMock.AddChatMembers. %v %v %v %v", ctx, chatID, actor, usernames)
 	return
 }
 
-// ConnectChatAfterCounter returns a count of finished ChatServiceMock.ConnectChat invocations
-func (mmConnectChat *ChatServiceMock) ConnectChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmConnectChat.afterConnectChatCounter)
+// AddChatMembersAfterCounter returns a count of finished ChatServiceMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatServiceMock) AddChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.afterAddChatMembersCounter)
 }
 
-// ConnectChatBeforeCounter returns a count of ChatServiceMock.ConnectChat invocations
-func (mmConnectChat *ChatServiceMock) ConnectChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmConnectChat.beforeConnectChatCounter)
+// AddChatMembersBeforeCounter returns a count of ChatServiceMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatServiceMock) AddChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.beforeAddChatMembersCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddChatMembers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmConnectChat *mChatServiceMockConnectChat) Calls() []*ChatServiceMockConnectChatParams {
-	mmConnectChat.mutex.RLock()
+func (mmAddChatMembers *mChatServiceMockAddChatMembers) Calls() []*ChatServiceMockAddChatMembersParams {
+	mmAddChatMembers.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockConnectChatParams, len(mmConnectChat.callArgs))
-	copy(argCopy, mmConnectChat.callArgs)
+	argCopy := make([]*ChatServiceMockAddChatMembersParams, len(mmAddChatMembers.callArgs))
+	copy(argCopy, mmAddChatMembers.callArgs)
 
-	mmConnectChat.mutex.RUnlock()
+	mmAddChatMembers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockConnectChatDone returns true if the count of the ConnectChat invocations corresponds
+// MinimockAddChatMembersDone returns true if the count of the AddChatMembers invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockConnectChatDone() bool {
-	if m.ConnectChatMock.optional {
+func (m *ChatServiceMock) MinimockAddChatMembersDone() bool {
+	if m.AddChatMembersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ConnectChatMock.expectations {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ConnectChatMock.invocationsDone()
+	return m.AddChatMembersMock.invocationsDone()
 }
 
-// MinimockConnectChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockConnectChatInspect() {
-	for _, e := range m.ConnectChatMock.expectations {
+// MinimockAddChatMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddChatMembersInspect() {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterConnectChatCounter := mm_atomic.LoadUint64(&m.afterConnectChatCounter)
+	afterAddChatMembersCounter := mm_atomic.LoadUint64(&m.afterAddChatMembersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ConnectChatMock.defaultExpectation != nil && afterConnectChatCounter < 1 {
-		if m.ConnectChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.ConnectChatMock.defaultExpectation.returnOrigin)
+	if m.AddChatMembersMock.defaultExpectation != nil && afterAddChatMembersCounter < 1 {
+		if m.AddChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddChatMembers at\n%s", m.AddChatMembersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", m.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *m.ConnectChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddChatMembers at\n%s with params: %#v", m.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddChatMembersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcConnectChat != nil && afterConnectChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.funcConnectChatOrigin)
+	if m.funcAddChatMembers != nil && afterAddChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddChatMembers at\n%s", m.funcAddChatMembersOrigin)
 	}
 
-	if !m.ConnectChatMock.invocationsDone() && afterConnectChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ConnectChatMock.expectedInvocations), m.ConnectChatMock.expectedInvocationsOrigin, afterConnectChatCounter)
+	if !m.AddChatMembersMock.invocationsDone() && afterAddChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddChatMembersMock.expectedInvocations), m.AddChatMembersMock.expectedInvocationsOrigin, afterAddChatMembersCounter)
 	}
 }
 
-type mChatServiceMockCreateChat struct {
+type mChatServiceMockAddReaction struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockCreateChatExpectation
-	expectations       []*ChatServiceMockCreateChatExpectation
+	defaultExpectation *ChatServiceMockAddReactionExpectation
+	expectations       []*ChatServiceMockAddReactionExpectation
 
-	callArgs []*ChatServiceMockCreateChatParams
+	callArgs []*ChatServiceMockAddReactionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
-type ChatServiceMockCreateChatExpectation struct {
+// ChatServiceMockAddReactionExpectation specifies expectation struct of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockCreateChatParams
-	paramPtrs          *ChatServiceMockCreateChatParamPtrs
-	expectationOrigins ChatServiceMockCreateChatExpectationOrigins
-	results            *ChatServiceMockCreateChatResults
+	params             *ChatServiceMockAddReactionParams
+	paramPtrs          *ChatServiceMockAddReactionParamPtrs
+	expectationOrigins ChatServiceMockAddReactionExpectationOrigins
+	results            *ChatServiceMockAddReactionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParams struct {
-	ctx  context.Context
-	chat *model.Chat
+// ChatServiceMockAddReactionParams contains parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+	emoji     string
 }
 
-// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParamPtrs struct {
-	ctx  *context.Context
-	chat **model.Chat
+// ChatServiceMockAddReactionParamPtrs contains pointers to parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+	emoji     *string
 }
 
-// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
-type ChatServiceMockCreateChatResults struct {
-	i1  int64
+// ChatServiceMockAddReactionResults contains results of the ChatService.AddReaction
+type ChatServiceMockAddReactionResults struct {
+	ep1 *emptypb.Empty
 	err error
 }
 
-// ChatServiceMockCreateChatOrigins contains origins of expectations of the ChatService.CreateChat
-type ChatServiceMockCreateChatExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatServiceMockAddReactionOrigins contains origins of expectations of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+	originEmoji     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -543,342 +753,8220 @@ type ChatServiceMockCreateChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
-	mmCreateChat.optional = true
-	return mmCreateChat
+func (mmAddReaction *mChatServiceMockAddReaction) Optional() *mChatServiceMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
 }
 
-// Expect sets up expected params for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, chat *model.Chat) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
+// Expect sets up expected params for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Expect(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &ChatServiceMockAddReactionParams{ctx, chatID, messageID, actor, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectChatIDParam2(chatID int64) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddReaction.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectMessageIDParam3(messageID int64) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectActorParam4(actor string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.actor = &actor
+	mmAddReaction.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectEmojiParam5 sets up expected param emoji for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectEmojiParam5(emoji string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string)) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &ChatServiceMockAddReactionResults{ep1, err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.AddReaction method
+func (mmAddReaction *mChatServiceMockAddReaction) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the ChatService.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mChatServiceMockAddReaction) When(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) *ChatServiceMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &ChatServiceMockAddReactionParams{ctx, chatID, messageID, actor, emoji},
+		expectationOrigins: ChatServiceMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddReactionExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddReactionResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AddReaction should be invoked
+func (mmAddReaction *mChatServiceMockAddReaction) Times(n uint64) *mChatServiceMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of ChatServiceMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mChatServiceMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements mm_service.ChatService
+func (mmAddReaction *ChatServiceMock) AddReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, chatID, messageID, actor, emoji)
+	}
+
+	mm_params := ChatServiceMockAddReactionParams{ctx, chatID, messageID, actor, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAddReactionParams{ctx, chatID, messageID, actor, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the ChatServiceMock.AddReaction")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, chatID, messageID, actor, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to ChatServiceMock.AddReaction. %v %v %v %v %v", ctx, chatID, messageID, actor, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mChatServiceMockAddReaction) Calls() []*ChatServiceMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mChatServiceMockConnectChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockConnectChatExpectation
+	expectations       []*ChatServiceMockConnectChatExpectation
+
+	callArgs []*ChatServiceMockConnectChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockConnectChatExpectation specifies expectation struct of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockConnectChatParams
+	paramPtrs          *ChatServiceMockConnectChatParamPtrs
+	expectationOrigins ChatServiceMockConnectChatExpectationOrigins
+	results            *ChatServiceMockConnectChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockConnectChatParams contains parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParams struct {
+	ctx            context.Context
+	chatID         int64
+	username       string
+	sinceMessageID int64
+	stream         chat_v1.ChatV1_ConnectChatServer
+}
+
+// ChatServiceMockConnectChatParamPtrs contains pointers to parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParamPtrs struct {
+	ctx            *context.Context
+	chatID         *int64
+	username       *string
+	sinceMessageID *int64
+	stream         *chat_v1.ChatV1_ConnectChatServer
+}
+
+// ChatServiceMockConnectChatResults contains results of the ChatService.ConnectChat
+type ChatServiceMockConnectChatResults struct {
+	err error
+}
+
+// ChatServiceMockConnectChatOrigins contains origins of expectations of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originChatID         string
+	originUsername       string
+	originSinceMessageID string
+	originStream         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConnectChat *mChatServiceMockConnectChat) Optional() *mChatServiceMockConnectChat {
+	mmConnectChat.optional = true
+	return mmConnectChat
+}
+
+// Expect sets up expected params for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Expect(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by ExpectParams functions")
+	}
+
+	mmConnectChat.defaultExpectation.params = &ChatServiceMockConnectChatParams{ctx, chatID, username, sinceMessageID, stream}
+	mmConnectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConnectChat.expectations {
+		if minimock.Equal(e.params, mmConnectChat.defaultExpectation.params) {
+			mmConnectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChat.defaultExpectation.params)
+		}
+	}
+
+	return mmConnectChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConnectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmConnectChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectUsernameParam3(username string) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.username = &username
+	mmConnectChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectSinceMessageIDParam4 sets up expected param sinceMessageID for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectSinceMessageIDParam4(sinceMessageID int64) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.sinceMessageID = &sinceMessageID
+	mmConnectChat.defaultExpectation.expectationOrigins.originSinceMessageID = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectStreamParam5 sets up expected param stream for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectStreamParam5(stream chat_v1.ChatV1_ConnectChatServer) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.stream = &stream
+	mmConnectChat.defaultExpectation.expectationOrigins.originStream = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Inspect(f func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer)) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.inspectFuncConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChat")
+	}
+
+	mmConnectChat.mock.inspectFuncConnectChat = f
+
+	return mmConnectChat
+}
+
+// Return sets up results that will be returned by ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Return(err error) *ChatServiceMock {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{mock: mmConnectChat.mock}
+	}
+	mmConnectChat.defaultExpectation.results = &ChatServiceMockConnectChatResults{err}
+	mmConnectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ConnectChat method
+func (mmConnectChat *mChatServiceMockConnectChat) Set(f func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer) (err error)) *ChatServiceMock {
+	if mmConnectChat.defaultExpectation != nil {
+		mmConnectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChat method")
+	}
+
+	if len(mmConnectChat.expectations) > 0 {
+		mmConnectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChat method")
+	}
+
+	mmConnectChat.mock.funcConnectChat = f
+	mmConnectChat.mock.funcConnectChatOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// When sets expectation for the ChatService.ConnectChat which will trigger the result defined by the following
+// Then helper
+func (mmConnectChat *mChatServiceMockConnectChat) When(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer) *ChatServiceMockConnectChatExpectation {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockConnectChatExpectation{
+		mock:               mmConnectChat.mock,
+		params:             &ChatServiceMockConnectChatParams{ctx, chatID, username, sinceMessageID, stream},
+		expectationOrigins: ChatServiceMockConnectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConnectChat.expectations = append(mmConnectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ConnectChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockConnectChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockConnectChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ConnectChat should be invoked
+func (mmConnectChat *mChatServiceMockConnectChat) Times(n uint64) *mChatServiceMockConnectChat {
+	if n == 0 {
+		mmConnectChat.mock.t.Fatalf("Times of ChatServiceMock.ConnectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnectChat.expectedInvocations, n)
+	mmConnectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConnectChat
+}
+
+func (mmConnectChat *mChatServiceMockConnectChat) invocationsDone() bool {
+	if len(mmConnectChat.expectations) == 0 && mmConnectChat.defaultExpectation == nil && mmConnectChat.mock.funcConnectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnectChat.mock.afterConnectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConnectChat implements mm_service.ChatService
+func (mmConnectChat *ChatServiceMock) ConnectChat(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatServer) (err error) {
+	mm_atomic.AddUint64(&mmConnectChat.beforeConnectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnectChat.afterConnectChatCounter, 1)
+
+	mmConnectChat.t.Helper()
+
+	if mmConnectChat.inspectFuncConnectChat != nil {
+		mmConnectChat.inspectFuncConnectChat(ctx, chatID, username, sinceMessageID, stream)
+	}
+
+	mm_params := ChatServiceMockConnectChatParams{ctx, chatID, username, sinceMessageID, stream}
+
+	// Record call args
+	mmConnectChat.ConnectChatMock.mutex.Lock()
+	mmConnectChat.ConnectChatMock.callArgs = append(mmConnectChat.ConnectChatMock.callArgs, &mm_params)
+	mmConnectChat.ConnectChatMock.mutex.Unlock()
+
+	for _, e := range mmConnectChat.ConnectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConnectChat.ConnectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnectChat.ConnectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnectChat.ConnectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmConnectChat.ConnectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockConnectChatParams{ctx, chatID, username, sinceMessageID, stream}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.sinceMessageID != nil && !minimock.Equal(*mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter sinceMessageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originSinceMessageID, *mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID, minimock.Diff(*mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID))
+			}
+
+			if mm_want_ptrs.stream != nil && !minimock.Equal(*mm_want_ptrs.stream, mm_got.stream) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter stream, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originStream, *mm_want_ptrs.stream, mm_got.stream, minimock.Diff(*mm_want_ptrs.stream, mm_got.stream))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnectChat.ConnectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnectChat.t.Fatal("No results are set for the ChatServiceMock.ConnectChat")
+		}
+		return (*mm_results).err
+	}
+	if mmConnectChat.funcConnectChat != nil {
+		return mmConnectChat.funcConnectChat(ctx, chatID, username, sinceMessageID, stream)
+	}
+	mmConnectChat.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChat. %v %v %v %v %v", ctx, chatID, username, sinceMessageID, stream)
+	return
+}
+
+// ConnectChatAfterCounter returns a count of finished ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.afterConnectChatCounter)
+}
+
+// ConnectChatBeforeCounter returns a count of ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.beforeConnectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnectChat *mChatServiceMockConnectChat) Calls() []*ChatServiceMockConnectChatParams {
+	mmConnectChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockConnectChatParams, len(mmConnectChat.callArgs))
+	copy(argCopy, mmConnectChat.callArgs)
+
+	mmConnectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectChatDone returns true if the count of the ConnectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockConnectChatDone() bool {
+	if m.ConnectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectChatMock.invocationsDone()
+}
+
+// MinimockConnectChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockConnectChatInspect() {
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConnectChatCounter := mm_atomic.LoadUint64(&m.afterConnectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectChatMock.defaultExpectation != nil && afterConnectChatCounter < 1 {
+		if m.ConnectChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.ConnectChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", m.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *m.ConnectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnectChat != nil && afterConnectChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.funcConnectChatOrigin)
+	}
+
+	if !m.ConnectChatMock.invocationsDone() && afterConnectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectChatMock.expectedInvocations), m.ConnectChatMock.expectedInvocationsOrigin, afterConnectChatCounter)
+	}
+}
+
+type mChatServiceMockConnectChatEvents struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockConnectChatEventsExpectation
+	expectations       []*ChatServiceMockConnectChatEventsExpectation
+
+	callArgs []*ChatServiceMockConnectChatEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockConnectChatEventsExpectation specifies expectation struct of the ChatService.ConnectChatEvents
+type ChatServiceMockConnectChatEventsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockConnectChatEventsParams
+	paramPtrs          *ChatServiceMockConnectChatEventsParamPtrs
+	expectationOrigins ChatServiceMockConnectChatEventsExpectationOrigins
+	results            *ChatServiceMockConnectChatEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockConnectChatEventsParams contains parameters of the ChatService.ConnectChatEvents
+type ChatServiceMockConnectChatEventsParams struct {
+	ctx            context.Context
+	chatID         int64
+	username       string
+	sinceMessageID int64
+	stream         chat_v1.ChatV1_ConnectChatEventsServer
+}
+
+// ChatServiceMockConnectChatEventsParamPtrs contains pointers to parameters of the ChatService.ConnectChatEvents
+type ChatServiceMockConnectChatEventsParamPtrs struct {
+	ctx            *context.Context
+	chatID         *int64
+	username       *string
+	sinceMessageID *int64
+	stream         *chat_v1.ChatV1_ConnectChatEventsServer
+}
+
+// ChatServiceMockConnectChatEventsResults contains results of the ChatService.ConnectChatEvents
+type ChatServiceMockConnectChatEventsResults struct {
+	err error
+}
+
+// ChatServiceMockConnectChatEventsOrigins contains origins of expectations of the ChatService.ConnectChatEvents
+type ChatServiceMockConnectChatEventsExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originChatID         string
+	originUsername       string
+	originSinceMessageID string
+	originStream         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Optional() *mChatServiceMockConnectChatEvents {
+	mmConnectChatEvents.optional = true
+	return mmConnectChatEvents
+}
+
+// Expect sets up expected params for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Expect(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by ExpectParams functions")
+	}
+
+	mmConnectChatEvents.defaultExpectation.params = &ChatServiceMockConnectChatEventsParams{ctx, chatID, username, sinceMessageID, stream}
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConnectChatEvents.expectations {
+		if minimock.Equal(e.params, mmConnectChatEvents.defaultExpectation.params) {
+			mmConnectChatEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChatEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmConnectChatEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.params != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Expect")
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs == nil {
+		mmConnectChatEvents.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatEventsParamPtrs{}
+	}
+	mmConnectChatEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConnectChatEvents
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) ExpectChatIDParam2(chatID int64) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.params != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Expect")
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs == nil {
+		mmConnectChatEvents.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatEventsParamPtrs{}
+	}
+	mmConnectChatEvents.defaultExpectation.paramPtrs.chatID = &chatID
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmConnectChatEvents
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) ExpectUsernameParam3(username string) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.params != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Expect")
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs == nil {
+		mmConnectChatEvents.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatEventsParamPtrs{}
+	}
+	mmConnectChatEvents.defaultExpectation.paramPtrs.username = &username
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmConnectChatEvents
+}
+
+// ExpectSinceMessageIDParam4 sets up expected param sinceMessageID for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) ExpectSinceMessageIDParam4(sinceMessageID int64) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.params != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Expect")
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs == nil {
+		mmConnectChatEvents.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatEventsParamPtrs{}
+	}
+	mmConnectChatEvents.defaultExpectation.paramPtrs.sinceMessageID = &sinceMessageID
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.originSinceMessageID = minimock.CallerInfo(1)
+
+	return mmConnectChatEvents
+}
+
+// ExpectStreamParam5 sets up expected param stream for ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) ExpectStreamParam5(stream chat_v1.ChatV1_ConnectChatEventsServer) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{}
+	}
+
+	if mmConnectChatEvents.defaultExpectation.params != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Expect")
+	}
+
+	if mmConnectChatEvents.defaultExpectation.paramPtrs == nil {
+		mmConnectChatEvents.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatEventsParamPtrs{}
+	}
+	mmConnectChatEvents.defaultExpectation.paramPtrs.stream = &stream
+	mmConnectChatEvents.defaultExpectation.expectationOrigins.originStream = minimock.CallerInfo(1)
+
+	return mmConnectChatEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Inspect(f func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer)) *mChatServiceMockConnectChatEvents {
+	if mmConnectChatEvents.mock.inspectFuncConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChatEvents")
+	}
+
+	mmConnectChatEvents.mock.inspectFuncConnectChatEvents = f
+
+	return mmConnectChatEvents
+}
+
+// Return sets up results that will be returned by ChatService.ConnectChatEvents
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Return(err error) *ChatServiceMock {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	if mmConnectChatEvents.defaultExpectation == nil {
+		mmConnectChatEvents.defaultExpectation = &ChatServiceMockConnectChatEventsExpectation{mock: mmConnectChatEvents.mock}
+	}
+	mmConnectChatEvents.defaultExpectation.results = &ChatServiceMockConnectChatEventsResults{err}
+	mmConnectChatEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConnectChatEvents.mock
+}
+
+// Set uses given function f to mock the ChatService.ConnectChatEvents method
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Set(f func(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer) (err error)) *ChatServiceMock {
+	if mmConnectChatEvents.defaultExpectation != nil {
+		mmConnectChatEvents.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChatEvents method")
+	}
+
+	if len(mmConnectChatEvents.expectations) > 0 {
+		mmConnectChatEvents.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChatEvents method")
+	}
+
+	mmConnectChatEvents.mock.funcConnectChatEvents = f
+	mmConnectChatEvents.mock.funcConnectChatEventsOrigin = minimock.CallerInfo(1)
+	return mmConnectChatEvents.mock
+}
+
+// When sets expectation for the ChatService.ConnectChatEvents which will trigger the result defined by the following
+// Then helper
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) When(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer) *ChatServiceMockConnectChatEventsExpectation {
+	if mmConnectChatEvents.mock.funcConnectChatEvents != nil {
+		mmConnectChatEvents.mock.t.Fatalf("ChatServiceMock.ConnectChatEvents mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockConnectChatEventsExpectation{
+		mock:               mmConnectChatEvents.mock,
+		params:             &ChatServiceMockConnectChatEventsParams{ctx, chatID, username, sinceMessageID, stream},
+		expectationOrigins: ChatServiceMockConnectChatEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConnectChatEvents.expectations = append(mmConnectChatEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ConnectChatEvents return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockConnectChatEventsExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockConnectChatEventsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ConnectChatEvents should be invoked
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Times(n uint64) *mChatServiceMockConnectChatEvents {
+	if n == 0 {
+		mmConnectChatEvents.mock.t.Fatalf("Times of ChatServiceMock.ConnectChatEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnectChatEvents.expectedInvocations, n)
+	mmConnectChatEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConnectChatEvents
+}
+
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) invocationsDone() bool {
+	if len(mmConnectChatEvents.expectations) == 0 && mmConnectChatEvents.defaultExpectation == nil && mmConnectChatEvents.mock.funcConnectChatEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnectChatEvents.mock.afterConnectChatEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChatEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConnectChatEvents implements mm_service.ChatService
+func (mmConnectChatEvents *ChatServiceMock) ConnectChatEvents(ctx context.Context, chatID int64, username string, sinceMessageID int64, stream chat_v1.ChatV1_ConnectChatEventsServer) (err error) {
+	mm_atomic.AddUint64(&mmConnectChatEvents.beforeConnectChatEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnectChatEvents.afterConnectChatEventsCounter, 1)
+
+	mmConnectChatEvents.t.Helper()
+
+	if mmConnectChatEvents.inspectFuncConnectChatEvents != nil {
+		mmConnectChatEvents.inspectFuncConnectChatEvents(ctx, chatID, username, sinceMessageID, stream)
+	}
+
+	mm_params := ChatServiceMockConnectChatEventsParams{ctx, chatID, username, sinceMessageID, stream}
+
+	// Record call args
+	mmConnectChatEvents.ConnectChatEventsMock.mutex.Lock()
+	mmConnectChatEvents.ConnectChatEventsMock.callArgs = append(mmConnectChatEvents.ConnectChatEventsMock.callArgs, &mm_params)
+	mmConnectChatEvents.ConnectChatEventsMock.mutex.Unlock()
+
+	for _, e := range mmConnectChatEvents.ConnectChatEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockConnectChatEventsParams{ctx, chatID, username, sinceMessageID, stream}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.sinceMessageID != nil && !minimock.Equal(*mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID) {
+				mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameter sinceMessageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.originSinceMessageID, *mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID, minimock.Diff(*mm_want_ptrs.sinceMessageID, mm_got.sinceMessageID))
+			}
+
+			if mm_want_ptrs.stream != nil && !minimock.Equal(*mm_want_ptrs.stream, mm_got.stream) {
+				mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameter stream, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.originStream, *mm_want_ptrs.stream, mm_got.stream, minimock.Diff(*mm_want_ptrs.stream, mm_got.stream))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnectChatEvents.t.Errorf("ChatServiceMock.ConnectChatEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnectChatEvents.ConnectChatEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnectChatEvents.t.Fatal("No results are set for the ChatServiceMock.ConnectChatEvents")
+		}
+		return (*mm_results).err
+	}
+	if mmConnectChatEvents.funcConnectChatEvents != nil {
+		return mmConnectChatEvents.funcConnectChatEvents(ctx, chatID, username, sinceMessageID, stream)
+	}
+	mmConnectChatEvents.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChatEvents. %v %v %v %v %v", ctx, chatID, username, sinceMessageID, stream)
+	return
+}
+
+// ConnectChatEventsAfterCounter returns a count of finished ChatServiceMock.ConnectChatEvents invocations
+func (mmConnectChatEvents *ChatServiceMock) ConnectChatEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChatEvents.afterConnectChatEventsCounter)
+}
+
+// ConnectChatEventsBeforeCounter returns a count of ChatServiceMock.ConnectChatEvents invocations
+func (mmConnectChatEvents *ChatServiceMock) ConnectChatEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChatEvents.beforeConnectChatEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChatEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnectChatEvents *mChatServiceMockConnectChatEvents) Calls() []*ChatServiceMockConnectChatEventsParams {
+	mmConnectChatEvents.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockConnectChatEventsParams, len(mmConnectChatEvents.callArgs))
+	copy(argCopy, mmConnectChatEvents.callArgs)
+
+	mmConnectChatEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectChatEventsDone returns true if the count of the ConnectChatEvents invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockConnectChatEventsDone() bool {
+	if m.ConnectChatEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectChatEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectChatEventsMock.invocationsDone()
+}
+
+// MinimockConnectChatEventsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockConnectChatEventsInspect() {
+	for _, e := range m.ConnectChatEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChatEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConnectChatEventsCounter := mm_atomic.LoadUint64(&m.afterConnectChatEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectChatEventsMock.defaultExpectation != nil && afterConnectChatEventsCounter < 1 {
+		if m.ConnectChatEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChatEvents at\n%s", m.ConnectChatEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChatEvents at\n%s with params: %#v", m.ConnectChatEventsMock.defaultExpectation.expectationOrigins.origin, *m.ConnectChatEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnectChatEvents != nil && afterConnectChatEventsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ConnectChatEvents at\n%s", m.funcConnectChatEventsOrigin)
+	}
+
+	if !m.ConnectChatEventsMock.invocationsDone() && afterConnectChatEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChatEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectChatEventsMock.expectedInvocations), m.ConnectChatEventsMock.expectedInvocationsOrigin, afterConnectChatEventsCounter)
+	}
+}
+
+type mChatServiceMockCreateChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateChatExpectation
+	expectations       []*ChatServiceMockCreateChatExpectation
+
+	callArgs []*ChatServiceMockCreateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
+type ChatServiceMockCreateChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCreateChatParams
+	paramPtrs          *ChatServiceMockCreateChatParamPtrs
+	expectationOrigins ChatServiceMockCreateChatExpectationOrigins
+	results            *ChatServiceMockCreateChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParams struct {
+	ctx  context.Context
+	chat *model.Chat
+}
+
+// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.Chat
+}
+
+// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
+type ChatServiceMockCreateChatResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockCreateChatOrigins contains origins of expectations of the ChatService.CreateChat
+type ChatServiceMockCreateChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
+	mmCreateChat.optional = true
+	return mmCreateChat
+}
+
+// Expect sets up expected params for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, chat *model.Chat) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	}
+
+	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, chat}
+	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateChat.expectations {
+		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
+			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectChatParam2(chat *model.Chat) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
+	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, chat *model.Chat)) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.inspectFuncCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+	}
+
+	mmCreateChat.mock.inspectFuncCreateChat = f
+
+	return mmCreateChat
+}
+
+// Return sets up results that will be returned by ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	}
+	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
+	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// Set uses given function f to mock the ChatService.CreateChat method
+func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, chat *model.Chat) (i1 int64, err error)) *ChatServiceMock {
+	if mmCreateChat.defaultExpectation != nil {
+		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+	}
+
+	if len(mmCreateChat.expectations) > 0 {
+		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	}
+
+	mmCreateChat.mock.funcCreateChat = f
+	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// Then helper
+func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, chat *model.Chat) *ChatServiceMockCreateChatExpectation {
+	if mmCreateChat.mock.funcCreateChat != nil {
 		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	expectation := &ChatServiceMockCreateChatExpectation{
+		mock:               mmCreateChat.mock,
+		params:             &ChatServiceMockCreateChatParams{ctx, chat},
+		expectationOrigins: ChatServiceMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CreateChat should be invoked
+func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+	if n == 0 {
+		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
+	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateChat
+}
+
+func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
+	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateChat implements mm_service.ChatService
+func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, chat *model.Chat) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+
+	mmCreateChat.t.Helper()
+
+	if mmCreateChat.inspectFuncCreateChat != nil {
+		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	}
+
+	mm_params := ChatServiceMockCreateChatParams{ctx, chat}
+
+	// Record call args
+	mmCreateChat.CreateChatMock.mutex.Lock()
+	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
+	mmCreateChat.CreateChatMock.mutex.Unlock()
+
+	for _, e := range mmCreateChat.CreateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateChatParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateChat.funcCreateChat != nil {
+		return mmCreateChat.funcCreateChat(ctx, chat)
+	}
+	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, chat)
+	return
+}
+
+// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+}
+
+// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
+	mmCreateChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
+	copy(argCopy, mmCreateChat.callArgs)
+
+	mmCreateChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateChatDone() bool {
+	if m.CreateChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateChatMock.invocationsDone()
+}
+
+// MinimockCreateChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateChatInspect() {
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
+		if m.CreateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	}
+
+	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	}
+}
+
+type mChatServiceMockDeleteChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteChatExpectation
+	expectations       []*ChatServiceMockDeleteChatExpectation
+
+	callArgs []*ChatServiceMockDeleteChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteChatParams
+	paramPtrs          *ChatServiceMockDeleteChatParamPtrs
+	expectationOrigins ChatServiceMockDeleteChatExpectationOrigins
+	results            *ChatServiceMockDeleteChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParams struct {
+	ctx    context.Context
+	chatID int64
+	actor  string
+}
+
+// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	actor  *string
+}
+
+// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockDeleteChatOrigins contains origins of expectations of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originActor  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
+	mmDeleteChat.optional = true
+	return mmDeleteChat
+}
+
+// Expect sets up expected params for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, chatID int64, actor string) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, chatID, actor}
+	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteChat.expectations {
+		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
+			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmDeleteChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectActorParam3(actor string) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.actor = &actor
+	mmDeleteChat.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, chatID int64, actor string)) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+	}
+
+	mmDeleteChat.mock.inspectFuncDeleteChat = f
+
+	return mmDeleteChat
+}
+
+// Return sets up results that will be returned by ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	}
+	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{ep1, err}
+	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteChat method
+func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, chatID int64, actor string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmDeleteChat.defaultExpectation != nil {
+		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+	}
+
+	if len(mmDeleteChat.expectations) > 0 {
+		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	}
+
+	mmDeleteChat.mock.funcDeleteChat = f
+	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, chatID int64, actor string) *ChatServiceMockDeleteChatExpectation {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteChatExpectation{
+		mock:               mmDeleteChat.mock,
+		params:             &ChatServiceMockDeleteChatParams{ctx, chatID, actor},
+		expectationOrigins: ChatServiceMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteChatResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteChat should be invoked
+func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+	if n == 0 {
+		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
+	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat
+}
+
+func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
+	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChat implements mm_service.ChatService
+func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, chatID int64, actor string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+
+	mmDeleteChat.t.Helper()
+
+	if mmDeleteChat.inspectFuncDeleteChat != nil {
+		mmDeleteChat.inspectFuncDeleteChat(ctx, chatID, actor)
+	}
+
+	mm_params := ChatServiceMockDeleteChatParams{ctx, chatID, actor}
+
+	// Record call args
+	mmDeleteChat.DeleteChatMock.mutex.Lock()
+	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
+	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteChatParams{ctx, chatID, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmDeleteChat.funcDeleteChat != nil {
+		return mmDeleteChat.funcDeleteChat(ctx, chatID, actor)
+	}
+	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v %v", ctx, chatID, actor)
+	return
+}
+
+// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+}
+
+// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
+	mmDeleteChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
+	copy(argCopy, mmDeleteChat.callArgs)
+
+	mmDeleteChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
+	if m.DeleteChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatMock.invocationsDone()
+}
+
+// MinimockDeleteChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteChatInspect() {
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
+		if m.DeleteChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	}
+
+	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	}
+}
+
+type mChatServiceMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteMessageExpectation
+	expectations       []*ChatServiceMockDeleteMessageExpectation
+
+	callArgs []*ChatServiceMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteMessageExpectation specifies expectation struct of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteMessageParams
+	paramPtrs          *ChatServiceMockDeleteMessageParamPtrs
+	expectationOrigins ChatServiceMockDeleteMessageExpectationOrigins
+	results            *ChatServiceMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteMessageParams contains parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+}
+
+// ChatServiceMockDeleteMessageParamPtrs contains pointers to parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+}
+
+// ChatServiceMockDeleteMessageResults contains results of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockDeleteMessageOrigins contains origins of expectations of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Optional() *mChatServiceMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Expect(ctx context.Context, chatID int64, messageID int64, actor string) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatServiceMockDeleteMessageParams{ctx, chatID, messageID, actor}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectActorParam4(actor string) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string)) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatServiceMockDeleteMessageResults{ep1, err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteMessage method
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatService.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) When(ctx context.Context, chatID int64, messageID int64, actor string) *ChatServiceMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatServiceMockDeleteMessageParams{ctx, chatID, messageID, actor},
+		expectationOrigins: ChatServiceMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteMessageResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Times(n uint64) *mChatServiceMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatServiceMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_service.ChatService
+func (mmDeleteMessage *ChatServiceMock) DeleteMessage(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, chatID, messageID, actor)
+	}
+
+	mm_params := ChatServiceMockDeleteMessageParams{ctx, chatID, messageID, actor}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteMessageParams{ctx, chatID, messageID, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatServiceMock.DeleteMessage")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, chatID, messageID, actor)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeleteMessage. %v %v %v %v", ctx, chatID, messageID, actor)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Calls() []*ChatServiceMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatServiceMockEditMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockEditMessageExpectation
+	expectations       []*ChatServiceMockEditMessageExpectation
+
+	callArgs []*ChatServiceMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockEditMessageExpectation specifies expectation struct of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockEditMessageParams
+	paramPtrs          *ChatServiceMockEditMessageParamPtrs
+	expectationOrigins ChatServiceMockEditMessageExpectationOrigins
+	results            *ChatServiceMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockEditMessageParams contains parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+	text      string
+}
+
+// ChatServiceMockEditMessageParamPtrs contains pointers to parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+	text      *string
+}
+
+// ChatServiceMockEditMessageResults contains results of the ChatService.EditMessage
+type ChatServiceMockEditMessageResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockEditMessageOrigins contains origins of expectations of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+	originText      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatServiceMockEditMessage) Optional() *mChatServiceMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Expect(ctx context.Context, chatID int64, messageID int64, actor string, text string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatServiceMockEditMessageParams{ctx, chatID, messageID, actor, text}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmEditMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmEditMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectActorParam4(actor string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmEditMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectTextParam5 sets up expected param text for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectTextParam5(text string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.text = &text
+	mmEditMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string, text string)) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatServiceMockEditMessageResults{ep1, err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.EditMessage method
+func (mmEditMessage *mChatServiceMockEditMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string, text string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatService.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatServiceMockEditMessage) When(ctx context.Context, chatID int64, messageID int64, actor string, text string) *ChatServiceMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatServiceMockEditMessageParams{ctx, chatID, messageID, actor, text},
+		expectationOrigins: ChatServiceMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockEditMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockEditMessageResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.EditMessage should be invoked
+func (mmEditMessage *mChatServiceMockEditMessage) Times(n uint64) *mChatServiceMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatServiceMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatServiceMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_service.ChatService
+func (mmEditMessage *ChatServiceMock) EditMessage(ctx context.Context, chatID int64, messageID int64, actor string, text string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, chatID, messageID, actor, text)
+	}
+
+	mm_params := ChatServiceMockEditMessageParams{ctx, chatID, messageID, actor, text}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockEditMessageParams{ctx, chatID, messageID, actor, text}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatServiceMock.EditMessage")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, chatID, messageID, actor, text)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatServiceMock.EditMessage. %v %v %v %v %v", ctx, chatID, messageID, actor, text)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatServiceMockEditMessage) Calls() []*ChatServiceMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatServiceMockGetChatMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetChatMessagesExpectation
+	expectations       []*ChatServiceMockGetChatMessagesExpectation
+
+	callArgs []*ChatServiceMockGetChatMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetChatMessagesExpectation specifies expectation struct of the ChatService.GetChatMessages
+type ChatServiceMockGetChatMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetChatMessagesParams
+	paramPtrs          *ChatServiceMockGetChatMessagesParamPtrs
+	expectationOrigins ChatServiceMockGetChatMessagesExpectationOrigins
+	results            *ChatServiceMockGetChatMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetChatMessagesParams contains parameters of the ChatService.GetChatMessages
+type ChatServiceMockGetChatMessagesParams struct {
+	ctx    context.Context
+	filter *model.MessagesFilter
+}
+
+// ChatServiceMockGetChatMessagesParamPtrs contains pointers to parameters of the ChatService.GetChatMessages
+type ChatServiceMockGetChatMessagesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MessagesFilter
+}
+
+// ChatServiceMockGetChatMessagesResults contains results of the ChatService.GetChatMessages
+type ChatServiceMockGetChatMessagesResults struct {
+	mp1 *model.MessagesPage
+	err error
+}
+
+// ChatServiceMockGetChatMessagesOrigins contains origins of expectations of the ChatService.GetChatMessages
+type ChatServiceMockGetChatMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Optional() *mChatServiceMockGetChatMessages {
+	mmGetChatMessages.optional = true
+	return mmGetChatMessages
+}
+
+// Expect sets up expected params for ChatService.GetChatMessages
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Expect(ctx context.Context, filter *model.MessagesFilter) *mChatServiceMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatServiceMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by ExpectParams functions")
+	}
+
+	mmGetChatMessages.defaultExpectation.params = &ChatServiceMockGetChatMessagesParams{ctx, filter}
+	mmGetChatMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChatMessages.expectations {
+		if minimock.Equal(e.params, mmGetChatMessages.defaultExpectation.params) {
+			mmGetChatMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChatMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChatMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetChatMessages
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatServiceMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.params != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Expect")
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs == nil {
+		mmGetChatMessages.defaultExpectation.paramPtrs = &ChatServiceMockGetChatMessagesParamPtrs{}
+	}
+	mmGetChatMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChatMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChatMessages
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.GetChatMessages
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) ExpectFilterParam2(filter *model.MessagesFilter) *mChatServiceMockGetChatMessages {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatServiceMockGetChatMessagesExpectation{}
+	}
+
+	if mmGetChatMessages.defaultExpectation.params != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Expect")
+	}
+
+	if mmGetChatMessages.defaultExpectation.paramPtrs == nil {
+		mmGetChatMessages.defaultExpectation.paramPtrs = &ChatServiceMockGetChatMessagesParamPtrs{}
+	}
+	mmGetChatMessages.defaultExpectation.paramPtrs.filter = &filter
+	mmGetChatMessages.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetChatMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetChatMessages
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Inspect(f func(ctx context.Context, filter *model.MessagesFilter)) *mChatServiceMockGetChatMessages {
+	if mmGetChatMessages.mock.inspectFuncGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetChatMessages")
+	}
+
+	mmGetChatMessages.mock.inspectFuncGetChatMessages = f
+
+	return mmGetChatMessages
+}
+
+// Return sets up results that will be returned by ChatService.GetChatMessages
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Return(mp1 *model.MessagesPage, err error) *ChatServiceMock {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Set")
+	}
+
+	if mmGetChatMessages.defaultExpectation == nil {
+		mmGetChatMessages.defaultExpectation = &ChatServiceMockGetChatMessagesExpectation{mock: mmGetChatMessages.mock}
+	}
+	mmGetChatMessages.defaultExpectation.results = &ChatServiceMockGetChatMessagesResults{mp1, err}
+	mmGetChatMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.GetChatMessages method
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Set(f func(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error)) *ChatServiceMock {
+	if mmGetChatMessages.defaultExpectation != nil {
+		mmGetChatMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.GetChatMessages method")
+	}
+
+	if len(mmGetChatMessages.expectations) > 0 {
+		mmGetChatMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.GetChatMessages method")
+	}
+
+	mmGetChatMessages.mock.funcGetChatMessages = f
+	mmGetChatMessages.mock.funcGetChatMessagesOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages.mock
+}
+
+// When sets expectation for the ChatService.GetChatMessages which will trigger the result defined by the following
+// Then helper
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) When(ctx context.Context, filter *model.MessagesFilter) *ChatServiceMockGetChatMessagesExpectation {
+	if mmGetChatMessages.mock.funcGetChatMessages != nil {
+		mmGetChatMessages.mock.t.Fatalf("ChatServiceMock.GetChatMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetChatMessagesExpectation{
+		mock:               mmGetChatMessages.mock,
+		params:             &ChatServiceMockGetChatMessagesParams{ctx, filter},
+		expectationOrigins: ChatServiceMockGetChatMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChatMessages.expectations = append(mmGetChatMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetChatMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetChatMessagesExpectation) Then(mp1 *model.MessagesPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetChatMessagesResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetChatMessages should be invoked
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Times(n uint64) *mChatServiceMockGetChatMessages {
+	if n == 0 {
+		mmGetChatMessages.mock.t.Fatalf("Times of ChatServiceMock.GetChatMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChatMessages.expectedInvocations, n)
+	mmGetChatMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChatMessages
+}
+
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) invocationsDone() bool {
+	if len(mmGetChatMessages.expectations) == 0 && mmGetChatMessages.defaultExpectation == nil && mmGetChatMessages.mock.funcGetChatMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChatMessages.mock.afterGetChatMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChatMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChatMessages implements mm_service.ChatService
+func (mmGetChatMessages *ChatServiceMock) GetChatMessages(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error) {
+	mm_atomic.AddUint64(&mmGetChatMessages.beforeGetChatMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChatMessages.afterGetChatMessagesCounter, 1)
+
+	mmGetChatMessages.t.Helper()
+
+	if mmGetChatMessages.inspectFuncGetChatMessages != nil {
+		mmGetChatMessages.inspectFuncGetChatMessages(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockGetChatMessagesParams{ctx, filter}
+
+	// Record call args
+	mmGetChatMessages.GetChatMessagesMock.mutex.Lock()
+	mmGetChatMessages.GetChatMessagesMock.callArgs = append(mmGetChatMessages.GetChatMessagesMock.callArgs, &mm_params)
+	mmGetChatMessages.GetChatMessagesMock.mutex.Unlock()
+
+	for _, e := range mmGetChatMessages.GetChatMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetChatMessages.GetChatMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChatMessages.GetChatMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetChatMessagesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChatMessages.t.Errorf("ChatServiceMock.GetChatMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetChatMessages.t.Errorf("ChatServiceMock.GetChatMessages got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChatMessages.t.Errorf("ChatServiceMock.GetChatMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChatMessages.GetChatMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChatMessages.GetChatMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChatMessages.t.Fatal("No results are set for the ChatServiceMock.GetChatMessages")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetChatMessages.funcGetChatMessages != nil {
+		return mmGetChatMessages.funcGetChatMessages(ctx, filter)
+	}
+	mmGetChatMessages.t.Fatalf("Unexpected call to ChatServiceMock.GetChatMessages. %v %v", ctx, filter)
+	return
+}
+
+// GetChatMessagesAfterCounter returns a count of finished ChatServiceMock.GetChatMessages invocations
+func (mmGetChatMessages *ChatServiceMock) GetChatMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMessages.afterGetChatMessagesCounter)
+}
+
+// GetChatMessagesBeforeCounter returns a count of ChatServiceMock.GetChatMessages invocations
+func (mmGetChatMessages *ChatServiceMock) GetChatMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMessages.beforeGetChatMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetChatMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChatMessages *mChatServiceMockGetChatMessages) Calls() []*ChatServiceMockGetChatMessagesParams {
+	mmGetChatMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetChatMessagesParams, len(mmGetChatMessages.callArgs))
+	copy(argCopy, mmGetChatMessages.callArgs)
+
+	mmGetChatMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatMessagesDone returns true if the count of the GetChatMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetChatMessagesDone() bool {
+	if m.GetChatMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMessagesMock.invocationsDone()
+}
+
+// MinimockGetChatMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetChatMessagesInspect() {
+	for _, e := range m.GetChatMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChatMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatMessagesCounter := mm_atomic.LoadUint64(&m.afterGetChatMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMessagesMock.defaultExpectation != nil && afterGetChatMessagesCounter < 1 {
+		if m.GetChatMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChatMessages at\n%s", m.GetChatMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChatMessages at\n%s with params: %#v", m.GetChatMessagesMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChatMessages != nil && afterGetChatMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetChatMessages at\n%s", m.funcGetChatMessagesOrigin)
+	}
+
+	if !m.GetChatMessagesMock.invocationsDone() && afterGetChatMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetChatMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMessagesMock.expectedInvocations), m.GetChatMessagesMock.expectedInvocationsOrigin, afterGetChatMessagesCounter)
+	}
+}
+
+type mChatServiceMockGetMessageStatus struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetMessageStatusExpectation
+	expectations       []*ChatServiceMockGetMessageStatusExpectation
+
+	callArgs []*ChatServiceMockGetMessageStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetMessageStatusExpectation specifies expectation struct of the ChatService.GetMessageStatus
+type ChatServiceMockGetMessageStatusExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetMessageStatusParams
+	paramPtrs          *ChatServiceMockGetMessageStatusParamPtrs
+	expectationOrigins ChatServiceMockGetMessageStatusExpectationOrigins
+	results            *ChatServiceMockGetMessageStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetMessageStatusParams contains parameters of the ChatService.GetMessageStatus
+type ChatServiceMockGetMessageStatusParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+}
+
+// ChatServiceMockGetMessageStatusParamPtrs contains pointers to parameters of the ChatService.GetMessageStatus
+type ChatServiceMockGetMessageStatusParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+}
+
+// ChatServiceMockGetMessageStatusResults contains results of the ChatService.GetMessageStatus
+type ChatServiceMockGetMessageStatusResults struct {
+	mpa1 []*model.MessageStatus
+	err  error
+}
+
+// ChatServiceMockGetMessageStatusOrigins contains origins of expectations of the ChatService.GetMessageStatus
+type ChatServiceMockGetMessageStatusExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Optional() *mChatServiceMockGetMessageStatus {
+	mmGetMessageStatus.optional = true
+	return mmGetMessageStatus
+}
+
+// Expect sets up expected params for ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Expect(ctx context.Context, chatID int64, messageID int64, actor string) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessageStatus.defaultExpectation.params = &ChatServiceMockGetMessageStatusParams{ctx, chatID, messageID, actor}
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMessageStatus.expectations {
+		if minimock.Equal(e.params, mmGetMessageStatus.defaultExpectation.params) {
+			mmGetMessageStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessageStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessageStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) ExpectMessageIDParam3(messageID int64) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) ExpectActorParam4(actor string) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{}
+	}
+
+	if mmGetMessageStatus.defaultExpectation.params != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Expect")
+	}
+
+	if mmGetMessageStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMessageStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageStatusParamPtrs{}
+	}
+	mmGetMessageStatus.defaultExpectation.paramPtrs.actor = &actor
+	mmGetMessageStatus.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmGetMessageStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string)) *mChatServiceMockGetMessageStatus {
+	if mmGetMessageStatus.mock.inspectFuncGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetMessageStatus")
+	}
+
+	mmGetMessageStatus.mock.inspectFuncGetMessageStatus = f
+
+	return mmGetMessageStatus
+}
+
+// Return sets up results that will be returned by ChatService.GetMessageStatus
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Return(mpa1 []*model.MessageStatus, err error) *ChatServiceMock {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	if mmGetMessageStatus.defaultExpectation == nil {
+		mmGetMessageStatus.defaultExpectation = &ChatServiceMockGetMessageStatusExpectation{mock: mmGetMessageStatus.mock}
+	}
+	mmGetMessageStatus.defaultExpectation.results = &ChatServiceMockGetMessageStatusResults{mpa1, err}
+	mmGetMessageStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus.mock
+}
+
+// Set uses given function f to mock the ChatService.GetMessageStatus method
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string) (mpa1 []*model.MessageStatus, err error)) *ChatServiceMock {
+	if mmGetMessageStatus.defaultExpectation != nil {
+		mmGetMessageStatus.mock.t.Fatalf("Default expectation is already set for the ChatService.GetMessageStatus method")
+	}
+
+	if len(mmGetMessageStatus.expectations) > 0 {
+		mmGetMessageStatus.mock.t.Fatalf("Some expectations are already set for the ChatService.GetMessageStatus method")
+	}
+
+	mmGetMessageStatus.mock.funcGetMessageStatus = f
+	mmGetMessageStatus.mock.funcGetMessageStatusOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus.mock
+}
+
+// When sets expectation for the ChatService.GetMessageStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) When(ctx context.Context, chatID int64, messageID int64, actor string) *ChatServiceMockGetMessageStatusExpectation {
+	if mmGetMessageStatus.mock.funcGetMessageStatus != nil {
+		mmGetMessageStatus.mock.t.Fatalf("ChatServiceMock.GetMessageStatus mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetMessageStatusExpectation{
+		mock:               mmGetMessageStatus.mock,
+		params:             &ChatServiceMockGetMessageStatusParams{ctx, chatID, messageID, actor},
+		expectationOrigins: ChatServiceMockGetMessageStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMessageStatus.expectations = append(mmGetMessageStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetMessageStatus return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetMessageStatusExpectation) Then(mpa1 []*model.MessageStatus, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetMessageStatusResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetMessageStatus should be invoked
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Times(n uint64) *mChatServiceMockGetMessageStatus {
+	if n == 0 {
+		mmGetMessageStatus.mock.t.Fatalf("Times of ChatServiceMock.GetMessageStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessageStatus.expectedInvocations, n)
+	mmGetMessageStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMessageStatus
+}
+
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) invocationsDone() bool {
+	if len(mmGetMessageStatus.expectations) == 0 && mmGetMessageStatus.defaultExpectation == nil && mmGetMessageStatus.mock.funcGetMessageStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessageStatus.mock.afterGetMessageStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessageStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessageStatus implements mm_service.ChatService
+func (mmGetMessageStatus *ChatServiceMock) GetMessageStatus(ctx context.Context, chatID int64, messageID int64, actor string) (mpa1 []*model.MessageStatus, err error) {
+	mm_atomic.AddUint64(&mmGetMessageStatus.beforeGetMessageStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessageStatus.afterGetMessageStatusCounter, 1)
+
+	mmGetMessageStatus.t.Helper()
+
+	if mmGetMessageStatus.inspectFuncGetMessageStatus != nil {
+		mmGetMessageStatus.inspectFuncGetMessageStatus(ctx, chatID, messageID, actor)
+	}
+
+	mm_params := ChatServiceMockGetMessageStatusParams{ctx, chatID, messageID, actor}
+
+	// Record call args
+	mmGetMessageStatus.GetMessageStatusMock.mutex.Lock()
+	mmGetMessageStatus.GetMessageStatusMock.callArgs = append(mmGetMessageStatus.GetMessageStatusMock.callArgs, &mm_params)
+	mmGetMessageStatus.GetMessageStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetMessageStatus.GetMessageStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetMessageStatus.GetMessageStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetMessageStatusParams{ctx, chatID, messageID, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessageStatus.t.Errorf("ChatServiceMock.GetMessageStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetMessageStatus.t.Errorf("ChatServiceMock.GetMessageStatus got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetMessageStatus.t.Errorf("ChatServiceMock.GetMessageStatus got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmGetMessageStatus.t.Errorf("ChatServiceMock.GetMessageStatus got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessageStatus.t.Errorf("ChatServiceMock.GetMessageStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessageStatus.GetMessageStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessageStatus.t.Fatal("No results are set for the ChatServiceMock.GetMessageStatus")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetMessageStatus.funcGetMessageStatus != nil {
+		return mmGetMessageStatus.funcGetMessageStatus(ctx, chatID, messageID, actor)
+	}
+	mmGetMessageStatus.t.Fatalf("Unexpected call to ChatServiceMock.GetMessageStatus. %v %v %v %v", ctx, chatID, messageID, actor)
+	return
+}
+
+// GetMessageStatusAfterCounter returns a count of finished ChatServiceMock.GetMessageStatus invocations
+func (mmGetMessageStatus *ChatServiceMock) GetMessageStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageStatus.afterGetMessageStatusCounter)
+}
+
+// GetMessageStatusBeforeCounter returns a count of ChatServiceMock.GetMessageStatus invocations
+func (mmGetMessageStatus *ChatServiceMock) GetMessageStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageStatus.beforeGetMessageStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetMessageStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessageStatus *mChatServiceMockGetMessageStatus) Calls() []*ChatServiceMockGetMessageStatusParams {
+	mmGetMessageStatus.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetMessageStatusParams, len(mmGetMessageStatus.callArgs))
+	copy(argCopy, mmGetMessageStatus.callArgs)
+
+	mmGetMessageStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageStatusDone returns true if the count of the GetMessageStatus invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetMessageStatusDone() bool {
+	if m.GetMessageStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageStatusMock.invocationsDone()
+}
+
+// MinimockGetMessageStatusInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetMessageStatusInspect() {
+	for _, e := range m.GetMessageStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetMessageStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMessageStatusCounter := mm_atomic.LoadUint64(&m.afterGetMessageStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageStatusMock.defaultExpectation != nil && afterGetMessageStatusCounter < 1 {
+		if m.GetMessageStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetMessageStatus at\n%s", m.GetMessageStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetMessageStatus at\n%s with params: %#v", m.GetMessageStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetMessageStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessageStatus != nil && afterGetMessageStatusCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetMessageStatus at\n%s", m.funcGetMessageStatusOrigin)
+	}
+
+	if !m.GetMessageStatusMock.invocationsDone() && afterGetMessageStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetMessageStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageStatusMock.expectedInvocations), m.GetMessageStatusMock.expectedInvocationsOrigin, afterGetMessageStatusCounter)
+	}
+}
+
+type mChatServiceMockGetPresence struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetPresenceExpectation
+	expectations       []*ChatServiceMockGetPresenceExpectation
+
+	callArgs []*ChatServiceMockGetPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetPresenceExpectation specifies expectation struct of the ChatService.GetPresence
+type ChatServiceMockGetPresenceExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetPresenceParams
+	paramPtrs          *ChatServiceMockGetPresenceParamPtrs
+	expectationOrigins ChatServiceMockGetPresenceExpectationOrigins
+	results            *ChatServiceMockGetPresenceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetPresenceParams contains parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParams struct {
+	ctx       context.Context
+	usernames []string
+}
+
+// ChatServiceMockGetPresenceParamPtrs contains pointers to parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParamPtrs struct {
+	ctx       *context.Context
+	usernames *[]string
+}
+
+// ChatServiceMockGetPresenceResults contains results of the ChatService.GetPresence
+type ChatServiceMockGetPresenceResults struct {
+	ppa1 []*model.Presence
+	err  error
+}
+
+// ChatServiceMockGetPresenceOrigins contains origins of expectations of the ChatService.GetPresence
+type ChatServiceMockGetPresenceExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPresence *mChatServiceMockGetPresence) Optional() *mChatServiceMockGetPresence {
+	mmGetPresence.optional = true
+	return mmGetPresence
+}
+
+// Expect sets up expected params for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Expect(ctx context.Context, usernames []string) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by ExpectParams functions")
+	}
+
+	mmGetPresence.defaultExpectation.params = &ChatServiceMockGetPresenceParams{ctx, usernames}
+	mmGetPresence.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPresence.expectations {
+		if minimock.Equal(e.params, mmGetPresence.defaultExpectation.params) {
+			mmGetPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPresence.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPresence
+}
+
+// ExpectUsernamesParam2 sets up expected param usernames for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectUsernamesParam2(usernames []string) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.usernames = &usernames
+	mmGetPresence.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmGetPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Inspect(f func(ctx context.Context, usernames []string)) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.inspectFuncGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetPresence")
+	}
+
+	mmGetPresence.mock.inspectFuncGetPresence = f
+
+	return mmGetPresence
+}
+
+// Return sets up results that will be returned by ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Return(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{mock: mmGetPresence.mock}
+	}
+	mmGetPresence.defaultExpectation.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	mmGetPresence.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPresence.mock
+}
+
+// Set uses given function f to mock the ChatService.GetPresence method
+func (mmGetPresence *mChatServiceMockGetPresence) Set(f func(ctx context.Context, usernames []string) (ppa1 []*model.Presence, err error)) *ChatServiceMock {
+	if mmGetPresence.defaultExpectation != nil {
+		mmGetPresence.mock.t.Fatalf("Default expectation is already set for the ChatService.GetPresence method")
+	}
+
+	if len(mmGetPresence.expectations) > 0 {
+		mmGetPresence.mock.t.Fatalf("Some expectations are already set for the ChatService.GetPresence method")
+	}
+
+	mmGetPresence.mock.funcGetPresence = f
+	mmGetPresence.mock.funcGetPresenceOrigin = minimock.CallerInfo(1)
+	return mmGetPresence.mock
+}
+
+// When sets expectation for the ChatService.GetPresence which will trigger the result defined by the following
+// Then helper
+func (mmGetPresence *mChatServiceMockGetPresence) When(ctx context.Context, usernames []string) *ChatServiceMockGetPresenceExpectation {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetPresenceExpectation{
+		mock:               mmGetPresence.mock,
+		params:             &ChatServiceMockGetPresenceParams{ctx, usernames},
+		expectationOrigins: ChatServiceMockGetPresenceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPresence.expectations = append(mmGetPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetPresence return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetPresenceExpectation) Then(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetPresence should be invoked
+func (mmGetPresence *mChatServiceMockGetPresence) Times(n uint64) *mChatServiceMockGetPresence {
+	if n == 0 {
+		mmGetPresence.mock.t.Fatalf("Times of ChatServiceMock.GetPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPresence.expectedInvocations, n)
+	mmGetPresence.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPresence
+}
+
+func (mmGetPresence *mChatServiceMockGetPresence) invocationsDone() bool {
+	if len(mmGetPresence.expectations) == 0 && mmGetPresence.defaultExpectation == nil && mmGetPresence.mock.funcGetPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPresence.mock.afterGetPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPresence implements mm_service.ChatService
+func (mmGetPresence *ChatServiceMock) GetPresence(ctx context.Context, usernames []string) (ppa1 []*model.Presence, err error) {
+	mm_atomic.AddUint64(&mmGetPresence.beforeGetPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPresence.afterGetPresenceCounter, 1)
+
+	mmGetPresence.t.Helper()
+
+	if mmGetPresence.inspectFuncGetPresence != nil {
+		mmGetPresence.inspectFuncGetPresence(ctx, usernames)
+	}
+
+	mm_params := ChatServiceMockGetPresenceParams{ctx, usernames}
+
+	// Record call args
+	mmGetPresence.GetPresenceMock.mutex.Lock()
+	mmGetPresence.GetPresenceMock.callArgs = append(mmGetPresence.GetPresenceMock.callArgs, &mm_params)
+	mmGetPresence.GetPresenceMock.mutex.Unlock()
+
+	for _, e := range mmGetPresence.GetPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetPresence.GetPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPresence.GetPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPresence.GetPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPresence.GetPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetPresenceParams{ctx, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPresence.GetPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPresence.t.Fatal("No results are set for the ChatServiceMock.GetPresence")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetPresence.funcGetPresence != nil {
+		return mmGetPresence.funcGetPresence(ctx, usernames)
+	}
+	mmGetPresence.t.Fatalf("Unexpected call to ChatServiceMock.GetPresence. %v %v", ctx, usernames)
+	return
+}
+
+// GetPresenceAfterCounter returns a count of finished ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.afterGetPresenceCounter)
+}
+
+// GetPresenceBeforeCounter returns a count of ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.beforeGetPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPresence *mChatServiceMockGetPresence) Calls() []*ChatServiceMockGetPresenceParams {
+	mmGetPresence.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetPresenceParams, len(mmGetPresence.callArgs))
+	copy(argCopy, mmGetPresence.callArgs)
+
+	mmGetPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresenceDone returns true if the count of the GetPresence invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetPresenceDone() bool {
+	if m.GetPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresenceMock.invocationsDone()
+}
+
+// MinimockGetPresenceInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetPresenceInspect() {
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPresenceCounter := mm_atomic.LoadUint64(&m.afterGetPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresenceMock.defaultExpectation != nil && afterGetPresenceCounter < 1 {
+		if m.GetPresenceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s", m.GetPresenceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s with params: %#v", m.GetPresenceMock.defaultExpectation.expectationOrigins.origin, *m.GetPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPresence != nil && afterGetPresenceCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s", m.funcGetPresenceOrigin)
+	}
+
+	if !m.GetPresenceMock.invocationsDone() && afterGetPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetPresence at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresenceMock.expectedInvocations), m.GetPresenceMock.expectedInvocationsOrigin, afterGetPresenceCounter)
+	}
+}
+
+type mChatServiceMockGetThread struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetThreadExpectation
+	expectations       []*ChatServiceMockGetThreadExpectation
+
+	callArgs []*ChatServiceMockGetThreadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetThreadExpectation specifies expectation struct of the ChatService.GetThread
+type ChatServiceMockGetThreadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetThreadParams
+	paramPtrs          *ChatServiceMockGetThreadParamPtrs
+	expectationOrigins ChatServiceMockGetThreadExpectationOrigins
+	results            *ChatServiceMockGetThreadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetThreadParams contains parameters of the ChatService.GetThread
+type ChatServiceMockGetThreadParams struct {
+	ctx    context.Context
+	filter *model.MessagesFilter
+}
+
+// ChatServiceMockGetThreadParamPtrs contains pointers to parameters of the ChatService.GetThread
+type ChatServiceMockGetThreadParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MessagesFilter
+}
+
+// ChatServiceMockGetThreadResults contains results of the ChatService.GetThread
+type ChatServiceMockGetThreadResults struct {
+	mp1 *model.MessagesPage
+	err error
+}
+
+// ChatServiceMockGetThreadOrigins contains origins of expectations of the ChatService.GetThread
+type ChatServiceMockGetThreadExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetThread *mChatServiceMockGetThread) Optional() *mChatServiceMockGetThread {
+	mmGetThread.optional = true
+	return mmGetThread
+}
+
+// Expect sets up expected params for ChatService.GetThread
+func (mmGetThread *mChatServiceMockGetThread) Expect(ctx context.Context, filter *model.MessagesFilter) *mChatServiceMockGetThread {
+	if mmGetThread.mock.funcGetThread != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Set")
+	}
+
+	if mmGetThread.defaultExpectation == nil {
+		mmGetThread.defaultExpectation = &ChatServiceMockGetThreadExpectation{}
+	}
+
+	if mmGetThread.defaultExpectation.paramPtrs != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by ExpectParams functions")
+	}
+
+	mmGetThread.defaultExpectation.params = &ChatServiceMockGetThreadParams{ctx, filter}
+	mmGetThread.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetThread.expectations {
+		if minimock.Equal(e.params, mmGetThread.defaultExpectation.params) {
+			mmGetThread.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetThread.defaultExpectation.params)
+		}
+	}
+
+	return mmGetThread
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetThread
+func (mmGetThread *mChatServiceMockGetThread) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetThread {
+	if mmGetThread.mock.funcGetThread != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Set")
+	}
+
+	if mmGetThread.defaultExpectation == nil {
+		mmGetThread.defaultExpectation = &ChatServiceMockGetThreadExpectation{}
+	}
+
+	if mmGetThread.defaultExpectation.params != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Expect")
+	}
+
+	if mmGetThread.defaultExpectation.paramPtrs == nil {
+		mmGetThread.defaultExpectation.paramPtrs = &ChatServiceMockGetThreadParamPtrs{}
+	}
+	mmGetThread.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetThread.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetThread
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.GetThread
+func (mmGetThread *mChatServiceMockGetThread) ExpectFilterParam2(filter *model.MessagesFilter) *mChatServiceMockGetThread {
+	if mmGetThread.mock.funcGetThread != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Set")
+	}
+
+	if mmGetThread.defaultExpectation == nil {
+		mmGetThread.defaultExpectation = &ChatServiceMockGetThreadExpectation{}
+	}
+
+	if mmGetThread.defaultExpectation.params != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Expect")
+	}
+
+	if mmGetThread.defaultExpectation.paramPtrs == nil {
+		mmGetThread.defaultExpectation.paramPtrs = &ChatServiceMockGetThreadParamPtrs{}
+	}
+	mmGetThread.defaultExpectation.paramPtrs.filter = &filter
+	mmGetThread.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetThread
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetThread
+func (mmGetThread *mChatServiceMockGetThread) Inspect(f func(ctx context.Context, filter *model.MessagesFilter)) *mChatServiceMockGetThread {
+	if mmGetThread.mock.inspectFuncGetThread != nil {
+		mmGetThread.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetThread")
+	}
+
+	mmGetThread.mock.inspectFuncGetThread = f
+
+	return mmGetThread
+}
+
+// Return sets up results that will be returned by ChatService.GetThread
+func (mmGetThread *mChatServiceMockGetThread) Return(mp1 *model.MessagesPage, err error) *ChatServiceMock {
+	if mmGetThread.mock.funcGetThread != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Set")
+	}
+
+	if mmGetThread.defaultExpectation == nil {
+		mmGetThread.defaultExpectation = &ChatServiceMockGetThreadExpectation{mock: mmGetThread.mock}
+	}
+	mmGetThread.defaultExpectation.results = &ChatServiceMockGetThreadResults{mp1, err}
+	mmGetThread.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetThread.mock
+}
+
+// Set uses given function f to mock the ChatService.GetThread method
+func (mmGetThread *mChatServiceMockGetThread) Set(f func(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error)) *ChatServiceMock {
+	if mmGetThread.defaultExpectation != nil {
+		mmGetThread.mock.t.Fatalf("Default expectation is already set for the ChatService.GetThread method")
+	}
+
+	if len(mmGetThread.expectations) > 0 {
+		mmGetThread.mock.t.Fatalf("Some expectations are already set for the ChatService.GetThread method")
+	}
+
+	mmGetThread.mock.funcGetThread = f
+	mmGetThread.mock.funcGetThreadOrigin = minimock.CallerInfo(1)
+	return mmGetThread.mock
+}
+
+// When sets expectation for the ChatService.GetThread which will trigger the result defined by the following
+// Then helper
+func (mmGetThread *mChatServiceMockGetThread) When(ctx context.Context, filter *model.MessagesFilter) *ChatServiceMockGetThreadExpectation {
+	if mmGetThread.mock.funcGetThread != nil {
+		mmGetThread.mock.t.Fatalf("ChatServiceMock.GetThread mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetThreadExpectation{
+		mock:               mmGetThread.mock,
+		params:             &ChatServiceMockGetThreadParams{ctx, filter},
+		expectationOrigins: ChatServiceMockGetThreadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetThread.expectations = append(mmGetThread.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetThread return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetThreadExpectation) Then(mp1 *model.MessagesPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetThreadResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetThread should be invoked
+func (mmGetThread *mChatServiceMockGetThread) Times(n uint64) *mChatServiceMockGetThread {
+	if n == 0 {
+		mmGetThread.mock.t.Fatalf("Times of ChatServiceMock.GetThread mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetThread.expectedInvocations, n)
+	mmGetThread.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetThread
+}
+
+func (mmGetThread *mChatServiceMockGetThread) invocationsDone() bool {
+	if len(mmGetThread.expectations) == 0 && mmGetThread.defaultExpectation == nil && mmGetThread.mock.funcGetThread == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetThread.mock.afterGetThreadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetThread.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetThread implements mm_service.ChatService
+func (mmGetThread *ChatServiceMock) GetThread(ctx context.Context, filter *model.MessagesFilter) (mp1 *model.MessagesPage, err error) {
+	mm_atomic.AddUint64(&mmGetThread.beforeGetThreadCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetThread.afterGetThreadCounter, 1)
+
+	mmGetThread.t.Helper()
+
+	if mmGetThread.inspectFuncGetThread != nil {
+		mmGetThread.inspectFuncGetThread(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockGetThreadParams{ctx, filter}
+
+	// Record call args
+	mmGetThread.GetThreadMock.mutex.Lock()
+	mmGetThread.GetThreadMock.callArgs = append(mmGetThread.GetThreadMock.callArgs, &mm_params)
+	mmGetThread.GetThreadMock.mutex.Unlock()
+
+	for _, e := range mmGetThread.GetThreadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetThread.GetThreadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetThread.GetThreadMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetThread.GetThreadMock.defaultExpectation.params
+		mm_want_ptrs := mmGetThread.GetThreadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetThreadParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetThread.t.Errorf("ChatServiceMock.GetThread got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetThread.GetThreadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetThread.t.Errorf("ChatServiceMock.GetThread got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetThread.GetThreadMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetThread.t.Errorf("ChatServiceMock.GetThread got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetThread.GetThreadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetThread.GetThreadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetThread.t.Fatal("No results are set for the ChatServiceMock.GetThread")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetThread.funcGetThread != nil {
+		return mmGetThread.funcGetThread(ctx, filter)
+	}
+	mmGetThread.t.Fatalf("Unexpected call to ChatServiceMock.GetThread. %v %v", ctx, filter)
+	return
+}
+
+// GetThreadAfterCounter returns a count of finished ChatServiceMock.GetThread invocations
+func (mmGetThread *ChatServiceMock) GetThreadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetThread.afterGetThreadCounter)
+}
+
+// GetThreadBeforeCounter returns a count of ChatServiceMock.GetThread invocations
+func (mmGetThread *ChatServiceMock) GetThreadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetThread.beforeGetThreadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetThread.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetThread *mChatServiceMockGetThread) Calls() []*ChatServiceMockGetThreadParams {
+	mmGetThread.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetThreadParams, len(mmGetThread.callArgs))
+	copy(argCopy, mmGetThread.callArgs)
+
+	mmGetThread.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetThreadDone returns true if the count of the GetThread invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetThreadDone() bool {
+	if m.GetThreadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetThreadMock.invocationsDone()
+}
+
+// MinimockGetThreadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetThreadInspect() {
+	for _, e := range m.GetThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetThread at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetThreadCounter := mm_atomic.LoadUint64(&m.afterGetThreadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetThreadMock.defaultExpectation != nil && afterGetThreadCounter < 1 {
+		if m.GetThreadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetThread at\n%s", m.GetThreadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetThread at\n%s with params: %#v", m.GetThreadMock.defaultExpectation.expectationOrigins.origin, *m.GetThreadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetThread != nil && afterGetThreadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetThread at\n%s", m.funcGetThreadOrigin)
+	}
+
+	if !m.GetThreadMock.invocationsDone() && afterGetThreadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetThread at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetThreadMock.expectedInvocations), m.GetThreadMock.expectedInvocationsOrigin, afterGetThreadCounter)
+	}
+}
+
+type mChatServiceMockGetUserChats struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetUserChatsExpectation
+	expectations       []*ChatServiceMockGetUserChatsExpectation
+
+	callArgs []*ChatServiceMockGetUserChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetUserChatsExpectation specifies expectation struct of the ChatService.GetUserChats
+type ChatServiceMockGetUserChatsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetUserChatsParams
+	paramPtrs          *ChatServiceMockGetUserChatsParamPtrs
+	expectationOrigins ChatServiceMockGetUserChatsExpectationOrigins
+	results            *ChatServiceMockGetUserChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetUserChatsParams contains parameters of the ChatService.GetUserChats
+type ChatServiceMockGetUserChatsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ChatServiceMockGetUserChatsParamPtrs contains pointers to parameters of the ChatService.GetUserChats
+type ChatServiceMockGetUserChatsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ChatServiceMockGetUserChatsResults contains results of the ChatService.GetUserChats
+type ChatServiceMockGetUserChatsResults struct {
+	cpa1 []*model.Chat
+	err  error
+}
+
+// ChatServiceMockGetUserChatsOrigins contains origins of expectations of the ChatService.GetUserChats
+type ChatServiceMockGetUserChatsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserChats *mChatServiceMockGetUserChats) Optional() *mChatServiceMockGetUserChats {
+	mmGetUserChats.optional = true
+	return mmGetUserChats
+}
+
+// Expect sets up expected params for ChatService.GetUserChats
+func (mmGetUserChats *mChatServiceMockGetUserChats) Expect(ctx context.Context, username string) *mChatServiceMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserChats.defaultExpectation.params = &ChatServiceMockGetUserChatsParams{ctx, username}
+	mmGetUserChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserChats.expectations {
+		if minimock.Equal(e.params, mmGetUserChats.defaultExpectation.params) {
+			mmGetUserChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserChats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetUserChats
+func (mmGetUserChats *mChatServiceMockGetUserChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.params != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Expect")
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
+		mmGetUserChats.defaultExpectation.paramPtrs = &ChatServiceMockGetUserChatsParamPtrs{}
+	}
+	mmGetUserChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserChats
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.GetUserChats
+func (mmGetUserChats *mChatServiceMockGetUserChats) ExpectUsernameParam2(username string) *mChatServiceMockGetUserChats {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	}
+
+	if mmGetUserChats.defaultExpectation.params != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Expect")
+	}
+
+	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
+		mmGetUserChats.defaultExpectation.paramPtrs = &ChatServiceMockGetUserChatsParamPtrs{}
+	}
+	mmGetUserChats.defaultExpectation.paramPtrs.username = &username
+	mmGetUserChats.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetUserChats
+func (mmGetUserChats *mChatServiceMockGetUserChats) Inspect(f func(ctx context.Context, username string)) *mChatServiceMockGetUserChats {
+	if mmGetUserChats.mock.inspectFuncGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetUserChats")
+	}
+
+	mmGetUserChats.mock.inspectFuncGetUserChats = f
+
+	return mmGetUserChats
+}
+
+// Return sets up results that will be returned by ChatService.GetUserChats
+func (mmGetUserChats *mChatServiceMockGetUserChats) Return(cpa1 []*model.Chat, err error) *ChatServiceMock {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+	}
+
+	if mmGetUserChats.defaultExpectation == nil {
+		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{mock: mmGetUserChats.mock}
+	}
+	mmGetUserChats.defaultExpectation.results = &ChatServiceMockGetUserChatsResults{cpa1, err}
+	mmGetUserChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats.mock
+}
+
+// Set uses given function f to mock the ChatService.GetUserChats method
+func (mmGetUserChats *mChatServiceMockGetUserChats) Set(f func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)) *ChatServiceMock {
+	if mmGetUserChats.defaultExpectation != nil {
+		mmGetUserChats.mock.t.Fatalf("Default expectation is already set for the ChatService.GetUserChats method")
+	}
+
+	if len(mmGetUserChats.expectations) > 0 {
+		mmGetUserChats.mock.t.Fatalf("Some expectations are already set for the ChatService.GetUserChats method")
+	}
+
+	mmGetUserChats.mock.funcGetUserChats = f
+	mmGetUserChats.mock.funcGetUserChatsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats.mock
+}
+
+// When sets expectation for the ChatService.GetUserChats which will trigger the result defined by the following
+// Then helper
+func (mmGetUserChats *mChatServiceMockGetUserChats) When(ctx context.Context, username string) *ChatServiceMockGetUserChatsExpectation {
+	if mmGetUserChats.mock.funcGetUserChats != nil {
+		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetUserChatsExpectation{
+		mock:               mmGetUserChats.mock,
+		params:             &ChatServiceMockGetUserChatsParams{ctx, username},
+		expectationOrigins: ChatServiceMockGetUserChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserChats.expectations = append(mmGetUserChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetUserChats return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetUserChatsExpectation) Then(cpa1 []*model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetUserChatsResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetUserChats should be invoked
+func (mmGetUserChats *mChatServiceMockGetUserChats) Times(n uint64) *mChatServiceMockGetUserChats {
+	if n == 0 {
+		mmGetUserChats.mock.t.Fatalf("Times of ChatServiceMock.GetUserChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserChats.expectedInvocations, n)
+	mmGetUserChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserChats
+}
+
+func (mmGetUserChats *mChatServiceMockGetUserChats) invocationsDone() bool {
+	if len(mmGetUserChats.expectations) == 0 && mmGetUserChats.defaultExpectation == nil && mmGetUserChats.mock.funcGetUserChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserChats.mock.afterGetUserChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserChats implements mm_service.ChatService
+func (mmGetUserChats *ChatServiceMock) GetUserChats(ctx context.Context, username string) (cpa1 []*model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetUserChats.beforeGetUserChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserChats.afterGetUserChatsCounter, 1)
+
+	mmGetUserChats.t.Helper()
+
+	if mmGetUserChats.inspectFuncGetUserChats != nil {
+		mmGetUserChats.inspectFuncGetUserChats(ctx, username)
+	}
+
+	mm_params := ChatServiceMockGetUserChatsParams{ctx, username}
+
+	// Record call args
+	mmGetUserChats.GetUserChatsMock.mutex.Lock()
+	mmGetUserChats.GetUserChatsMock.callArgs = append(mmGetUserChats.GetUserChatsMock.callArgs, &mm_params)
+	mmGetUserChats.GetUserChatsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserChats.GetUserChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetUserChats.GetUserChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserChats.GetUserChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserChats.GetUserChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserChats.GetUserChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetUserChatsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserChats.GetUserChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserChats.t.Fatal("No results are set for the ChatServiceMock.GetUserChats")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetUserChats.funcGetUserChats != nil {
+		return mmGetUserChats.funcGetUserChats(ctx, username)
+	}
+	mmGetUserChats.t.Fatalf("Unexpected call to ChatServiceMock.GetUserChats. %v %v", ctx, username)
+	return
+}
+
+// GetUserChatsAfterCounter returns a count of finished ChatServiceMock.GetUserChats invocations
+func (mmGetUserChats *ChatServiceMock) GetUserChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChats.afterGetUserChatsCounter)
+}
+
+// GetUserChatsBeforeCounter returns a count of ChatServiceMock.GetUserChats invocations
+func (mmGetUserChats *ChatServiceMock) GetUserChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserChats.beforeGetUserChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetUserChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserChats *mChatServiceMockGetUserChats) Calls() []*ChatServiceMockGetUserChatsParams {
+	mmGetUserChats.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetUserChatsParams, len(mmGetUserChats.callArgs))
+	copy(argCopy, mmGetUserChats.callArgs)
+
+	mmGetUserChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserChatsDone returns true if the count of the GetUserChats invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetUserChatsDone() bool {
+	if m.GetUserChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserChatsMock.invocationsDone()
+}
+
+// MinimockGetUserChatsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetUserChatsInspect() {
+	for _, e := range m.GetUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserChatsCounter := mm_atomic.LoadUint64(&m.afterGetUserChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserChatsMock.defaultExpectation != nil && afterGetUserChatsCounter < 1 {
+		if m.GetUserChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s", m.GetUserChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s with params: %#v", m.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserChats != nil && afterGetUserChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s", m.funcGetUserChatsOrigin)
+	}
+
+	if !m.GetUserChatsMock.invocationsDone() && afterGetUserChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetUserChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserChatsMock.expectedInvocations), m.GetUserChatsMock.expectedInvocationsOrigin, afterGetUserChatsCounter)
+	}
+}
+
+type mChatServiceMockLeaveChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockLeaveChatExpectation
+	expectations       []*ChatServiceMockLeaveChatExpectation
+
+	callArgs []*ChatServiceMockLeaveChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockLeaveChatExpectation specifies expectation struct of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockLeaveChatParams
+	paramPtrs          *ChatServiceMockLeaveChatParamPtrs
+	expectationOrigins ChatServiceMockLeaveChatExpectationOrigins
+	results            *ChatServiceMockLeaveChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockLeaveChatParams contains parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockLeaveChatParamPtrs contains pointers to parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockLeaveChatResults contains results of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockLeaveChatOrigins contains origins of expectations of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeaveChat *mChatServiceMockLeaveChat) Optional() *mChatServiceMockLeaveChat {
+	mmLeaveChat.optional = true
+	return mmLeaveChat
+}
+
+// Expect sets up expected params for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by ExpectParams functions")
+	}
+
+	mmLeaveChat.defaultExpectation.params = &ChatServiceMockLeaveChatParams{ctx, chatID, username}
+	mmLeaveChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLeaveChat.expectations {
+		if minimock.Equal(e.params, mmLeaveChat.defaultExpectation.params) {
+			mmLeaveChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveChat.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLeaveChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmLeaveChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectUsernameParam3(username string) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.username = &username
+	mmLeaveChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.inspectFuncLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.LeaveChat")
+	}
+
+	mmLeaveChat.mock.inspectFuncLeaveChat = f
+
+	return mmLeaveChat
+}
+
+// Return sets up results that will be returned by ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{mock: mmLeaveChat.mock}
+	}
+	mmLeaveChat.defaultExpectation.results = &ChatServiceMockLeaveChatResults{ep1, err}
+	mmLeaveChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// Set uses given function f to mock the ChatService.LeaveChat method
+func (mmLeaveChat *mChatServiceMockLeaveChat) Set(f func(ctx context.Context, chatID int64, username string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmLeaveChat.defaultExpectation != nil {
+		mmLeaveChat.mock.t.Fatalf("Default expectation is already set for the ChatService.LeaveChat method")
+	}
+
+	if len(mmLeaveChat.expectations) > 0 {
+		mmLeaveChat.mock.t.Fatalf("Some expectations are already set for the ChatService.LeaveChat method")
+	}
+
+	mmLeaveChat.mock.funcLeaveChat = f
+	mmLeaveChat.mock.funcLeaveChatOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// When sets expectation for the ChatService.LeaveChat which will trigger the result defined by the following
+// Then helper
+func (mmLeaveChat *mChatServiceMockLeaveChat) When(ctx context.Context, chatID int64, username string) *ChatServiceMockLeaveChatExpectation {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockLeaveChatExpectation{
+		mock:               mmLeaveChat.mock,
+		params:             &ChatServiceMockLeaveChatParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockLeaveChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLeaveChat.expectations = append(mmLeaveChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.LeaveChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockLeaveChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockLeaveChatResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.LeaveChat should be invoked
+func (mmLeaveChat *mChatServiceMockLeaveChat) Times(n uint64) *mChatServiceMockLeaveChat {
+	if n == 0 {
+		mmLeaveChat.mock.t.Fatalf("Times of ChatServiceMock.LeaveChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeaveChat.expectedInvocations, n)
+	mmLeaveChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat
+}
+
+func (mmLeaveChat *mChatServiceMockLeaveChat) invocationsDone() bool {
+	if len(mmLeaveChat.expectations) == 0 && mmLeaveChat.defaultExpectation == nil && mmLeaveChat.mock.funcLeaveChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeaveChat.mock.afterLeaveChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeaveChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LeaveChat implements mm_service.ChatService
+func (mmLeaveChat *ChatServiceMock) LeaveChat(ctx context.Context, chatID int64, username string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmLeaveChat.beforeLeaveChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveChat.afterLeaveChatCounter, 1)
+
+	mmLeaveChat.t.Helper()
+
+	if mmLeaveChat.inspectFuncLeaveChat != nil {
+		mmLeaveChat.inspectFuncLeaveChat(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockLeaveChatParams{ctx, chatID, username}
+
+	// Record call args
+	mmLeaveChat.LeaveChatMock.mutex.Lock()
+	mmLeaveChat.LeaveChatMock.callArgs = append(mmLeaveChat.LeaveChatMock.callArgs, &mm_params)
+	mmLeaveChat.LeaveChatMock.mutex.Unlock()
+
+	for _, e := range mmLeaveChat.LeaveChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmLeaveChat.LeaveChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveChat.LeaveChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveChat.LeaveChatMock.defaultExpectation.params
+		mm_want_ptrs := mmLeaveChat.LeaveChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockLeaveChatParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveChat.LeaveChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveChat.t.Fatal("No results are set for the ChatServiceMock.LeaveChat")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmLeaveChat.funcLeaveChat != nil {
+		return mmLeaveChat.funcLeaveChat(ctx, chatID, username)
+	}
+	mmLeaveChat.t.Fatalf("Unexpected call to ChatServiceMock.LeaveChat. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// LeaveChatAfterCounter returns a count of finished ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.afterLeaveChatCounter)
+}
+
+// LeaveChatBeforeCounter returns a count of ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.beforeLeaveChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.LeaveChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveChat *mChatServiceMockLeaveChat) Calls() []*ChatServiceMockLeaveChatParams {
+	mmLeaveChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockLeaveChatParams, len(mmLeaveChat.callArgs))
+	copy(argCopy, mmLeaveChat.callArgs)
+
+	mmLeaveChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveChatDone returns true if the count of the LeaveChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockLeaveChatDone() bool {
+	if m.LeaveChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveChatMock.invocationsDone()
+}
+
+// MinimockLeaveChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockLeaveChatInspect() {
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLeaveChatCounter := mm_atomic.LoadUint64(&m.afterLeaveChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveChatMock.defaultExpectation != nil && afterLeaveChatCounter < 1 {
+		if m.LeaveChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.LeaveChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", m.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *m.LeaveChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveChat != nil && afterLeaveChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.funcLeaveChatOrigin)
+	}
+
+	if !m.LeaveChatMock.invocationsDone() && afterLeaveChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.LeaveChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveChatMock.expectedInvocations), m.LeaveChatMock.expectedInvocationsOrigin, afterLeaveChatCounter)
+	}
+}
+
+type mChatServiceMockListPinnedMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListPinnedMessagesExpectation
+	expectations       []*ChatServiceMockListPinnedMessagesExpectation
+
+	callArgs []*ChatServiceMockListPinnedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListPinnedMessagesExpectation specifies expectation struct of the ChatService.ListPinnedMessages
+type ChatServiceMockListPinnedMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListPinnedMessagesParams
+	paramPtrs          *ChatServiceMockListPinnedMessagesParamPtrs
+	expectationOrigins ChatServiceMockListPinnedMessagesExpectationOrigins
+	results            *ChatServiceMockListPinnedMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListPinnedMessagesParams contains parameters of the ChatService.ListPinnedMessages
+type ChatServiceMockListPinnedMessagesParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockListPinnedMessagesParamPtrs contains pointers to parameters of the ChatService.ListPinnedMessages
+type ChatServiceMockListPinnedMessagesParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockListPinnedMessagesResults contains results of the ChatService.ListPinnedMessages
+type ChatServiceMockListPinnedMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatServiceMockListPinnedMessagesOrigins contains origins of expectations of the ChatService.ListPinnedMessages
+type ChatServiceMockListPinnedMessagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Optional() *mChatServiceMockListPinnedMessages {
+	mmListPinnedMessages.optional = true
+	return mmListPinnedMessages
+}
+
+// Expect sets up expected params for ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &ChatServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListPinnedMessages.defaultExpectation.params = &ChatServiceMockListPinnedMessagesParams{ctx, chatID, username}
+	mmListPinnedMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPinnedMessages.expectations {
+		if minimock.Equal(e.params, mmListPinnedMessages.defaultExpectation.params) {
+			mmListPinnedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinnedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinnedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &ChatServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPinnedMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPinnedMessages
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) ExpectChatIDParam2(chatID int64) *mChatServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &ChatServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListPinnedMessages.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListPinnedMessages
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) ExpectUsernameParam3(username string) *mChatServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &ChatServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.username = &username
+	mmListPinnedMessages.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListPinnedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListPinnedMessages")
+	}
+
+	mmListPinnedMessages.mock.inspectFuncListPinnedMessages = f
+
+	return mmListPinnedMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListPinnedMessages
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &ChatServiceMockListPinnedMessagesExpectation{mock: mmListPinnedMessages.mock}
+	}
+	mmListPinnedMessages.defaultExpectation.results = &ChatServiceMockListPinnedMessagesResults{mpa1, err}
+	mmListPinnedMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPinnedMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListPinnedMessages method
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Set(f func(ctx context.Context, chatID int64, username string) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmListPinnedMessages.defaultExpectation != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListPinnedMessages method")
+	}
+
+	if len(mmListPinnedMessages.expectations) > 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListPinnedMessages method")
+	}
+
+	mmListPinnedMessages.mock.funcListPinnedMessages = f
+	mmListPinnedMessages.mock.funcListPinnedMessagesOrigin = minimock.CallerInfo(1)
+	return mmListPinnedMessages.mock
+}
+
+// When sets expectation for the ChatService.ListPinnedMessages which will trigger the result defined by the following
+// Then helper
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) When(ctx context.Context, chatID int64, username string) *ChatServiceMockListPinnedMessagesExpectation {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("ChatServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListPinnedMessagesExpectation{
+		mock:               mmListPinnedMessages.mock,
+		params:             &ChatServiceMockListPinnedMessagesParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockListPinnedMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPinnedMessages.expectations = append(mmListPinnedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListPinnedMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListPinnedMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListPinnedMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListPinnedMessages should be invoked
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Times(n uint64) *mChatServiceMockListPinnedMessages {
+	if n == 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Times of ChatServiceMock.ListPinnedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinnedMessages.expectedInvocations, n)
+	mmListPinnedMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPinnedMessages
+}
+
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) invocationsDone() bool {
+	if len(mmListPinnedMessages.expectations) == 0 && mmListPinnedMessages.defaultExpectation == nil && mmListPinnedMessages.mock.funcListPinnedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.mock.afterListPinnedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinnedMessages implements mm_service.ChatService
+func (mmListPinnedMessages *ChatServiceMock) ListPinnedMessages(ctx context.Context, chatID int64, username string) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter, 1)
+
+	mmListPinnedMessages.t.Helper()
+
+	if mmListPinnedMessages.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.inspectFuncListPinnedMessages(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockListPinnedMessagesParams{ctx, chatID, username}
+
+	// Record call args
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Lock()
+	mmListPinnedMessages.ListPinnedMessagesMock.callArgs = append(mmListPinnedMessages.ListPinnedMessagesMock.callArgs, &mm_params)
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListPinnedMessages.ListPinnedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListPinnedMessagesParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinnedMessages.t.Errorf("ChatServiceMock.ListPinnedMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListPinnedMessages.t.Errorf("ChatServiceMock.ListPinnedMessages got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListPinnedMessages.t.Errorf("ChatServiceMock.ListPinnedMessages got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinnedMessages.t.Errorf("ChatServiceMock.ListPinnedMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinnedMessages.t.Fatal("No results are set for the ChatServiceMock.ListPinnedMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListPinnedMessages.funcListPinnedMessages != nil {
+		return mmListPinnedMessages.funcListPinnedMessages(ctx, chatID, username)
+	}
+	mmListPinnedMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListPinnedMessages. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// ListPinnedMessagesAfterCounter returns a count of finished ChatServiceMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *ChatServiceMock) ListPinnedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter)
+}
+
+// ListPinnedMessagesBeforeCounter returns a count of ChatServiceMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *ChatServiceMock) ListPinnedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListPinnedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinnedMessages *mChatServiceMockListPinnedMessages) Calls() []*ChatServiceMockListPinnedMessagesParams {
+	mmListPinnedMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListPinnedMessagesParams, len(mmListPinnedMessages.callArgs))
+	copy(argCopy, mmListPinnedMessages.callArgs)
+
+	mmListPinnedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedMessagesDone returns true if the count of the ListPinnedMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListPinnedMessagesDone() bool {
+	if m.ListPinnedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMessagesMock.invocationsDone()
+}
+
+// MinimockListPinnedMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListPinnedMessagesInspect() {
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinnedMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPinnedMessagesCounter := mm_atomic.LoadUint64(&m.afterListPinnedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMessagesMock.defaultExpectation != nil && afterListPinnedMessagesCounter < 1 {
+		if m.ListPinnedMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinnedMessages at\n%s", m.ListPinnedMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinnedMessages at\n%s with params: %#v", m.ListPinnedMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListPinnedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinnedMessages != nil && afterListPinnedMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListPinnedMessages at\n%s", m.funcListPinnedMessagesOrigin)
+	}
+
+	if !m.ListPinnedMessagesMock.invocationsDone() && afterListPinnedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListPinnedMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMessagesMock.expectedInvocations), m.ListPinnedMessagesMock.expectedInvocationsOrigin, afterListPinnedMessagesCounter)
+	}
+}
+
+type mChatServiceMockMarkRead struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockMarkReadExpectation
+	expectations       []*ChatServiceMockMarkReadExpectation
+
+	callArgs []*ChatServiceMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockMarkReadExpectation specifies expectation struct of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockMarkReadParams
+	paramPtrs          *ChatServiceMockMarkReadParamPtrs
+	expectationOrigins ChatServiceMockMarkReadExpectationOrigins
+	results            *ChatServiceMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockMarkReadParams contains parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParams struct {
+	ctx           context.Context
+	chatID        int64
+	username      string
+	upToMessageID int64
+}
+
+// ChatServiceMockMarkReadParamPtrs contains pointers to parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParamPtrs struct {
+	ctx           *context.Context
+	chatID        *int64
+	username      *string
+	upToMessageID *int64
+}
+
+// ChatServiceMockMarkReadResults contains results of the ChatService.MarkRead
+type ChatServiceMockMarkReadResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockMarkReadOrigins contains origins of expectations of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originChatID        string
+	originUsername      string
+	originUpToMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatServiceMockMarkRead) Optional() *mChatServiceMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Expect(ctx context.Context, chatID int64, username string, upToMessageID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatServiceMockMarkReadParams{ctx, chatID, username, upToMessageID}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectChatIDParam2(chatID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+	mmMarkRead.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectUsernameParam3(username string) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.username = &username
+	mmMarkRead.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUpToMessageIDParam4 sets up expected param upToMessageID for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectUpToMessageIDParam4(upToMessageID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.upToMessageID = &upToMessageID
+	mmMarkRead.defaultExpectation.expectationOrigins.originUpToMessageID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Inspect(f func(ctx context.Context, chatID int64, username string, upToMessageID int64)) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatServiceMockMarkReadResults{ep1, err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatService.MarkRead method
+func (mmMarkRead *mChatServiceMockMarkRead) Set(f func(ctx context.Context, chatID int64, username string, upToMessageID int64) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatService.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatService.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatService.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatServiceMockMarkRead) When(ctx context.Context, chatID int64, username string, upToMessageID int64) *ChatServiceMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatServiceMockMarkReadParams{ctx, chatID, username, upToMessageID},
+		expectationOrigins: ChatServiceMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockMarkReadExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockMarkReadResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.MarkRead should be invoked
+func (mmMarkRead *mChatServiceMockMarkRead) Times(n uint64) *mChatServiceMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatServiceMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatServiceMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_service.ChatService
+func (mmMarkRead *ChatServiceMock) MarkRead(ctx context.Context, chatID int64, username string, upToMessageID int64) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, chatID, username, upToMessageID)
+	}
+
+	mm_params := ChatServiceMockMarkReadParams{ctx, chatID, username, upToMessageID}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockMarkReadParams{ctx, chatID, username, upToMessageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.upToMessageID != nil && !minimock.Equal(*mm_want_ptrs.upToMessageID, mm_got.upToMessageID) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter upToMessageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUpToMessageID, *mm_want_ptrs.upToMessageID, mm_got.upToMessageID, minimock.Diff(*mm_want_ptrs.upToMessageID, mm_got.upToMessageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatServiceMock.MarkRead")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, chatID, username, upToMessageID)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatServiceMock.MarkRead. %v %v %v %v", ctx, chatID, username, upToMessageID)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatServiceMockMarkRead) Calls() []*ChatServiceMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatServiceMockPinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPinMessageExpectation
+	expectations       []*ChatServiceMockPinMessageExpectation
+
+	callArgs []*ChatServiceMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockPinMessageExpectation specifies expectation struct of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockPinMessageParams
+	paramPtrs          *ChatServiceMockPinMessageParamPtrs
+	expectationOrigins ChatServiceMockPinMessageExpectationOrigins
+	results            *ChatServiceMockPinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockPinMessageParams contains parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+}
+
+// ChatServiceMockPinMessageParamPtrs contains pointers to parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+}
+
+// ChatServiceMockPinMessageResults contains results of the ChatService.PinMessage
+type ChatServiceMockPinMessageResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockPinMessageOrigins contains origins of expectations of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatServiceMockPinMessage) Optional() *mChatServiceMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Expect(ctx context.Context, chatID int64, messageID int64, actor string) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatServiceMockPinMessageParams{ctx, chatID, messageID, actor}
+	mmPinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmPinMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmPinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectActorParam4(actor string) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmPinMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string)) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatServiceMockPinMessageResults{ep1, err}
+	mmPinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PinMessage method
+func (mmPinMessage *mChatServiceMockPinMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	mmPinMessage.mock.funcPinMessageOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatService.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatServiceMockPinMessage) When(ctx context.Context, chatID int64, messageID int64, actor string) *ChatServiceMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPinMessageExpectation{
+		mock:               mmPinMessage.mock,
+		params:             &ChatServiceMockPinMessageParams{ctx, chatID, messageID, actor},
+		expectationOrigins: ChatServiceMockPinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPinMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPinMessageResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PinMessage should be invoked
+func (mmPinMessage *mChatServiceMockPinMessage) Times(n uint64) *mChatServiceMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatServiceMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	mmPinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatServiceMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements mm_service.ChatService
+func (mmPinMessage *ChatServiceMock) PinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	mmPinMessage.t.Helper()
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, chatID, messageID, actor)
+	}
+
+	mm_params := ChatServiceMockPinMessageParams{ctx, chatID, messageID, actor}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPinMessageParams{ctx, chatID, messageID, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatServiceMock.PinMessage")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, chatID, messageID, actor)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatServiceMock.PinMessage. %v %v %v %v", ctx, chatID, messageID, actor)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatServiceMockPinMessage) Calls() []*ChatServiceMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.PinMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", m.PinMessageMock.defaultExpectation.expectationOrigins.origin, *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.funcPinMessageOrigin)
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), m.PinMessageMock.expectedInvocationsOrigin, afterPinMessageCounter)
+	}
+}
+
+type mChatServiceMockRemoveChatMember struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveChatMemberExpectation
+	expectations       []*ChatServiceMockRemoveChatMemberExpectation
+
+	callArgs []*ChatServiceMockRemoveChatMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveChatMemberExpectation specifies expectation struct of the ChatService.RemoveChatMember
+type ChatServiceMockRemoveChatMemberExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveChatMemberParams
+	paramPtrs          *ChatServiceMockRemoveChatMemberParamPtrs
+	expectationOrigins ChatServiceMockRemoveChatMemberExpectationOrigins
+	results            *ChatServiceMockRemoveChatMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveChatMemberParams contains parameters of the ChatService.RemoveChatMember
+type ChatServiceMockRemoveChatMemberParams struct {
+	ctx      context.Context
+	chatID   int64
+	actor    string
+	username string
+}
+
+// ChatServiceMockRemoveChatMemberParamPtrs contains pointers to parameters of the ChatService.RemoveChatMember
+type ChatServiceMockRemoveChatMemberParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	actor    *string
+	username *string
+}
+
+// ChatServiceMockRemoveChatMemberResults contains results of the ChatService.RemoveChatMember
+type ChatServiceMockRemoveChatMemberResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockRemoveChatMemberOrigins contains origins of expectations of the ChatService.RemoveChatMember
+type ChatServiceMockRemoveChatMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originActor    string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Optional() *mChatServiceMockRemoveChatMember {
+	mmRemoveChatMember.optional = true
+	return mmRemoveChatMember
+}
+
+// Expect sets up expected params for ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Expect(ctx context.Context, chatID int64, actor string, username string) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveChatMember.defaultExpectation.params = &ChatServiceMockRemoveChatMemberParams{ctx, chatID, actor, username}
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveChatMember.expectations {
+		if minimock.Equal(e.params, mmRemoveChatMember.defaultExpectation.params) {
+			mmRemoveChatMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveChatMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveChatMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) ExpectChatIDParam2(chatID int64) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) ExpectActorParam3(actor string) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.actor = &actor
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// ExpectUsernameParam4 sets up expected param username for ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) ExpectUsernameParam4(username string) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{}
+	}
+
+	if mmRemoveChatMember.defaultExpectation.params != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Expect")
+	}
+
+	if mmRemoveChatMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveChatMemberParamPtrs{}
+	}
+	mmRemoveChatMember.defaultExpectation.paramPtrs.username = &username
+	mmRemoveChatMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveChatMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Inspect(f func(ctx context.Context, chatID int64, actor string, username string)) *mChatServiceMockRemoveChatMember {
+	if mmRemoveChatMember.mock.inspectFuncRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveChatMember")
+	}
+
+	mmRemoveChatMember.mock.inspectFuncRemoveChatMember = f
+
+	return mmRemoveChatMember
+}
+
+// Return sets up results that will be returned by ChatService.RemoveChatMember
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	if mmRemoveChatMember.defaultExpectation == nil {
+		mmRemoveChatMember.defaultExpectation = &ChatServiceMockRemoveChatMemberExpectation{mock: mmRemoveChatMember.mock}
+	}
+	mmRemoveChatMember.defaultExpectation.results = &ChatServiceMockRemoveChatMemberResults{ep1, err}
+	mmRemoveChatMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveChatMember method
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Set(f func(ctx context.Context, chatID int64, actor string, username string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmRemoveChatMember.defaultExpectation != nil {
+		mmRemoveChatMember.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveChatMember method")
+	}
+
+	if len(mmRemoveChatMember.expectations) > 0 {
+		mmRemoveChatMember.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveChatMember method")
+	}
+
+	mmRemoveChatMember.mock.funcRemoveChatMember = f
+	mmRemoveChatMember.mock.funcRemoveChatMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember.mock
+}
+
+// When sets expectation for the ChatService.RemoveChatMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) When(ctx context.Context, chatID int64, actor string, username string) *ChatServiceMockRemoveChatMemberExpectation {
+	if mmRemoveChatMember.mock.funcRemoveChatMember != nil {
+		mmRemoveChatMember.mock.t.Fatalf("ChatServiceMock.RemoveChatMember mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveChatMemberExpectation{
+		mock:               mmRemoveChatMember.mock,
+		params:             &ChatServiceMockRemoveChatMemberParams{ctx, chatID, actor, username},
+		expectationOrigins: ChatServiceMockRemoveChatMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveChatMember.expectations = append(mmRemoveChatMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveChatMember return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveChatMemberExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveChatMemberResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveChatMember should be invoked
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Times(n uint64) *mChatServiceMockRemoveChatMember {
+	if n == 0 {
+		mmRemoveChatMember.mock.t.Fatalf("Times of ChatServiceMock.RemoveChatMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveChatMember.expectedInvocations, n)
+	mmRemoveChatMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMember
+}
+
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) invocationsDone() bool {
+	if len(mmRemoveChatMember.expectations) == 0 && mmRemoveChatMember.defaultExpectation == nil && mmRemoveChatMember.mock.funcRemoveChatMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveChatMember.mock.afterRemoveChatMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveChatMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveChatMember implements mm_service.ChatService
+func (mmRemoveChatMember *ChatServiceMock) RemoveChatMember(ctx context.Context, chatID int64, actor string, username string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmRemoveChatMember.beforeRemoveChatMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveChatMember.afterRemoveChatMemberCounter, 1)
+
+	mmRemoveChatMember.t.Helper()
+
+	if mmRemoveChatMember.inspectFuncRemoveChatMember != nil {
+		mmRemoveChatMember.inspectFuncRemoveChatMember(ctx, chatID, actor, username)
+	}
+
+	mm_params := ChatServiceMockRemoveChatMemberParams{ctx, chatID, actor, username}
+
+	// Record call args
+	mmRemoveChatMember.RemoveChatMemberMock.mutex.Lock()
+	mmRemoveChatMember.RemoveChatMemberMock.callArgs = append(mmRemoveChatMember.RemoveChatMemberMock.callArgs, &mm_params)
+	mmRemoveChatMember.RemoveChatMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveChatMember.RemoveChatMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveChatMemberParams{ctx, chatID, actor, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveChatMember.t.Errorf("ChatServiceMock.RemoveChatMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveChatMember.t.Errorf("ChatServiceMock.RemoveChatMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmRemoveChatMember.t.Errorf("ChatServiceMock.RemoveChatMember got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveChatMember.t.Errorf("ChatServiceMock.RemoveChatMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveChatMember.t.Errorf("ChatServiceMock.RemoveChatMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveChatMember.RemoveChatMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveChatMember.t.Fatal("No results are set for the ChatServiceMock.RemoveChatMember")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmRemoveChatMember.funcRemoveChatMember != nil {
+		return mmRemoveChatMember.funcRemoveChatMember(ctx, chatID, actor, username)
+	}
+	mmRemoveChatMember.t.Fatalf("Unexpected call to ChatServiceMock.RemoveChatMember. %v %v %v %v", ctx, chatID, actor, username)
+	return
+}
+
+// RemoveChatMemberAfterCounter returns a count of finished ChatServiceMock.RemoveChatMember invocations
+func (mmRemoveChatMember *ChatServiceMock) RemoveChatMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMember.afterRemoveChatMemberCounter)
+}
+
+// RemoveChatMemberBeforeCounter returns a count of ChatServiceMock.RemoveChatMember invocations
+func (mmRemoveChatMember *ChatServiceMock) RemoveChatMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMember.beforeRemoveChatMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveChatMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveChatMember *mChatServiceMockRemoveChatMember) Calls() []*ChatServiceMockRemoveChatMemberParams {
+	mmRemoveChatMember.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveChatMemberParams, len(mmRemoveChatMember.callArgs))
+	copy(argCopy, mmRemoveChatMember.callArgs)
+
+	mmRemoveChatMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveChatMemberDone returns true if the count of the RemoveChatMember invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveChatMemberDone() bool {
+	if m.RemoveChatMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveChatMemberMock.invocationsDone()
+}
+
+// MinimockRemoveChatMemberInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveChatMemberInspect() {
+	for _, e := range m.RemoveChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveChatMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveChatMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveChatMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveChatMemberMock.defaultExpectation != nil && afterRemoveChatMemberCounter < 1 {
+		if m.RemoveChatMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveChatMember at\n%s", m.RemoveChatMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveChatMember at\n%s with params: %#v", m.RemoveChatMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveChatMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveChatMember != nil && afterRemoveChatMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveChatMember at\n%s", m.funcRemoveChatMemberOrigin)
+	}
+
+	if !m.RemoveChatMemberMock.invocationsDone() && afterRemoveChatMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveChatMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveChatMemberMock.expectedInvocations), m.RemoveChatMemberMock.expectedInvocationsOrigin, afterRemoveChatMemberCounter)
+	}
+}
+
+type mChatServiceMockRemoveReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveReactionExpectation
+	expectations       []*ChatServiceMockRemoveReactionExpectation
+
+	callArgs []*ChatServiceMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveReactionExpectation specifies expectation struct of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveReactionParams
+	paramPtrs          *ChatServiceMockRemoveReactionParamPtrs
+	expectationOrigins ChatServiceMockRemoveReactionExpectationOrigins
+	results            *ChatServiceMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveReactionParams contains parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
+	emoji     string
+}
+
+// ChatServiceMockRemoveReactionParamPtrs contains pointers to parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
+	emoji     *string
+}
+
+// ChatServiceMockRemoveReactionResults contains results of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockRemoveReactionOrigins contains origins of expectations of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Optional() *mChatServiceMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Expect(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ChatServiceMockRemoveReactionParams{ctx, chatID, messageID, actor, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectChatIDParam2(chatID int64) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectMessageIDParam3(messageID int64) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectActorParam4 sets up expected param actor for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectActorParam4(actor string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.actor = &actor
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam5 sets up expected param emoji for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectEmojiParam5(emoji string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string)) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ChatServiceMockRemoveReactionResults{ep1, err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveReaction method
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ChatService.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) When(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) *ChatServiceMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &ChatServiceMockRemoveReactionParams{ctx, chatID, messageID, actor, emoji},
+		expectationOrigins: ChatServiceMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveReactionExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveReactionResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveReaction should be invoked
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Times(n uint64) *mChatServiceMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ChatServiceMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_service.ChatService
+func (mmRemoveReaction *ChatServiceMock) RemoveReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, chatID, messageID, actor, emoji)
+	}
+
+	mm_params := ChatServiceMockRemoveReactionParams{ctx, chatID, messageID, actor, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveReactionParams{ctx, chatID, messageID, actor, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ChatServiceMock.RemoveReaction")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, chatID, messageID, actor, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ChatServiceMock.RemoveReaction. %v %v %v %v %v", ctx, chatID, messageID, actor, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Calls() []*ChatServiceMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mChatServiceMockRenameChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRenameChatExpectation
+	expectations       []*ChatServiceMockRenameChatExpectation
+
+	callArgs []*ChatServiceMockRenameChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRenameChatExpectation specifies expectation struct of the ChatService.RenameChat
+type ChatServiceMockRenameChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRenameChatParams
+	paramPtrs          *ChatServiceMockRenameChatParamPtrs
+	expectationOrigins ChatServiceMockRenameChatExpectationOrigins
+	results            *ChatServiceMockRenameChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRenameChatParams contains parameters of the ChatService.RenameChat
+type ChatServiceMockRenameChatParams struct {
+	ctx    context.Context
+	chatID int64
+	actor  string
+	name   string
+}
+
+// ChatServiceMockRenameChatParamPtrs contains pointers to parameters of the ChatService.RenameChat
+type ChatServiceMockRenameChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	actor  *string
+	name   *string
+}
+
+// ChatServiceMockRenameChatResults contains results of the ChatService.RenameChat
+type ChatServiceMockRenameChatResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockRenameChatOrigins contains origins of expectations of the ChatService.RenameChat
+type ChatServiceMockRenameChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originActor  string
+	originName   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRenameChat *mChatServiceMockRenameChat) Optional() *mChatServiceMockRenameChat {
+	mmRenameChat.optional = true
+	return mmRenameChat
+}
+
+// Expect sets up expected params for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Expect(ctx context.Context, chatID int64, actor string, name string) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by ExpectParams functions")
+	}
+
+	mmRenameChat.defaultExpectation.params = &ChatServiceMockRenameChatParams{ctx, chatID, actor, name}
+	mmRenameChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRenameChat.expectations {
+		if minimock.Equal(e.params, mmRenameChat.defaultExpectation.params) {
+			mmRenameChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenameChat.defaultExpectation.params)
+		}
+	}
+
+	return mmRenameChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRenameChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRenameChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectActorParam3(actor string) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.actor = &actor
+	mmRenameChat.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// ExpectNameParam4 sets up expected param name for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectNameParam4(name string) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.name = &name
+	mmRenameChat.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmRenameChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Inspect(f func(ctx context.Context, chatID int64, actor string, name string)) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.inspectFuncRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RenameChat")
+	}
+
+	mmRenameChat.mock.inspectFuncRenameChat = f
+
+	return mmRenameChat
+}
+
+// Return sets up results that will be returned by ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{mock: mmRenameChat.mock}
+	}
+	mmRenameChat.defaultExpectation.results = &ChatServiceMockRenameChatResults{ep1, err}
+	mmRenameChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRenameChat.mock
+}
+
+// Set uses given function f to mock the ChatService.RenameChat method
+func (mmRenameChat *mChatServiceMockRenameChat) Set(f func(ctx context.Context, chatID int64, actor string, name string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmRenameChat.defaultExpectation != nil {
+		mmRenameChat.mock.t.Fatalf("Default expectation is already set for the ChatService.RenameChat method")
+	}
+
+	if len(mmRenameChat.expectations) > 0 {
+		mmRenameChat.mock.t.Fatalf("Some expectations are already set for the ChatService.RenameChat method")
+	}
+
+	mmRenameChat.mock.funcRenameChat = f
+	mmRenameChat.mock.funcRenameChatOrigin = minimock.CallerInfo(1)
+	return mmRenameChat.mock
+}
+
+// When sets expectation for the ChatService.RenameChat which will trigger the result defined by the following
+// Then helper
+func (mmRenameChat *mChatServiceMockRenameChat) When(ctx context.Context, chatID int64, actor string, name string) *ChatServiceMockRenameChatExpectation {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRenameChatExpectation{
+		mock:               mmRenameChat.mock,
+		params:             &ChatServiceMockRenameChatParams{ctx, chatID, actor, name},
+		expectationOrigins: ChatServiceMockRenameChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRenameChat.expectations = append(mmRenameChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RenameChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRenameChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRenameChatResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RenameChat should be invoked
+func (mmRenameChat *mChatServiceMockRenameChat) Times(n uint64) *mChatServiceMockRenameChat {
+	if n == 0 {
+		mmRenameChat.mock.t.Fatalf("Times of ChatServiceMock.RenameChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRenameChat.expectedInvocations, n)
+	mmRenameChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRenameChat
+}
+
+func (mmRenameChat *mChatServiceMockRenameChat) invocationsDone() bool {
+	if len(mmRenameChat.expectations) == 0 && mmRenameChat.defaultExpectation == nil && mmRenameChat.mock.funcRenameChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRenameChat.mock.afterRenameChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRenameChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RenameChat implements mm_service.ChatService
+func (mmRenameChat *ChatServiceMock) RenameChat(ctx context.Context, chatID int64, actor string, name string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmRenameChat.beforeRenameChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenameChat.afterRenameChatCounter, 1)
+
+	mmRenameChat.t.Helper()
+
+	if mmRenameChat.inspectFuncRenameChat != nil {
+		mmRenameChat.inspectFuncRenameChat(ctx, chatID, actor, name)
+	}
+
+	mm_params := ChatServiceMockRenameChatParams{ctx, chatID, actor, name}
+
+	// Record call args
+	mmRenameChat.RenameChatMock.mutex.Lock()
+	mmRenameChat.RenameChatMock.callArgs = append(mmRenameChat.RenameChatMock.callArgs, &mm_params)
+	mmRenameChat.RenameChatMock.mutex.Unlock()
+
+	for _, e := range mmRenameChat.RenameChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmRenameChat.RenameChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenameChat.RenameChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenameChat.RenameChatMock.defaultExpectation.params
+		mm_want_ptrs := mmRenameChat.RenameChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRenameChatParams{ctx, chatID, actor, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRenameChat.RenameChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenameChat.RenameChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenameChat.t.Fatal("No results are set for the ChatServiceMock.RenameChat")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmRenameChat.funcRenameChat != nil {
+		return mmRenameChat.funcRenameChat(ctx, chatID, actor, name)
+	}
+	mmRenameChat.t.Fatalf("Unexpected call to ChatServiceMock.RenameChat. %v %v %v %v", ctx, chatID, actor, name)
+	return
+}
+
+// RenameChatAfterCounter returns a count of finished ChatServiceMock.RenameChat invocations
+func (mmRenameChat *ChatServiceMock) RenameChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.afterRenameChatCounter)
+}
+
+// RenameChatBeforeCounter returns a count of ChatServiceMock.RenameChat invocations
+func (mmRenameChat *ChatServiceMock) RenameChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.beforeRenameChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RenameChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenameChat *mChatServiceMockRenameChat) Calls() []*ChatServiceMockRenameChatParams {
+	mmRenameChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRenameChatParams, len(mmRenameChat.callArgs))
+	copy(argCopy, mmRenameChat.callArgs)
+
+	mmRenameChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenameChatDone returns true if the count of the RenameChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRenameChatDone() bool {
+	if m.RenameChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RenameChatMock.invocationsDone()
+}
+
+// MinimockRenameChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRenameChatInspect() {
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RenameChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRenameChatCounter := mm_atomic.LoadUint64(&m.afterRenameChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenameChatMock.defaultExpectation != nil && afterRenameChatCounter < 1 {
+		if m.RenameChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RenameChat at\n%s", m.RenameChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RenameChat at\n%s with params: %#v", m.RenameChatMock.defaultExpectation.expectationOrigins.origin, *m.RenameChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenameChat != nil && afterRenameChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RenameChat at\n%s", m.funcRenameChatOrigin)
+	}
+
+	if !m.RenameChatMock.invocationsDone() && afterRenameChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RenameChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RenameChatMock.expectedInvocations), m.RenameChatMock.expectedInvocationsOrigin, afterRenameChatCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSendMessageParams
+	paramPtrs          *ChatServiceMockSendMessageParamPtrs
+	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
+	results            *ChatServiceMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx     context.Context
+	message *model.Message
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx     *context.Context
+	message **model.Message
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, message *model.Message) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, message}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectMessageParam2(message *model.Message) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.message = &message
+	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, message *model.Message)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{ep1, err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, message *model.Message) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &ChatServiceMockSendMessageParams{ctx, message},
+		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
 
-	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, chat}
-	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateChat.expectations {
-		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
-			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements mm_service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	}
+
+	mm_params := ChatServiceMockSendMessageParams{ctx, message}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, message)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, message)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
+}
+
+type mChatServiceMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetMemberRoleExpectation
+	expectations       []*ChatServiceMockSetMemberRoleExpectation
+
+	callArgs []*ChatServiceMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSetMemberRoleExpectation specifies expectation struct of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSetMemberRoleParams
+	paramPtrs          *ChatServiceMockSetMemberRoleParamPtrs
+	expectationOrigins ChatServiceMockSetMemberRoleExpectationOrigins
+	results            *ChatServiceMockSetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSetMemberRoleParams contains parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParams struct {
+	ctx    context.Context
+	chatID int64
+	actor  string
+	member string
+	role   model.Role
+}
+
+// ChatServiceMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	actor  *string
+	member *string
+	role   *model.Role
+}
+
+// ChatServiceMockSetMemberRoleResults contains results of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleResults struct {
+	ep1 *emptypb.Empty
+	err error
+}
+
+// ChatServiceMockSetMemberRoleOrigins contains origins of expectations of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originActor  string
+	originMember string
+	originRole   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Optional() *mChatServiceMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Expect(ctx context.Context, chatID int64, actor string, member string, role model.Role) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, member, role}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateChat
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectActorParam3(actor string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.actor = &actor
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectMemberParam4 sets up expected param member for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectMemberParam4(member string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.member = &member
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmSetMemberRole
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectChatParam2(chat *model.Chat) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectRoleParam5 sets up expected param role for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectRoleParam5(role model.Role) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
-	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmSetMemberRole
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, chat *model.Chat)) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.inspectFuncCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, actor string, member string, role model.Role)) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetMemberRole")
 	}
 
-	mmCreateChat.mock.inspectFuncCreateChat = f
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
 
-	return mmCreateChat
+	return mmSetMemberRole
 }
 
-// Return sets up results that will be returned by ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// Return sets up results that will be returned by ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
 	}
-	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
-	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmSetMemberRole.defaultExpectation.results = &ChatServiceMockSetMemberRoleResults{ep1, err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
 }
 
-// Set uses given function f to mock the ChatService.CreateChat method
-func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, chat *model.Chat) (i1 int64, err error)) *ChatServiceMock {
-	if mmCreateChat.defaultExpectation != nil {
-		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+// Set uses given function f to mock the ChatService.SetMemberRole method
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, actor string, member string, role model.Role) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatService.SetMemberRole method")
 	}
 
-	if len(mmCreateChat.expectations) > 0 {
-		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatService.SetMemberRole method")
 	}
 
-	mmCreateChat.mock.funcCreateChat = f
-	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
 }
 
-// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.SetMemberRole which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, chat *model.Chat) *ChatServiceMockCreateChatExpectation {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) When(ctx context.Context, chatID int64, actor string, member string, role model.Role) *ChatServiceMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockCreateChatExpectation{
-		mock:               mmCreateChat.mock,
-		params:             &ChatServiceMockCreateChatParams{ctx, chat},
-		expectationOrigins: ChatServiceMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, member, role},
+		expectationOrigins: ChatServiceMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockCreateChatResults{i1, err}
+// Then sets up ChatService.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetMemberRoleExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetMemberRoleResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.CreateChat should be invoked
-func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+// Times sets number of times ChatService.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Times(n uint64) *mChatServiceMockSetMemberRole {
 	if n == 0 {
-		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatServiceMock.SetMemberRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
-	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateChat
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
 }
 
-func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
-	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateChat implements mm_service.ChatService
-func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, chat *model.Chat) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+// SetMemberRole implements mm_service.ChatService
+func (mmSetMemberRole *ChatServiceMock) SetMemberRole(ctx context.Context, chatID int64, actor string, member string, role model.Role) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
 
-	mmCreateChat.t.Helper()
+	mmSetMemberRole.t.Helper()
 
-	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, actor, member, role)
 	}
 
-	mm_params := ChatServiceMockCreateChatParams{ctx, chat}
+	mm_params := ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, member, role}
 
 	// Record call args
-	mmCreateChat.CreateChatMock.mutex.Lock()
-	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
-	mmCreateChat.CreateChatMock.mutex.Unlock()
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
 
-	for _, e := range mmCreateChat.CreateChatMock.expectations {
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockCreateChatParams{ctx, chat}
+		mm_got := ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, member, role}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+			mmSetMemberRole.t.Fatal("No results are set for the ChatServiceMock.SetMemberRole")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, chat)
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, actor, member, role)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, chat)
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatServiceMock.SetMemberRole. %v %v %v %v %v", ctx, chatID, actor, member, role)
 	return
 }
 
-// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+// SetMemberRoleAfterCounter returns a count of finished ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
 }
 
-// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+// SetMemberRoleBeforeCounter returns a count of ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetMemberRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
-	mmCreateChat.mutex.RLock()
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Calls() []*ChatServiceMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
-	copy(argCopy, mmCreateChat.callArgs)
+	argCopy := make([]*ChatServiceMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
 
-	mmCreateChat.mutex.RUnlock()
+	mmSetMemberRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockCreateChatDone() bool {
-	if m.CreateChatMock.optional {
+func (m *ChatServiceMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateChatMock.expectations {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateChatMock.invocationsDone()
+	return m.SetMemberRoleMock.invocationsDone()
 }
 
-// MinimockCreateChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockCreateChatInspect() {
-	for _, e := range m.CreateChatMock.expectations {
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
-		if m.CreateChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
 	}
 
-	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
 	}
 }
 
-type mChatServiceMockDeleteChat struct {
+type mChatServiceMockSetTyping struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockDeleteChatExpectation
-	expectations       []*ChatServiceMockDeleteChatExpectation
+	defaultExpectation *ChatServiceMockSetTypingExpectation
+	expectations       []*ChatServiceMockSetTypingExpectation
 
-	callArgs []*ChatServiceMockDeleteChatParams
+	callArgs []*ChatServiceMockSetTypingParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatExpectation struct {
+// ChatServiceMockSetTypingExpectation specifies expectation struct of the ChatService.SetTyping
+type ChatServiceMockSetTypingExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockDeleteChatParams
-	paramPtrs          *ChatServiceMockDeleteChatParamPtrs
-	expectationOrigins ChatServiceMockDeleteChatExpectationOrigins
-	results            *ChatServiceMockDeleteChatResults
+	params             *ChatServiceMockSetTypingParams
+	paramPtrs          *ChatServiceMockSetTypingParamPtrs
+	expectationOrigins ChatServiceMockSetTypingExpectationOrigins
+	results            *ChatServiceMockSetTypingResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParams struct {
-	ctx    context.Context
-	chatID int64
+// ChatServiceMockSetTypingParams contains parameters of the ChatService.SetTyping
+type ChatServiceMockSetTypingParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+	isTyping bool
 }
 
-// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParamPtrs struct {
-	ctx    *context.Context
-	chatID *int64
+// ChatServiceMockSetTypingParamPtrs contains pointers to parameters of the ChatService.SetTyping
+type ChatServiceMockSetTypingParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+	isTyping *bool
 }
 
-// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatResults struct {
+// ChatServiceMockSetTypingResults contains results of the ChatService.SetTyping
+type ChatServiceMockSetTypingResults struct {
 	ep1 *emptypb.Empty
 	err error
 }
 
-// ChatServiceMockDeleteChatOrigins contains origins of expectations of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originChatID string
+// ChatServiceMockSetTypingOrigins contains origins of expectations of the ChatService.SetTyping
+type ChatServiceMockSetTypingExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+	originIsTyping string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -886,342 +8974,404 @@ type ChatServiceMockDeleteChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
-	mmDeleteChat.optional = true
-	return mmDeleteChat
+func (mmSetTyping *mChatServiceMockSetTyping) Optional() *mChatServiceMockSetTyping {
+	mmSetTyping.optional = true
+	return mmSetTyping
 }
 
-// Expect sets up expected params for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, chatID int64) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// Expect sets up expected params for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Expect(ctx context.Context, chatID int64, username string, isTyping bool) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	if mmSetTyping.defaultExpectation.paramPtrs != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, chatID}
-	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteChat.expectations {
-		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
-			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+	mmSetTyping.defaultExpectation.params = &ChatServiceMockSetTypingParams{ctx, chatID, username, isTyping}
+	mmSetTyping.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetTyping.expectations {
+		if minimock.Equal(e.params, mmSetTyping.defaultExpectation.params) {
+			mmSetTyping.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetTyping.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteChat
+	return mmSetTyping
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetTyping.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetTyping.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmSetTyping
 }
 
-// ExpectChatIDParam2 sets up expected param chatID for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.chatID = &chatID
-	mmDeleteChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+	mmSetTyping.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetTyping.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmSetTyping
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+// ExpectUsernameParam3 sets up expected param username for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectUsernameParam3(username string) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	mmDeleteChat.mock.inspectFuncDeleteChat = f
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
 
-	return mmDeleteChat
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.username = &username
+	mmSetTyping.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSetTyping
 }
 
-// Return sets up results that will be returned by ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// ExpectIsTypingParam4 sets up expected param isTyping for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectIsTypingParam4(isTyping bool) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
 	}
-	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{ep1, err}
-	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.isTyping = &isTyping
+	mmSetTyping.defaultExpectation.expectationOrigins.originIsTyping = minimock.CallerInfo(1)
+
+	return mmSetTyping
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Inspect(f func(ctx context.Context, chatID int64, username string, isTyping bool)) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.inspectFuncSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetTyping")
+	}
+
+	mmSetTyping.mock.inspectFuncSetTyping = f
+
+	return mmSetTyping
+}
+
+// Return sets up results that will be returned by ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{mock: mmSetTyping.mock}
+	}
+	mmSetTyping.defaultExpectation.results = &ChatServiceMockSetTypingResults{ep1, err}
+	mmSetTyping.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetTyping.mock
 }
 
-// Set uses given function f to mock the ChatService.DeleteChat method
-func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
-	if mmDeleteChat.defaultExpectation != nil {
-		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+// Set uses given function f to mock the ChatService.SetTyping method
+func (mmSetTyping *mChatServiceMockSetTyping) Set(f func(ctx context.Context, chatID int64, username string, isTyping bool) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmSetTyping.defaultExpectation != nil {
+		mmSetTyping.mock.t.Fatalf("Default expectation is already set for the ChatService.SetTyping method")
 	}
 
-	if len(mmDeleteChat.expectations) > 0 {
-		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	if len(mmSetTyping.expectations) > 0 {
+		mmSetTyping.mock.t.Fatalf("Some expectations are already set for the ChatService.SetTyping method")
 	}
 
-	mmDeleteChat.mock.funcDeleteChat = f
-	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+	mmSetTyping.mock.funcSetTyping = f
+	mmSetTyping.mock.funcSetTypingOrigin = minimock.CallerInfo(1)
+	return mmSetTyping.mock
 }
 
-// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.SetTyping which will trigger the result defined by the following
 // Then helper
-func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, chatID int64) *ChatServiceMockDeleteChatExpectation {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+func (mmSetTyping *mChatServiceMockSetTyping) When(ctx context.Context, chatID int64, username string, isTyping bool) *ChatServiceMockSetTypingExpectation {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockDeleteChatExpectation{
-		mock:               mmDeleteChat.mock,
-		params:             &ChatServiceMockDeleteChatParams{ctx, chatID},
-		expectationOrigins: ChatServiceMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockSetTypingExpectation{
+		mock:               mmSetTyping.mock,
+		params:             &ChatServiceMockSetTypingParams{ctx, chatID, username, isTyping},
+		expectationOrigins: ChatServiceMockSetTypingExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	mmSetTyping.expectations = append(mmSetTyping.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockDeleteChatExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockDeleteChatResults{ep1, err}
+// Then sets up ChatService.SetTyping return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetTypingExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetTypingResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.DeleteChat should be invoked
-func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+// Times sets number of times ChatService.SetTyping should be invoked
+func (mmSetTyping *mChatServiceMockSetTyping) Times(n uint64) *mChatServiceMockSetTyping {
 	if n == 0 {
-		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+		mmSetTyping.mock.t.Fatalf("Times of ChatServiceMock.SetTyping mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
-	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat
+	mm_atomic.StoreUint64(&mmSetTyping.expectedInvocations, n)
+	mmSetTyping.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetTyping
 }
 
-func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
-	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+func (mmSetTyping *mChatServiceMockSetTyping) invocationsDone() bool {
+	if len(mmSetTyping.expectations) == 0 && mmSetTyping.defaultExpectation == nil && mmSetTyping.mock.funcSetTyping == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetTyping.mock.afterSetTypingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetTyping.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteChat implements mm_service.ChatService
-func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, chatID int64) (ep1 *emptypb.Empty, err error) {
-	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+// SetTyping implements mm_service.ChatService
+func (mmSetTyping *ChatServiceMock) SetTyping(ctx context.Context, chatID int64, username string, isTyping bool) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmSetTyping.beforeSetTypingCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetTyping.afterSetTypingCounter, 1)
 
-	mmDeleteChat.t.Helper()
+	mmSetTyping.t.Helper()
 
-	if mmDeleteChat.inspectFuncDeleteChat != nil {
-		mmDeleteChat.inspectFuncDeleteChat(ctx, chatID)
+	if mmSetTyping.inspectFuncSetTyping != nil {
+		mmSetTyping.inspectFuncSetTyping(ctx, chatID, username, isTyping)
 	}
 
-	mm_params := ChatServiceMockDeleteChatParams{ctx, chatID}
+	mm_params := ChatServiceMockSetTypingParams{ctx, chatID, username, isTyping}
 
 	// Record call args
-	mmDeleteChat.DeleteChatMock.mutex.Lock()
-	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
-	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+	mmSetTyping.SetTypingMock.mutex.Lock()
+	mmSetTyping.SetTypingMock.callArgs = append(mmSetTyping.SetTypingMock.callArgs, &mm_params)
+	mmSetTyping.SetTypingMock.mutex.Unlock()
 
-	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+	for _, e := range mmSetTyping.SetTypingMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+	if mmSetTyping.SetTypingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetTyping.SetTypingMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetTyping.SetTypingMock.defaultExpectation.params
+		mm_want_ptrs := mmSetTyping.SetTypingMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockDeleteChatParams{ctx, chatID}
+		mm_got := ChatServiceMockSetTypingParams{ctx, chatID, username, isTyping}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTyping.SetTypingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTyping.SetTypingMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTyping.SetTypingMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.isTyping != nil && !minimock.Equal(*mm_want_ptrs.isTyping, mm_got.isTyping) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter isTyping, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTyping.SetTypingMock.defaultExpectation.expectationOrigins.originIsTyping, *mm_want_ptrs.isTyping, mm_got.isTyping, minimock.Diff(*mm_want_ptrs.isTyping, mm_got.isTyping))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetTyping.SetTypingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		mm_results := mmSetTyping.SetTypingMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+			mmSetTyping.t.Fatal("No results are set for the ChatServiceMock.SetTyping")
 		}
 		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmDeleteChat.funcDeleteChat != nil {
-		return mmDeleteChat.funcDeleteChat(ctx, chatID)
+	if mmSetTyping.funcSetTyping != nil {
+		return mmSetTyping.funcSetTyping(ctx, chatID, username, isTyping)
 	}
-	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v", ctx, chatID)
+	mmSetTyping.t.Fatalf("Unexpected call to ChatServiceMock.SetTyping. %v %v %v %v", ctx, chatID, username, isTyping)
 	return
 }
 
-// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+// SetTypingAfterCounter returns a count of finished ChatServiceMock.SetTyping invocations
+func (mmSetTyping *ChatServiceMock) SetTypingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTyping.afterSetTypingCounter)
 }
 
-// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+// SetTypingBeforeCounter returns a count of ChatServiceMock.SetTyping invocations
+func (mmSetTyping *ChatServiceMock) SetTypingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTyping.beforeSetTypingCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetTyping.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
-	mmDeleteChat.mutex.RLock()
+func (mmSetTyping *mChatServiceMockSetTyping) Calls() []*ChatServiceMockSetTypingParams {
+	mmSetTyping.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
-	copy(argCopy, mmDeleteChat.callArgs)
+	argCopy := make([]*ChatServiceMockSetTypingParams, len(mmSetTyping.callArgs))
+	copy(argCopy, mmSetTyping.callArgs)
 
-	mmDeleteChat.mutex.RUnlock()
+	mmSetTyping.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// MinimockSetTypingDone returns true if the count of the SetTyping invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
-	if m.DeleteChatMock.optional {
+func (m *ChatServiceMock) MinimockSetTypingDone() bool {
+	if m.SetTypingMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteChatMock.expectations {
+	for _, e := range m.SetTypingMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteChatMock.invocationsDone()
+	return m.SetTypingMock.invocationsDone()
 }
 
-// MinimockDeleteChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockDeleteChatInspect() {
-	for _, e := range m.DeleteChatMock.expectations {
+// MinimockSetTypingInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetTypingInspect() {
+	for _, e := range m.SetTypingMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetTyping at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	afterSetTypingCounter := mm_atomic.LoadUint64(&m.afterSetTypingCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
-		if m.DeleteChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+	if m.SetTypingMock.defaultExpectation != nil && afterSetTypingCounter < 1 {
+		if m.SetTypingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SetTyping at\n%s", m.SetTypingMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetTyping at\n%s with params: %#v", m.SetTypingMock.defaultExpectation.expectationOrigins.origin, *m.SetTypingMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	if m.funcSetTyping != nil && afterSetTypingCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SetTyping at\n%s", m.funcSetTypingOrigin)
 	}
 
-	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	if !m.SetTypingMock.invocationsDone() && afterSetTypingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetTyping at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetTypingMock.expectedInvocations), m.SetTypingMock.expectedInvocationsOrigin, afterSetTypingCounter)
 	}
 }
 
-type mChatServiceMockGetUserChats struct {
+type mChatServiceMockTransferOwnership struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockGetUserChatsExpectation
-	expectations       []*ChatServiceMockGetUserChatsExpectation
+	defaultExpectation *ChatServiceMockTransferOwnershipExpectation
+	expectations       []*ChatServiceMockTransferOwnershipExpectation
 
-	callArgs []*ChatServiceMockGetUserChatsParams
+	callArgs []*ChatServiceMockTransferOwnershipParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockGetUserChatsExpectation specifies expectation struct of the ChatService.GetUserChats
-type ChatServiceMockGetUserChatsExpectation struct {
+// ChatServiceMockTransferOwnershipExpectation specifies expectation struct of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockGetUserChatsParams
-	paramPtrs          *ChatServiceMockGetUserChatsParamPtrs
-	expectationOrigins ChatServiceMockGetUserChatsExpectationOrigins
-	results            *ChatServiceMockGetUserChatsResults
+	params             *ChatServiceMockTransferOwnershipParams
+	paramPtrs          *ChatServiceMockTransferOwnershipParamPtrs
+	expectationOrigins ChatServiceMockTransferOwnershipExpectationOrigins
+	results            *ChatServiceMockTransferOwnershipResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockGetUserChatsParams contains parameters of the ChatService.GetUserChats
-type ChatServiceMockGetUserChatsParams struct {
+// ChatServiceMockTransferOwnershipParams contains parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParams struct {
 	ctx      context.Context
-	username string
+	chatID   int64
+	actor    string
+	newOwner string
 }
 
-// ChatServiceMockGetUserChatsParamPtrs contains pointers to parameters of the ChatService.GetUserChats
-type ChatServiceMockGetUserChatsParamPtrs struct {
+// ChatServiceMockTransferOwnershipParamPtrs contains pointers to parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParamPtrs struct {
 	ctx      *context.Context
-	username *string
+	chatID   *int64
+	actor    *string
+	newOwner *string
 }
 
-// ChatServiceMockGetUserChatsResults contains results of the ChatService.GetUserChats
-type ChatServiceMockGetUserChatsResults struct {
-	cpa1 []*model.Chat
-	err  error
+// ChatServiceMockTransferOwnershipResults contains results of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipResults struct {
+	ep1 *emptypb.Empty
+	err error
 }
 
-// ChatServiceMockGetUserChatsOrigins contains origins of expectations of the ChatService.GetUserChats
-type ChatServiceMockGetUserChatsExpectationOrigins struct {
+// ChatServiceMockTransferOwnershipOrigins contains origins of expectations of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipExpectationOrigins struct {
 	origin         string
 	originCtx      string
-	originUsername string
+	originChatID   string
+	originActor    string
+	originNewOwner string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1229,342 +9379,404 @@ type ChatServiceMockGetUserChatsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserChats *mChatServiceMockGetUserChats) Optional() *mChatServiceMockGetUserChats {
-	mmGetUserChats.optional = true
-	return mmGetUserChats
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Optional() *mChatServiceMockTransferOwnership {
+	mmTransferOwnership.optional = true
+	return mmTransferOwnership
 }
 
-// Expect sets up expected params for ChatService.GetUserChats
-func (mmGetUserChats *mChatServiceMockGetUserChats) Expect(ctx context.Context, username string) *mChatServiceMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+// Expect sets up expected params for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Expect(ctx context.Context, chatID int64, actor string, newOwner string) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by ExpectParams functions")
+	if mmTransferOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserChats.defaultExpectation.params = &ChatServiceMockGetUserChatsParams{ctx, username}
-	mmGetUserChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserChats.expectations {
-		if minimock.Equal(e.params, mmGetUserChats.defaultExpectation.params) {
-			mmGetUserChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserChats.defaultExpectation.params)
+	mmTransferOwnership.defaultExpectation.params = &ChatServiceMockTransferOwnershipParams{ctx, chatID, actor, newOwner}
+	mmTransferOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferOwnership.defaultExpectation.params) {
+			mmTransferOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferOwnership.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserChats
+	return mmTransferOwnership
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.GetUserChats
-func (mmGetUserChats *mChatServiceMockGetUserChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectCtxParam1(ctx context.Context) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.params != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Expect")
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
-		mmGetUserChats.defaultExpectation.paramPtrs = &ChatServiceMockGetUserChatsParamPtrs{}
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
 	}
-	mmGetUserChats.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmTransferOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserChats
+	return mmTransferOwnership
 }
 
-// ExpectUsernameParam2 sets up expected param username for ChatService.GetUserChats
-func (mmGetUserChats *mChatServiceMockGetUserChats) ExpectUsernameParam2(username string) *mChatServiceMockGetUserChats {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectChatIDParam2(chatID int64) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmGetUserChats.defaultExpectation.params != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Expect")
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
 	}
 
-	if mmGetUserChats.defaultExpectation.paramPtrs == nil {
-		mmGetUserChats.defaultExpectation.paramPtrs = &ChatServiceMockGetUserChatsParamPtrs{}
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
 	}
-	mmGetUserChats.defaultExpectation.paramPtrs.username = &username
-	mmGetUserChats.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmTransferOwnership.defaultExpectation.paramPtrs.chatID = &chatID
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmGetUserChats
+	return mmTransferOwnership
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.GetUserChats
-func (mmGetUserChats *mChatServiceMockGetUserChats) Inspect(f func(ctx context.Context, username string)) *mChatServiceMockGetUserChats {
-	if mmGetUserChats.mock.inspectFuncGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetUserChats")
+// ExpectActorParam3 sets up expected param actor for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectActorParam3(actor string) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	mmGetUserChats.mock.inspectFuncGetUserChats = f
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
+	}
 
-	return mmGetUserChats
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
+	}
+	mmTransferOwnership.defaultExpectation.paramPtrs.actor = &actor
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmTransferOwnership
 }
 
-// Return sets up results that will be returned by ChatService.GetUserChats
-func (mmGetUserChats *mChatServiceMockGetUserChats) Return(cpa1 []*model.Chat, err error) *ChatServiceMock {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+// ExpectNewOwnerParam4 sets up expected param newOwner for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectNewOwnerParam4(newOwner string) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmGetUserChats.defaultExpectation == nil {
-		mmGetUserChats.defaultExpectation = &ChatServiceMockGetUserChatsExpectation{mock: mmGetUserChats.mock}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
-	mmGetUserChats.defaultExpectation.results = &ChatServiceMockGetUserChatsResults{cpa1, err}
-	mmGetUserChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats.mock
+
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
+	}
+	mmTransferOwnership.defaultExpectation.paramPtrs.newOwner = &newOwner
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originNewOwner = minimock.CallerInfo(1)
+
+	return mmTransferOwnership
 }
 
-// Set uses given function f to mock the ChatService.GetUserChats method
-func (mmGetUserChats *mChatServiceMockGetUserChats) Set(f func(ctx context.Context, username string) (cpa1 []*model.Chat, err error)) *ChatServiceMock {
-	if mmGetUserChats.defaultExpectation != nil {
-		mmGetUserChats.mock.t.Fatalf("Default expectation is already set for the ChatService.GetUserChats method")
+// Inspect accepts an inspector function that has same arguments as the ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Inspect(f func(ctx context.Context, chatID int64, actor string, newOwner string)) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.TransferOwnership")
 	}
 
-	if len(mmGetUserChats.expectations) > 0 {
-		mmGetUserChats.mock.t.Fatalf("Some expectations are already set for the ChatService.GetUserChats method")
+	mmTransferOwnership.mock.inspectFuncTransferOwnership = f
+
+	return mmTransferOwnership
+}
+
+// Return sets up results that will be returned by ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	mmGetUserChats.mock.funcGetUserChats = f
-	mmGetUserChats.mock.funcGetUserChatsOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats.mock
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{mock: mmTransferOwnership.mock}
+	}
+	mmTransferOwnership.defaultExpectation.results = &ChatServiceMockTransferOwnershipResults{ep1, err}
+	mmTransferOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership.mock
 }
 
-// When sets expectation for the ChatService.GetUserChats which will trigger the result defined by the following
+// Set uses given function f to mock the ChatService.TransferOwnership method
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Set(f func(ctx context.Context, chatID int64, actor string, newOwner string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmTransferOwnership.defaultExpectation != nil {
+		mmTransferOwnership.mock.t.Fatalf("Default expectation is already set for the ChatService.TransferOwnership method")
+	}
+
+	if len(mmTransferOwnership.expectations) > 0 {
+		mmTransferOwnership.mock.t.Fatalf("Some expectations are already set for the ChatService.TransferOwnership method")
+	}
+
+	mmTransferOwnership.mock.funcTransferOwnership = f
+	mmTransferOwnership.mock.funcTransferOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership.mock
+}
+
+// When sets expectation for the ChatService.TransferOwnership which will trigger the result defined by the following
 // Then helper
-func (mmGetUserChats *mChatServiceMockGetUserChats) When(ctx context.Context, username string) *ChatServiceMockGetUserChatsExpectation {
-	if mmGetUserChats.mock.funcGetUserChats != nil {
-		mmGetUserChats.mock.t.Fatalf("ChatServiceMock.GetUserChats mock is already set by Set")
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) When(ctx context.Context, chatID int64, actor string, newOwner string) *ChatServiceMockTransferOwnershipExpectation {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockGetUserChatsExpectation{
-		mock:               mmGetUserChats.mock,
-		params:             &ChatServiceMockGetUserChatsParams{ctx, username},
-		expectationOrigins: ChatServiceMockGetUserChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockTransferOwnershipExpectation{
+		mock:               mmTransferOwnership.mock,
+		params:             &ChatServiceMockTransferOwnershipParams{ctx, chatID, actor, newOwner},
+		expectationOrigins: ChatServiceMockTransferOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserChats.expectations = append(mmGetUserChats.expectations, expectation)
+	mmTransferOwnership.expectations = append(mmTransferOwnership.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.GetUserChats return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockGetUserChatsExpectation) Then(cpa1 []*model.Chat, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockGetUserChatsResults{cpa1, err}
+// Then sets up ChatService.TransferOwnership return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockTransferOwnershipExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockTransferOwnershipResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.GetUserChats should be invoked
-func (mmGetUserChats *mChatServiceMockGetUserChats) Times(n uint64) *mChatServiceMockGetUserChats {
+// Times sets number of times ChatService.TransferOwnership should be invoked
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Times(n uint64) *mChatServiceMockTransferOwnership {
 	if n == 0 {
-		mmGetUserChats.mock.t.Fatalf("Times of ChatServiceMock.GetUserChats mock can not be zero")
+		mmTransferOwnership.mock.t.Fatalf("Times of ChatServiceMock.TransferOwnership mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserChats.expectedInvocations, n)
-	mmGetUserChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserChats
+	mm_atomic.StoreUint64(&mmTransferOwnership.expectedInvocations, n)
+	mmTransferOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership
 }
 
-func (mmGetUserChats *mChatServiceMockGetUserChats) invocationsDone() bool {
-	if len(mmGetUserChats.expectations) == 0 && mmGetUserChats.defaultExpectation == nil && mmGetUserChats.mock.funcGetUserChats == nil {
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) invocationsDone() bool {
+	if len(mmTransferOwnership.expectations) == 0 && mmTransferOwnership.defaultExpectation == nil && mmTransferOwnership.mock.funcTransferOwnership == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserChats.mock.afterGetUserChatsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserChats.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.mock.afterTransferOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserChats implements mm_service.ChatService
-func (mmGetUserChats *ChatServiceMock) GetUserChats(ctx context.Context, username string) (cpa1 []*model.Chat, err error) {
-	mm_atomic.AddUint64(&mmGetUserChats.beforeGetUserChatsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserChats.afterGetUserChatsCounter, 1)
+// TransferOwnership implements mm_service.ChatService
+func (mmTransferOwnership *ChatServiceMock) TransferOwnership(ctx context.Context, chatID int64, actor string, newOwner string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmTransferOwnership.beforeTransferOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferOwnership.afterTransferOwnershipCounter, 1)
 
-	mmGetUserChats.t.Helper()
+	mmTransferOwnership.t.Helper()
 
-	if mmGetUserChats.inspectFuncGetUserChats != nil {
-		mmGetUserChats.inspectFuncGetUserChats(ctx, username)
+	if mmTransferOwnership.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.inspectFuncTransferOwnership(ctx, chatID, actor, newOwner)
 	}
 
-	mm_params := ChatServiceMockGetUserChatsParams{ctx, username}
+	mm_params := ChatServiceMockTransferOwnershipParams{ctx, chatID, actor, newOwner}
 
 	// Record call args
-	mmGetUserChats.GetUserChatsMock.mutex.Lock()
-	mmGetUserChats.GetUserChatsMock.callArgs = append(mmGetUserChats.GetUserChatsMock.callArgs, &mm_params)
-	mmGetUserChats.GetUserChatsMock.mutex.Unlock()
+	mmTransferOwnership.TransferOwnershipMock.mutex.Lock()
+	mmTransferOwnership.TransferOwnershipMock.callArgs = append(mmTransferOwnership.TransferOwnershipMock.callArgs, &mm_params)
+	mmTransferOwnership.TransferOwnershipMock.mutex.Unlock()
 
-	for _, e := range mmGetUserChats.GetUserChatsMock.expectations {
+	for _, e := range mmTransferOwnership.TransferOwnershipMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cpa1, e.results.err
+			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmGetUserChats.GetUserChatsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserChats.GetUserChatsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserChats.GetUserChatsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserChats.GetUserChatsMock.defaultExpectation.paramPtrs
+	if mmTransferOwnership.TransferOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferOwnership.TransferOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockGetUserChatsParams{ctx, username}
+		mm_got := ChatServiceMockTransferOwnershipParams{ctx, chatID, actor, newOwner}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.newOwner != nil && !minimock.Equal(*mm_want_ptrs.newOwner, mm_got.newOwner) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter newOwner, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originNewOwner, *mm_want_ptrs.newOwner, mm_got.newOwner, minimock.Diff(*mm_want_ptrs.newOwner, mm_got.newOwner))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserChats.t.Errorf("ChatServiceMock.GetUserChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserChats.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserChats.GetUserChatsMock.defaultExpectation.results
+		mm_results := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserChats.t.Fatal("No results are set for the ChatServiceMock.GetUserChats")
+			mmTransferOwnership.t.Fatal("No results are set for the ChatServiceMock.TransferOwnership")
 		}
-		return (*mm_results).cpa1, (*mm_results).err
+		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmGetUserChats.funcGetUserChats != nil {
-		return mmGetUserChats.funcGetUserChats(ctx, username)
+	if mmTransferOwnership.funcTransferOwnership != nil {
+		return mmTransferOwnership.funcTransferOwnership(ctx, chatID, actor, newOwner)
 	}
-	mmGetUserChats.t.Fatalf("Unexpected call to ChatServiceMock.GetUserChats. %v %v", ctx, username)
+	mmTransferOwnership.t.Fatalf("Unexpected call to ChatServiceMock.TransferOwnership. %v %v %v %v", ctx, chatID, actor, newOwner)
 	return
 }
 
-// GetUserChatsAfterCounter returns a count of finished ChatServiceMock.GetUserChats invocations
-func (mmGetUserChats *ChatServiceMock) GetUserChatsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserChats.afterGetUserChatsCounter)
+// TransferOwnershipAfterCounter returns a count of finished ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.afterTransferOwnershipCounter)
 }
 
-// GetUserChatsBeforeCounter returns a count of ChatServiceMock.GetUserChats invocations
-func (mmGetUserChats *ChatServiceMock) GetUserChatsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserChats.beforeGetUserChatsCounter)
+// TransferOwnershipBeforeCounter returns a count of ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.beforeTransferOwnershipCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.GetUserChats.
+// Calls returns a list of arguments used in each call to ChatServiceMock.TransferOwnership.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserChats *mChatServiceMockGetUserChats) Calls() []*ChatServiceMockGetUserChatsParams {
-	mmGetUserChats.mutex.RLock()
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Calls() []*ChatServiceMockTransferOwnershipParams {
+	mmTransferOwnership.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockGetUserChatsParams, len(mmGetUserChats.callArgs))
-	copy(argCopy, mmGetUserChats.callArgs)
+	argCopy := make([]*ChatServiceMockTransferOwnershipParams, len(mmTransferOwnership.callArgs))
+	copy(argCopy, mmTransferOwnership.callArgs)
 
-	mmGetUserChats.mutex.RUnlock()
+	mmTransferOwnership.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserChatsDone returns true if the count of the GetUserChats invocations corresponds
+// MinimockTransferOwnershipDone returns true if the count of the TransferOwnership invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockGetUserChatsDone() bool {
-	if m.GetUserChatsMock.optional {
+func (m *ChatServiceMock) MinimockTransferOwnershipDone() bool {
+	if m.TransferOwnershipMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserChatsMock.expectations {
+	for _, e := range m.TransferOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserChatsMock.invocationsDone()
+	return m.TransferOwnershipMock.invocationsDone()
 }
 
-// MinimockGetUserChatsInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockGetUserChatsInspect() {
-	for _, e := range m.GetUserChatsMock.expectations {
+// MinimockTransferOwnershipInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockTransferOwnershipInspect() {
+	for _, e := range m.TransferOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserChatsCounter := mm_atomic.LoadUint64(&m.afterGetUserChatsCounter)
+	afterTransferOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferOwnershipCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserChatsMock.defaultExpectation != nil && afterGetUserChatsCounter < 1 {
-		if m.GetUserChatsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s", m.GetUserChatsMock.defaultExpectation.returnOrigin)
+	if m.TransferOwnershipMock.defaultExpectation != nil && afterTransferOwnershipCounter < 1 {
+		if m.TransferOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s", m.TransferOwnershipMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s with params: %#v", m.GetUserChatsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserChatsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s with params: %#v", m.TransferOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferOwnershipMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserChats != nil && afterGetUserChatsCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.GetUserChats at\n%s", m.funcGetUserChatsOrigin)
+	if m.funcTransferOwnership != nil && afterTransferOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s", m.funcTransferOwnershipOrigin)
 	}
 
-	if !m.GetUserChatsMock.invocationsDone() && afterGetUserChatsCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.GetUserChats at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserChatsMock.expectedInvocations), m.GetUserChatsMock.expectedInvocationsOrigin, afterGetUserChatsCounter)
+	if !m.TransferOwnershipMock.invocationsDone() && afterTransferOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.TransferOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferOwnershipMock.expectedInvocations), m.TransferOwnershipMock.expectedInvocationsOrigin, afterTransferOwnershipCounter)
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockUnpinMessage struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockUnpinMessageExpectation
+	expectations       []*ChatServiceMockUnpinMessageExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockUnpinMessageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockUnpinMessageExpectation specifies expectation struct of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockSendMessageParams
-	paramPtrs          *ChatServiceMockSendMessageParamPtrs
-	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
-	results            *ChatServiceMockSendMessageResults
+	params             *ChatServiceMockUnpinMessageParams
+	paramPtrs          *ChatServiceMockUnpinMessageParamPtrs
+	expectationOrigins ChatServiceMockUnpinMessageExpectationOrigins
+	results            *ChatServiceMockUnpinMessageResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
-	ctx     context.Context
-	message *model.Message
+// ChatServiceMockUnpinMessageParams contains parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	actor     string
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
-	ctx     *context.Context
-	message **model.Message
+// ChatServiceMockUnpinMessageParamPtrs contains pointers to parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	actor     *string
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
+// ChatServiceMockUnpinMessageResults contains results of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageResults struct {
 	ep1 *emptypb.Empty
 	err error
 }
 
-// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originMessage string
+// ChatServiceMockUnpinMessageOrigins contains origins of expectations of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originActor     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1572,292 +9784,348 @@ type ChatServiceMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Optional() *mChatServiceMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, message *model.Message) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Expect(ctx context.Context, chatID int64, messageID int64, actor string) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, message}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmUnpinMessage.defaultExpectation.params = &ChatServiceMockUnpinMessageParams{ctx, chatID, messageID, actor}
+	mmUnpinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmUnpinMessage
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUnpinMessage
 }
 
-// ExpectMessageParam2 sets up expected param message for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectMessageParam2(message *model.Message) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.message = &message
-	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+	mmUnpinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUnpinMessage
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, message *model.Message)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
 
-	return mmSendMessage
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmUnpinMessage
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectActorParam4 sets up expected param actor for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectActorParam4(actor string) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{ep1, err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmUnpinMessage
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Inspect accepts an inspector function that has same arguments as the ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, actor string)) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UnpinMessage")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
+
+	return mmUnpinMessage
+}
+
+// Return sets up results that will be returned by ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Return(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
+	}
+	mmUnpinMessage.defaultExpectation.results = &ChatServiceMockUnpinMessageResults{ep1, err}
+	mmUnpinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Set uses given function f to mock the ChatService.UnpinMessage method
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error)) *ChatServiceMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.UnpinMessage method")
+	}
+
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.UnpinMessage method")
+	}
+
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	mmUnpinMessage.mock.funcUnpinMessageOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
+}
+
+// When sets expectation for the ChatService.UnpinMessage which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, message *model.Message) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) When(ctx context.Context, chatID int64, messageID int64, actor string) *ChatServiceMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatServiceMockSendMessageParams{ctx, message},
-		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockUnpinMessageExpectation{
+		mock:               mmUnpinMessage.mock,
+		params:             &ChatServiceMockUnpinMessageParams{ctx, chatID, messageID, actor},
+		expectationOrigins: ChatServiceMockUnpinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{ep1, err}
+// Then sets up ChatService.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUnpinMessageExpectation) Then(ep1 *emptypb.Empty, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUnpinMessageResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.UnpinMessage should be invoked
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Times(n uint64) *mChatServiceMockUnpinMessage {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmUnpinMessage.mock.t.Fatalf("Times of ChatServiceMock.UnpinMessage mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	mmUnpinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, message *model.Message) (ep1 *emptypb.Empty, err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// UnpinMessage implements mm_service.ChatService
+func (mmUnpinMessage *ChatServiceMock) UnpinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (ep1 *emptypb.Empty, err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmUnpinMessage.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, chatID, messageID, actor)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, message}
+	mm_params := ChatServiceMockUnpinMessageParams{ctx, chatID, messageID, actor}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, message}
+		mm_got := ChatServiceMockUnpinMessageParams{ctx, chatID, messageID, actor}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmUnpinMessage.t.Fatal("No results are set for the ChatServiceMock.UnpinMessage")
 		}
 		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, message)
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, chatID, messageID, actor)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, message)
+	mmUnpinMessage.t.Fatalf("Unexpected call to ChatServiceMock.UnpinMessage. %v %v %v %v", ctx, chatID, messageID, actor)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// UnpinMessageAfterCounter returns a count of finished ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// UnpinMessageBeforeCounter returns a count of ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.UnpinMessage.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Calls() []*ChatServiceMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmUnpinMessage.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.UnpinMessageMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s", m.UnpinMessageMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s with params: %#v", m.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *m.UnpinMessageMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s", m.funcUnpinMessageOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UnpinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), m.UnpinMessageMock.expectedInvocationsOrigin, afterUnpinMessageCounter)
 	}
 }
 
@@ -1865,15 +10133,55 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddChatMembersInspect()
+
+			m.MinimockAddReactionInspect()
+
 			m.MinimockConnectChatInspect()
 
+			m.MinimockConnectChatEventsInspect()
+
 			m.MinimockCreateChatInspect()
 
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockGetChatMessagesInspect()
+
+			m.MinimockGetMessageStatusInspect()
+
+			m.MinimockGetPresenceInspect()
+
+			m.MinimockGetThreadInspect()
+
 			m.MinimockGetUserChatsInspect()
 
+			m.MinimockLeaveChatInspect()
+
+			m.MinimockListPinnedMessagesInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockRemoveChatMemberInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockRenameChatInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMemberRoleInspect()
+
+			m.MinimockSetTypingInspect()
+
+			m.MinimockTransferOwnershipInspect()
+
+			m.MinimockUnpinMessageInspect()
 		}
 	})
 }
@@ -1897,9 +10205,29 @@ func (m *ChatServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddChatMembersDone() &&
+		m.MinimockAddReactionDone() &&
 		m.MinimockConnectChatDone() &&
+		m.MinimockConnectChatEventsDone() &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockGetChatMessagesDone() &&
+		m.MinimockGetMessageStatusDone() &&
+		m.MinimockGetPresenceDone() &&
+		m.MinimockGetThreadDone() &&
 		m.MinimockGetUserChatsDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockLeaveChatDone() &&
+		m.MinimockListPinnedMessagesDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockRemoveChatMemberDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockRenameChatDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockSetTypingDone() &&
+		m.MinimockTransferOwnershipDone() &&
+		m.MinimockUnpinMessageDone()
 }
@@ -12,9 +12,30 @@ import (
 // ChatService - интерфейс сервисного слоя
 type ChatService interface {
 	CreateChat(ctx context.Context, chat *model.Chat) (int64, error)
-	DeleteChat(ctx context.Context, chatID int64) (*emptypb.Empty, error)
+	DeleteChat(ctx context.Context, chatID int64, actor string) (*emptypb.Empty, error)
 	GetUserChats(ctx context.Context, username string) ([]*model.Chat, error)
 	SendMessage(ctx context.Context, message *model.Message) (*emptypb.Empty, error)
-	ConnectChat(ctx context.Context, chatID int64, username string,
+	ConnectChat(ctx context.Context, chatID int64, username string, sinceMessageID int64,
 		stream chat_v1.ChatV1_ConnectChatServer) error
+	ConnectChatEvents(ctx context.Context, chatID int64, username string, sinceMessageID int64,
+		stream chat_v1.ChatV1_ConnectChatEventsServer) error
+	GetChatMessages(ctx context.Context, filter *model.MessagesFilter) (*model.MessagesPage, error)
+	GetThread(ctx context.Context, filter *model.MessagesFilter) (*model.MessagesPage, error)
+	SetTyping(ctx context.Context, chatID int64, username string, isTyping bool) (*emptypb.Empty, error)
+	MarkRead(ctx context.Context, chatID int64, username string, upToMessageID int64) (*emptypb.Empty, error)
+	GetMessageStatus(ctx context.Context, chatID int64, messageID int64, actor string) ([]*model.MessageStatus, error)
+	GetPresence(ctx context.Context, usernames []string) ([]*model.Presence, error)
+	EditMessage(ctx context.Context, chatID int64, messageID int64, actor string, text string) (*emptypb.Empty, error)
+	DeleteMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error)
+	AddReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (*emptypb.Empty, error)
+	RemoveReaction(ctx context.Context, chatID int64, messageID int64, actor string, emoji string) (*emptypb.Empty, error)
+	PinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error)
+	UnpinMessage(ctx context.Context, chatID int64, messageID int64, actor string) (*emptypb.Empty, error)
+	ListPinnedMessages(ctx context.Context, chatID int64, username string) ([]*model.Message, error)
+	AddChatMembers(ctx context.Context, chatID int64, actor string, usernames []string) (*emptypb.Empty, error)
+	RemoveChatMember(ctx context.Context, chatID int64, actor string, username string) (*emptypb.Empty, error)
+	LeaveChat(ctx context.Context, chatID int64, username string) (*emptypb.Empty, error)
+	RenameChat(ctx context.Context, chatID int64, actor string, name string) (*emptypb.Empty, error)
+	TransferOwnership(ctx context.Context, chatID int64, actor string, newOwner string) (*emptypb.Empty, error)
+	SetMemberRole(ctx context.Context, chatID int64, actor string, member string, role model.Role) (*emptypb.Empty, error)
 }
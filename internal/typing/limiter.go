@@ -0,0 +1,86 @@
+package typing
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimit сколько обновлений набора текста пользователь
+	// может отправить за окно по умолчанию
+	DefaultRateLimit = 5
+	// DefaultRateWindow окно ограничения частоты обновлений по умолчанию
+	DefaultRateWindow = time.Second
+)
+
+// usage количество обновлений пользователя в текущем окне
+type usage struct {
+	start time.Time
+	count int
+}
+
+// Limiter ограничивает частоту обновлений набора текста от одного
+// пользователя: не больше limit обновлений за window
+type Limiter struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	users     map[string]*usage
+	lastSweep time.Time
+}
+
+// NewLimiter возвращает ограничитель частоты обновлений набора текста
+func NewLimiter(limit int, window time.Duration) *Limiter {
+	if limit <= 0 {
+		limit = DefaultRateLimit
+	}
+	if window <= 0 {
+		window = DefaultRateWindow
+	}
+
+	return &Limiter{
+		limit:     limit,
+		window:    window,
+		users:     make(map[string]*usage),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow учитывает обновление пользователя и проверяет, что лимит не превышен
+func (l *Limiter) Allow(username string) bool {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	u, ok := l.users[username]
+	if !ok || now.Sub(u.start) >= l.window {
+		l.users[username] = &usage{start: now, count: 1}
+		return true
+	}
+
+	if u.count >= l.limit {
+		return false
+	}
+
+	u.count++
+	return true
+}
+
+// sweep не чаще раза в окно забывает пользователей, окно которых закончилось,
+// чтобы ограничитель не хранил всех, кто когда-либо набирал текст
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+
+	for username, u := range l.users {
+		if now.Sub(u.start) >= l.window {
+			delete(l.users, username)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/solumD/chat-server/internal/typing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = 5 * time.Second
+
+func TestTrackerSet(t *testing.T) {
+	t.Parallel()
+
+	type update struct {
+		isTyping bool
+		want     bool
+	}
+
+	tests := []struct {
+		name    string
+		updates []update
+	}{
+		{
+			name:    "start typing",
+			updates: []update{{isTyping: true, want: true}},
+		},
+		{
+			name:    "refresh doesn't change state",
+			updates: []update{{isTyping: true, want: true}, {isTyping: true, want: false}},
+		},
+		{
+			name:    "stop typing",
+			updates: []update{{isTyping: true, want: true}, {isTyping: false, want: true}},
+		},
+		{
+			name:    "stop without typing doesn't change state",
+			updates: []update{{isTyping: false, want: false}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := typing.New(time.Minute)
+			chatID, username := gofakeit.Int64(), gofakeit.Username()
+
+			for _, u := range tt.updates {
+				require.Equal(t, u.want, tracker.Set(chatID, username, u.isTyping))
+				require.Equal(t, u.isTyping, tracker.IsTyping(chatID, username))
+			}
+
+			// состояние в другом чате не меняется
+			require.False(t, tracker.IsTyping(chatID+1, username))
+		})
+	}
+}
+
+func TestTrackerExpire(t *testing.T) {
+	t.Parallel()
+
+	const ttl = 50 * time.Millisecond
+
+	type expired struct {
+		chatID   int64
+		username string
+	}
+
+	tracker := typing.New(ttl)
+	expiredCh := make(chan expired, 1)
+	tracker.OnExpire(func(chatID int64, username string) {
+		expiredCh <- expired{chatID: chatID, username: username}
+	})
+
+	chatID, username := gofakeit.Int64(), gofakeit.Username()
+	started := time.Now()
+	require.True(t, tracker.Set(chatID, username, true))
+
+	// обновления продлевают набор текста дольше ttl
+	for i := 0; i < 4; i++ {
+		time.Sleep(ttl / 2)
+		require.False(t, tracker.Set(chatID, username, true))
+	}
+
+	select {
+	case e := <-expiredCh:
+		require.Equal(t, expired{chatID: chatID, username: username}, e)
+		require.GreaterOrEqual(t, time.Since(started), 2*ttl)
+	case <-time.After(waitTimeout):
+		t.Fatal("typing didn't expire")
+	}
+	require.False(t, tracker.IsTyping(chatID, username))
+
+	// явно завершенный набор текста не истекает
+	require.True(t, tracker.Set(chatID, username, true))
+	require.True(t, tracker.Set(chatID, username, false))
+
+	select {
+	case e := <-expiredCh:
+		t.Fatalf("unexpected expiry: %v", e)
+	case <-time.After(2 * ttl):
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limit  = 3
+		window = 100 * time.Millisecond
+	)
+
+	limiter := typing.NewLimiter(limit, window)
+	alice, bob := gofakeit.Username(), gofakeit.Username()
+
+	for i := 0; i < limit; i++ {
+		require.True(t, limiter.Allow(alice))
+	}
+	require.False(t, limiter.Allow(alice))
+
+	// лимит у каждого пользователя свой
+	require.True(t, limiter.Allow(bob))
+
+	require.Eventually(t, func() bool {
+		return limiter.Allow(alice)
+	}, waitTimeout, window/10)
+}
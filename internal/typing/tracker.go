@@ -0,0 +1,112 @@
+package typing
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultTTL через сколько после последнего обновления набор текста
+// считается завершенным по умолчанию
+const DefaultTTL = 5 * time.Second
+
+// ExpireHandler вызывается, когда пользователь перестал набирать текст,
+// не сообщив об этом
+type ExpireHandler func(chatID int64, username string)
+
+// key пользователь в чате
+type key struct {
+	chatID   int64
+	username string
+}
+
+// entry набор текста пользователем. Таймер завершает набор, если
+// за ttl не пришло обновление
+type entry struct {
+	timer *time.Timer
+}
+
+// Tracker хранит в памяти, кто из пользователей набирает текст в чатах.
+// Состояние не сохраняется в БД: пользователь, который перестал обновлять
+// его в течение ttl, считается закончившим набор текста
+type Tracker struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	typing   map[key]*entry
+	onExpire ExpireHandler
+}
+
+// New возвращает новый трекер набора текста
+func New(ttl time.Duration) *Tracker {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Tracker{
+		ttl:    ttl,
+		typing: make(map[key]*entry),
+	}
+}
+
+// OnExpire задает обработчик завершения набора текста по истечении ttl
+func (t *Tracker) OnExpire(handler ExpireHandler) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.onExpire = handler
+}
+
+// Set обновляет состояние набора текста пользователем в чате и возвращает
+// true, если пользователь начал или закончил набирать текст. Повторное
+// начало набора только продлевает его на ttl
+func (t *Tracker) Set(chatID int64, username string, isTyping bool) bool {
+	k := key{chatID: chatID, username: username}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, wasTyping := t.typing[k]
+	if wasTyping {
+		prev.timer.Stop()
+		delete(t.typing, k)
+	}
+
+	if !isTyping {
+		return wasTyping
+	}
+
+	// у каждого обновления свой таймер, поэтому сработавший старый
+	// таймер не завершит продленный набор текста
+	e := &entry{}
+	e.timer = time.AfterFunc(t.ttl, func() {
+		t.expire(k, e)
+	})
+	t.typing[k] = e
+
+	return !wasTyping
+}
+
+// IsTyping проверяет, набирает ли пользователь текст в чате
+func (t *Tracker) IsTyping(chatID int64, username string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.typing[key{chatID: chatID, username: username}]
+	return ok
+}
+
+func (t *Tracker) expire(k key, e *entry) {
+	t.mu.Lock()
+	if t.typing[k] != e {
+		t.mu.Unlock()
+		return
+	}
+
+	delete(t.typing, k)
+	handler := t.onExpire
+	t.mu.Unlock()
+
+	if handler != nil {
+		handler(k.chatID, k.username)
+	}
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id INT NOT NULL REFERENCES chats(id),
+    message_id INT NOT NULL REFERENCES messages(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMP
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE processed_at IS NULL;
+
+
+-- +goose Down
+DROP INDEX outbox_pending_idx;
+DROP TABLE outbox;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- убираем дубли участников, чтобы добавление в чат было идемпотентным
+DELETE FROM users_in_chats a
+    USING users_in_chats b
+WHERE a.chat_id = b.chat_id
+  AND a.user_id = b.user_id
+  AND a.id > b.id;
+
+CREATE UNIQUE INDEX users_in_chats_chat_user_idx ON users_in_chats (chat_id, user_id);
+
+
+-- +goose Down
+DROP INDEX users_in_chats_chat_user_idx;
\ No newline at end of file
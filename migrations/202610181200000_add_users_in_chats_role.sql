@@ -0,0 +1,16 @@
+-- +goose Up
+-- 1 - владелец, 2 - администратор, 3 - участник
+ALTER TABLE users_in_chats ADD COLUMN role SMALLINT NOT NULL DEFAULT 3;
+
+-- создатель существующих чатов неизвестен, поэтому владельцем
+-- становится участник, добавленный в чат первым
+UPDATE users_in_chats SET role = 1
+WHERE id IN (SELECT MIN(id) FROM users_in_chats GROUP BY chat_id);
+
+-- у чата не может быть больше одного владельца
+CREATE UNIQUE INDEX users_in_chats_owner_idx ON users_in_chats (chat_id) WHERE role = 1;
+
+
+-- +goose Down
+DROP INDEX users_in_chats_owner_idx;
+ALTER TABLE users_in_chats DROP COLUMN role;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- +goose Up
+-- edited_at не задано у неизмененных сообщений, удаленные сообщения
+-- остаются в истории с пометкой is_deleted
+ALTER TABLE messages ADD COLUMN edited_at TIMESTAMP;
+ALTER TABLE messages ADD COLUMN is_deleted INT NOT NULL DEFAULT 0;
+
+-- события outbox бывают о новом, измененном и удаленном сообщении
+ALTER TABLE outbox ADD COLUMN event_type VARCHAR(32) NOT NULL DEFAULT 'message';
+
+
+-- +goose Down
+ALTER TABLE outbox DROP COLUMN event_type;
+ALTER TABLE messages DROP COLUMN is_deleted;
+ALTER TABLE messages DROP COLUMN edited_at;
\ No newline at end of file
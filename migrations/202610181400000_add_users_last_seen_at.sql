@@ -0,0 +1,7 @@
+-- +goose Up
+-- last_seen_at не задано у пользователей, которые ни разу не подключались к чатам
+ALTER TABLE users ADD COLUMN last_seen_at TIMESTAMP;
+
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN last_seen_at;
\ No newline at end of file
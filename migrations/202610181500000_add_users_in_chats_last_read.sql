@@ -0,0 +1,11 @@
+-- +goose Up
+-- участник прочитал все сообщения чата с id не больше last_read_message_id
+ALTER TABLE users_in_chats ADD COLUMN last_read_message_id INT NOT NULL DEFAULT 0;
+
+-- история, отправленная до появления счетчиков, считается прочитанной
+UPDATE users_in_chats uic
+SET last_read_message_id = COALESCE((SELECT MAX(m.id) FROM messages m WHERE m.chat_id = uic.chat_id), 0);
+
+
+-- +goose Down
+ALTER TABLE users_in_chats DROP COLUMN last_read_message_id;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- +goose Up
+-- когда сообщение было доставлено в живой stream получателя и когда он его прочитал.
+-- Сообщения, прочитанные до появления отметок, отмечены только курсором прочтения
+CREATE TABLE message_receipts (
+    message_id INT NOT NULL REFERENCES messages(id),
+    user_id INT NOT NULL REFERENCES users(id),
+    delivered_at TIMESTAMP,
+    read_at TIMESTAMP,
+    PRIMARY KEY (message_id, user_id)
+);
+
+
+-- +goose Down
+DROP TABLE message_receipts;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +goose Up
+-- сообщение, на которое отвечает сообщение; NULL, если сообщение не ответ
+ALTER TABLE messages ADD COLUMN reply_to_message_id INT REFERENCES messages(id);
+
+-- выборка ответов и подсчет их количества
+CREATE INDEX messages_reply_to_idx ON messages (reply_to_message_id, id) WHERE reply_to_message_id IS NOT NULL;
+
+
+-- +goose Down
+DROP INDEX messages_reply_to_idx;
+ALTER TABLE messages DROP COLUMN reply_to_message_id;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+-- реакции пользователей на сообщения, каждый пользователь ставит каждую реакцию на сообщение не больше одного раза
+CREATE TABLE message_reactions (
+    message_id INT NOT NULL REFERENCES messages(id),
+    user_id INT NOT NULL REFERENCES users(id),
+    emoji TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, user_id, emoji)
+);
+
+
+-- +goose Down
+DROP TABLE message_reactions;
\ No newline at end of file
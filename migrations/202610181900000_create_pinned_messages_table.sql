@@ -0,0 +1,15 @@
+-- +goose Up
+-- закрепленные сообщения чатов
+CREATE TABLE pinned_messages (
+    message_id INT PRIMARY KEY REFERENCES messages(id),
+    chat_id INT NOT NULL REFERENCES chats(id),
+    user_id INT NOT NULL REFERENCES users(id),
+    pinned_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX pinned_messages_chat_idx ON pinned_messages (chat_id, pinned_at);
+
+
+-- +goose Down
+DROP INDEX pinned_messages_chat_idx;
+DROP TABLE pinned_messages;
\ No newline at end of file
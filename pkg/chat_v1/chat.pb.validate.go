@@ -68,6 +68,8 @@ func (m *CreateChatRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Username
+
 	if len(errors) > 0 {
 		return CreateChatRequestMultiError(errors)
 	}
@@ -278,6 +280,8 @@ func (m *DeleteChatRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Username
+
 	if len(errors) > 0 {
 		return DeleteChatRequestMultiError(errors)
 	}
@@ -384,6 +388,17 @@ func (m *ConnectChatRequest) validate(all bool) error {
 
 	// no validation rules for Username
 
+	if m.GetSinceMessageId() < 0 {
+		err := ConnectChatRequestValidationError{
+			field:  "SinceMessageId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ConnectChatRequestMultiError(errors)
 	}
@@ -489,6 +504,106 @@ func (m *Message) validate(all bool) error {
 
 	// no validation rules for Text
 
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
+	// no validation rules for ReplyToMessageId
+
+	// no validation rules for ReplyCount
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MessageMultiError(errors)
 	}
@@ -566,163 +681,45 @@ var _ interface {
 	ErrorName() string
 } = MessageValidationError{}
 
-// Validate checks the field values on SendMessageRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SendMessageRequest) Validate() error {
+// Validate checks the field values on Reaction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reaction) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendMessageRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SendMessageRequestMultiError, or nil if none found.
-func (m *SendMessageRequest) ValidateAll() error {
+// ValidateAll checks the field values on Reaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionMultiError, or nil
+// if none found.
+func (m *Reaction) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendMessageRequest) validate(all bool) error {
+func (m *Reaction) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if !_SendMessageRequest_From_Pattern.MatchString(m.GetFrom()) {
-		err := SendMessageRequestValidationError{
-			field:  "From",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Text
-
-	if len(errors) > 0 {
-		return SendMessageRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// SendMessageRequestMultiError is an error wrapping multiple validation errors
-// returned by SendMessageRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SendMessageRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m SendMessageRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m SendMessageRequestMultiError) AllErrors() []error { return m }
-
-// SendMessageRequestValidationError is the validation error returned by
-// SendMessageRequest.Validate if the designated constraints aren't met.
-type SendMessageRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e SendMessageRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e SendMessageRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e SendMessageRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e SendMessageRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e SendMessageRequestValidationError) ErrorName() string {
-	return "SendMessageRequestValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e SendMessageRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sSendMessageRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = SendMessageRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = SendMessageRequestValidationError{}
-
-var _SendMessageRequest_From_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
-
-// Validate checks the field values on GetUserChatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserChatsRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetUserChatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserChatsRequestMultiError, or nil if none found.
-func (m *GetUserChatsRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetUserChatsRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for Emoji
 
-	var errors []error
-
-	// no validation rules for Username
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return GetUserChatsRequestMultiError(errors)
+		return ReactionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserChatsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserChatsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetUserChatsRequestMultiError []error
+// ReactionMultiError is an error wrapping multiple validation errors returned
+// by Reaction.ValidateAll() if the designated constraints aren't met.
+type ReactionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserChatsRequestMultiError) Error() string {
+func (m ReactionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -731,11 +728,11 @@ func (m GetUserChatsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserChatsRequestMultiError) AllErrors() []error { return m }
+func (m ReactionMultiError) AllErrors() []error { return m }
 
-// GetUserChatsRequestValidationError is the validation error returned by
-// GetUserChatsRequest.Validate if the designated constraints aren't met.
-type GetUserChatsRequestValidationError struct {
+// ReactionValidationError is the validation error returned by
+// Reaction.Validate if the designated constraints aren't met.
+type ReactionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -743,24 +740,22 @@ type GetUserChatsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserChatsRequestValidationError) Field() string { return e.field }
+func (e ReactionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserChatsRequestValidationError) Reason() string { return e.reason }
+func (e ReactionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserChatsRequestValidationError) Cause() error { return e.cause }
+func (e ReactionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserChatsRequestValidationError) Key() bool { return e.key }
+func (e ReactionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserChatsRequestValidationError) ErrorName() string {
-	return "GetUserChatsRequestValidationError"
-}
+func (e ReactionValidationError) ErrorName() string { return "ReactionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserChatsRequestValidationError) Error() string {
+func (e ReactionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -772,14 +767,14 @@ func (e GetUserChatsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserChatsRequest.%s: %s%s",
+		"invalid %sReaction.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserChatsRequestValidationError{}
+var _ error = ReactionValidationError{}
 
 var _ interface {
 	Field() string
@@ -787,78 +782,4631 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserChatsRequestValidationError{}
+} = ReactionValidationError{}
 
-// Validate checks the field values on GetUserChatsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserChatsResponse) Validate() error {
+// Validate checks the field values on ChatEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserChatsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserChatsResponseMultiError, or nil if none found.
-func (m *GetUserChatsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ChatEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatEventMultiError, or nil
+// if none found.
+func (m *ChatEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserChatsResponse) validate(all bool) error {
+func (m *ChatEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetChats() {
-		_, _ = idx, item
+	switch v := m.Event.(type) {
+	case *ChatEvent_Message:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetMessage()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUserChatsResponseValidationError{
-						field:  fmt.Sprintf("Chats[%v]", idx),
+					errors = append(errors, ChatEventValidationError{
+						field:  "Message",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUserChatsResponseValidationError{
-						field:  fmt.Sprintf("Chats[%v]", idx),
+					errors = append(errors, ChatEventValidationError{
+						field:  "Message",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUserChatsResponseValidationError{
-					field:  fmt.Sprintf("Chats[%v]", idx),
+				return ChatEventValidationError{
+					field:  "Message",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	}
-
-	if len(errors) > 0 {
-		return GetUserChatsResponseMultiError(errors)
-	}
+	case *ChatEvent_MessageEdited:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	return nil
+		if all {
+			switch v := interface{}(m.GetMessageEdited()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageEdited",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageEdited",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessageEdited()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MessageEdited",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_MessageDeleted:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessageDeleted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageDeleted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageDeleted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessageDeleted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MessageDeleted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_MemberAdded:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMemberAdded()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MemberAdded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MemberAdded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMemberAdded()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MemberAdded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_MemberRemoved:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMemberRemoved()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MemberRemoved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MemberRemoved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMemberRemoved()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MemberRemoved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Typing:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTyping()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Typing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Typing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTyping()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Typing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Presence:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPresence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Presence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Presence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Read:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRead()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Read",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Read",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRead()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Read",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_ReactionsChanged:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReactionsChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "ReactionsChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "ReactionsChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReactionsChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "ReactionsChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_MessagePinned:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessagePinned()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessagePinned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessagePinned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessagePinned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MessagePinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_MessageUnpinned:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessageUnpinned()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageUnpinned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "MessageUnpinned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessageUnpinned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "MessageUnpinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Resync:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetResync()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Resync",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Resync",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResync()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Resync",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ChatEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatEventMultiError is an error wrapping multiple validation errors returned
+// by ChatEvent.ValidateAll() if the designated constraints aren't met.
+type ChatEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatEventMultiError) AllErrors() []error { return m }
+
+// ChatEventValidationError is the validation error returned by
+// ChatEvent.Validate if the designated constraints aren't met.
+type ChatEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatEventValidationError) ErrorName() string { return "ChatEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatEventValidationError{}
+
+// Validate checks the field values on Resync with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Resync) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Resync with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ResyncMultiError, or nil if none found.
+func (m *Resync) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Resync) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResyncMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResyncMultiError is an error wrapping multiple validation errors returned by
+// Resync.ValidateAll() if the designated constraints aren't met.
+type ResyncMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResyncMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResyncMultiError) AllErrors() []error { return m }
+
+// ResyncValidationError is the validation error returned by Resync.Validate if
+// the designated constraints aren't met.
+type ResyncValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResyncValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResyncValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResyncValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResyncValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResyncValidationError) ErrorName() string { return "ResyncValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResyncValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResync.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResyncValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResyncValidationError{}
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for UpToMessageId
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on Typing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Typing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Typing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TypingMultiError, or nil if none found.
+func (m *Typing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Typing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for IsTyping
+
+	if len(errors) > 0 {
+		return TypingMultiError(errors)
+	}
+
+	return nil
+}
+
+// TypingMultiError is an error wrapping multiple validation errors returned by
+// Typing.ValidateAll() if the designated constraints aren't met.
+type TypingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TypingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TypingMultiError) AllErrors() []error { return m }
+
+// TypingValidationError is the validation error returned by Typing.Validate if
+// the designated constraints aren't met.
+type TypingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypingValidationError) ErrorName() string { return "TypingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTyping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TypingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypingValidationError{}
+
+// Validate checks the field values on MessageDeleted with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageDeleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageDeleted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageDeletedMultiError,
+// or nil if none found.
+func (m *MessageDeleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageDeleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return MessageDeletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageDeletedMultiError is an error wrapping multiple validation errors
+// returned by MessageDeleted.ValidateAll() if the designated constraints
+// aren't met.
+type MessageDeletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageDeletedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageDeletedMultiError) AllErrors() []error { return m }
+
+// MessageDeletedValidationError is the validation error returned by
+// MessageDeleted.Validate if the designated constraints aren't met.
+type MessageDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageDeletedValidationError) ErrorName() string { return "MessageDeletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageDeletedValidationError{}
+
+// Validate checks the field values on MessageUnpinned with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageUnpinned) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageUnpinned with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageUnpinnedMultiError, or nil if none found.
+func (m *MessageUnpinned) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageUnpinned) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return MessageUnpinnedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageUnpinnedMultiError is an error wrapping multiple validation errors
+// returned by MessageUnpinned.ValidateAll() if the designated constraints
+// aren't met.
+type MessageUnpinnedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageUnpinnedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageUnpinnedMultiError) AllErrors() []error { return m }
+
+// MessageUnpinnedValidationError is the validation error returned by
+// MessageUnpinned.Validate if the designated constraints aren't met.
+type MessageUnpinnedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageUnpinnedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageUnpinnedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageUnpinnedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageUnpinnedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageUnpinnedValidationError) ErrorName() string { return "MessageUnpinnedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageUnpinnedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageUnpinned.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageUnpinnedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageUnpinnedValidationError{}
+
+// Validate checks the field values on ChatMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatMemberMultiError, or
+// nil if none found.
+func (m *ChatMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return ChatMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatMemberMultiError is an error wrapping multiple validation errors
+// returned by ChatMember.ValidateAll() if the designated constraints aren't met.
+type ChatMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatMemberMultiError) AllErrors() []error { return m }
+
+// ChatMemberValidationError is the validation error returned by
+// ChatMember.Validate if the designated constraints aren't met.
+type ChatMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatMemberValidationError) ErrorName() string { return "ChatMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatMemberValidationError{}
+
+// Validate checks the field values on SendMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendMessageRequestMultiError, or nil if none found.
+func (m *SendMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_SendMessageRequest_From_Pattern.MatchString(m.GetFrom()) {
+		err := SendMessageRequestValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Text
+
+	if m.GetReplyToMessageId() < 0 {
+		err := SendMessageRequestValidationError{
+			field:  "ReplyToMessageId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SendMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by SendMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMessageRequestMultiError) AllErrors() []error { return m }
+
+// SendMessageRequestValidationError is the validation error returned by
+// SendMessageRequest.Validate if the designated constraints aren't met.
+type SendMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMessageRequestValidationError) ErrorName() string {
+	return "SendMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMessageRequestValidationError{}
+
+var _SendMessageRequest_From_Pattern = regexp.MustCompile("^[a-zA-Z0-9]*$")
+
+// Validate checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageRequestMultiError, or nil if none found.
+func (m *EditMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := EditMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return EditMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by EditMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageRequestMultiError) AllErrors() []error { return m }
+
+// EditMessageRequestValidationError is the validation error returned by
+// EditMessageRequest.Validate if the designated constraints aren't met.
+type EditMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageRequestValidationError) ErrorName() string {
+	return "EditMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageRequestValidationError{}
+
+// Validate checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageRequestMultiError, or nil if none found.
+func (m *DeleteMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := DeleteMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMessageRequestValidationError is the validation error returned by
+// DeleteMessageRequest.Validate if the designated constraints aren't met.
+type DeleteMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageRequestValidationError) ErrorName() string {
+	return "DeleteMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageRequestValidationError{}
+
+// Validate checks the field values on SetTypingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetTypingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTypingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTypingRequestMultiError, or nil if none found.
+func (m *SetTypingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTypingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	// no validation rules for IsTyping
+
+	if len(errors) > 0 {
+		return SetTypingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTypingRequestMultiError is an error wrapping multiple validation errors
+// returned by SetTypingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetTypingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTypingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTypingRequestMultiError) AllErrors() []error { return m }
+
+// SetTypingRequestValidationError is the validation error returned by
+// SetTypingRequest.Validate if the designated constraints aren't met.
+type SetTypingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTypingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTypingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTypingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTypingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTypingRequestValidationError) ErrorName() string { return "SetTypingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetTypingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTypingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTypingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTypingRequestValidationError{}
+
+// Validate checks the field values on GetUserChatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserChatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserChatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserChatsRequestMultiError, or nil if none found.
+func (m *GetUserChatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserChatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return GetUserChatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserChatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserChatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserChatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserChatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserChatsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserChatsRequestValidationError is the validation error returned by
+// GetUserChatsRequest.Validate if the designated constraints aren't met.
+type GetUserChatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserChatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserChatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserChatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserChatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserChatsRequestValidationError) ErrorName() string {
+	return "GetUserChatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserChatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserChatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserChatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserChatsRequestValidationError{}
+
+// Validate checks the field values on GetUserChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserChatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserChatsResponseMultiError, or nil if none found.
+func (m *GetUserChatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserChatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserChatsResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserChatsResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserChatsResponseValidationError{
+					field:  fmt.Sprintf("Chats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserChatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserChatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserChatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserChatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserChatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserChatsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserChatsResponseValidationError is the validation error returned by
+// GetUserChatsResponse.Validate if the designated constraints aren't met.
+type GetUserChatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserChatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserChatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserChatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserChatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserChatsResponseValidationError) ErrorName() string {
+	return "GetUserChatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserChatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserChatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserChatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserChatsResponseValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionRequestMultiError, or nil if none found.
+func (m *AddReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := AddReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 16 {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddReactionRequestMultiError is an error wrapping multiple validation errors
+// returned by AddReactionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionRequestMultiError) AllErrors() []error { return m }
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionRequestMultiError, or nil if none found.
+func (m *RemoveReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := RemoveReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 16 {
+		err := RemoveReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on PinMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageRequestMultiError, or nil if none found.
+func (m *PinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := PinMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by PinMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageRequestMultiError) AllErrors() []error { return m }
+
+// PinMessageRequestValidationError is the validation error returned by
+// PinMessageRequest.Validate if the designated constraints aren't met.
+type PinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageRequestValidationError) ErrorName() string {
+	return "PinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageRequestValidationError{}
+
+// Validate checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageRequestMultiError, or nil if none found.
+func (m *UnpinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := UnpinMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageRequestMultiError) AllErrors() []error { return m }
+
+// UnpinMessageRequestValidationError is the validation error returned by
+// UnpinMessageRequest.Validate if the designated constraints aren't met.
+type UnpinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageRequestValidationError) ErrorName() string {
+	return "UnpinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageRequestValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesRequestMultiError, or nil if none found.
+func (m *ListPinnedMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPinnedMessagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPinnedMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesRequestValidationError is the validation error returned by
+// ListPinnedMessagesRequest.Validate if the designated constraints aren't met.
+type ListPinnedMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesRequestValidationError) ErrorName() string {
+	return "ListPinnedMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesRequestValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesResponseMultiError, or nil if none found.
+func (m *ListPinnedMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPinnedMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPinnedMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPinnedMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesResponseValidationError is the validation error returned
+// by ListPinnedMessagesResponse.Validate if the designated constraints aren't met.
+type ListPinnedMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesResponseValidationError) ErrorName() string {
+	return "ListPinnedMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesResponseValidationError{}
+
+// Validate checks the field values on GetChatMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChatMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatMessagesRequestMultiError, or nil if none found.
+func (m *GetChatMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetBeforeId() < 0 {
+		err := GetChatMessagesRequestValidationError{
+			field:  "BeforeId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterId() < 0 {
+		err := GetChatMessagesRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := GetChatMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetChatMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChatMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChatMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatMessagesRequestMultiError) AllErrors() []error { return m }
+
+// GetChatMessagesRequestValidationError is the validation error returned by
+// GetChatMessagesRequest.Validate if the designated constraints aren't met.
+type GetChatMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatMessagesRequestValidationError) ErrorName() string {
+	return "GetChatMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChatMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatMessagesRequestValidationError{}
+
+// Validate checks the field values on GetChatMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChatMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatMessagesResponseMultiError, or nil if none found.
+func (m *GetChatMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChatMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChatMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChatMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasMore
+
+	// no validation rules for OldestId
+
+	// no validation rules for NewestId
+
+	if len(errors) > 0 {
+		return GetChatMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChatMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChatMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatMessagesResponseMultiError) AllErrors() []error { return m }
+
+// GetChatMessagesResponseValidationError is the validation error returned by
+// GetChatMessagesResponse.Validate if the designated constraints aren't met.
+type GetChatMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatMessagesResponseValidationError) ErrorName() string {
+	return "GetChatMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChatMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatMessagesResponseValidationError{}
+
+// Validate checks the field values on GetThreadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetThreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetThreadRequestMultiError, or nil if none found.
+func (m *GetThreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetThreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := GetThreadRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBeforeId() < 0 {
+		err := GetThreadRequestValidationError{
+			field:  "BeforeId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterId() < 0 {
+		err := GetThreadRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := GetThreadRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetThreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetThreadRequestMultiError is an error wrapping multiple validation errors
+// returned by GetThreadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetThreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetThreadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetThreadRequestMultiError) AllErrors() []error { return m }
+
+// GetThreadRequestValidationError is the validation error returned by
+// GetThreadRequest.Validate if the designated constraints aren't met.
+type GetThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadRequestValidationError) ErrorName() string { return "GetThreadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadRequestValidationError{}
+
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetUpToMessageId() <= 0 {
+		err := MarkReadRequestValidationError{
+			field:  "UpToMessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+// Validate checks the field values on GetMessageStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageStatusRequestMultiError, or nil if none found.
+func (m *GetMessageStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if m.GetMessageId() <= 0 {
+		err := GetMessageStatusRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMessageStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMessageStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetMessageStatusRequestValidationError is the validation error returned by
+// GetMessageStatusRequest.Validate if the designated constraints aren't met.
+type GetMessageStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageStatusRequestValidationError) ErrorName() string {
+	return "GetMessageStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageStatusRequestValidationError{}
+
+// Validate checks the field values on GetMessageStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageStatusResponseMultiError, or nil if none found.
+func (m *GetMessageStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMessageStatusResponseValidationError{
+						field:  fmt.Sprintf("Recipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMessageStatusResponseValidationError{
+						field:  fmt.Sprintf("Recipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessageStatusResponseValidationError{
+					field:  fmt.Sprintf("Recipients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMessageStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMessageStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetMessageStatusResponseValidationError is the validation error returned by
+// GetMessageStatusResponse.Validate if the designated constraints aren't met.
+type GetMessageStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageStatusResponseValidationError) ErrorName() string {
+	return "GetMessageStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageStatusResponseValidationError{}
+
+// Validate checks the field values on RecipientStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecipientStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecipientStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecipientStatusMultiError, or nil if none found.
+func (m *RecipientStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecipientStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Delivered
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientStatusValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientStatusValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientStatusValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Read
+
+	if all {
+		switch v := interface{}(m.GetReadAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientStatusValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientStatusValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientStatusValidationError{
+				field:  "ReadAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecipientStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecipientStatusMultiError is an error wrapping multiple validation errors
+// returned by RecipientStatus.ValidateAll() if the designated constraints
+// aren't met.
+type RecipientStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecipientStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecipientStatusMultiError) AllErrors() []error { return m }
+
+// RecipientStatusValidationError is the validation error returned by
+// RecipientStatus.Validate if the designated constraints aren't met.
+type RecipientStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecipientStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecipientStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecipientStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecipientStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecipientStatusValidationError) ErrorName() string { return "RecipientStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecipientStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecipientStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecipientStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecipientStatusValidationError{}
+
+// Validate checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceRequestMultiError, or nil if none found.
+func (m *GetPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUsernames()); l < 1 || l > 100 {
+		err := GetPresenceRequestValidationError{
+			field:  "Usernames",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceRequestValidationError is the validation error returned by
+// GetPresenceRequest.Validate if the designated constraints aren't met.
+type GetPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceRequestValidationError) ErrorName() string {
+	return "GetPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceRequestValidationError{}
+
+// Validate checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceResponseMultiError, or nil if none found.
+func (m *GetPresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPresences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPresenceResponseValidationError{
+					field:  fmt.Sprintf("Presences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceResponseMultiError) AllErrors() []error { return m }
+
+// GetPresenceResponseValidationError is the validation error returned by
+// GetPresenceResponse.Validate if the designated constraints aren't met.
+type GetPresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceResponseValidationError) ErrorName() string {
+	return "GetPresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceResponseValidationError{}
+
+// Validate checks the field values on Presence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Presence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Presence with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceMultiError, or nil
+// if none found.
+func (m *Presence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Presence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Online
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PresenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceMultiError is an error wrapping multiple validation errors returned
+// by Presence.ValidateAll() if the designated constraints aren't met.
+type PresenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceMultiError) AllErrors() []error { return m }
+
+// PresenceValidationError is the validation error returned by
+// Presence.Validate if the designated constraints aren't met.
+type PresenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceValidationError) ErrorName() string { return "PresenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceValidationError{}
+
+// Validate checks the field values on ChatInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatInfoMultiError, or nil
+// if none found.
+func (m *ChatInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return ChatInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatInfoMultiError is an error wrapping multiple validation errors returned
+// by ChatInfo.ValidateAll() if the designated constraints aren't met.
+type ChatInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatInfoMultiError) AllErrors() []error { return m }
+
+// ChatInfoValidationError is the validation error returned by
+// ChatInfo.Validate if the designated constraints aren't met.
+type ChatInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatInfoValidationError) ErrorName() string { return "ChatInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatInfoValidationError{}
+
+// Validate checks the field values on AddChatMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddChatMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddChatMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddChatMembersRequestMultiError, or nil if none found.
+func (m *AddChatMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddChatMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if len(m.GetUsernames()) < 1 {
+		err := AddChatMembersRequestValidationError{
+			field:  "Usernames",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsernames() {
+		_, _ = idx, item
+
+		if !_AddChatMembersRequest_Usernames_Pattern.MatchString(item) {
+			err := AddChatMembersRequestValidationError{
+				field:  fmt.Sprintf("Usernames[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddChatMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddChatMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by AddChatMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddChatMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddChatMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddChatMembersRequestMultiError) AllErrors() []error { return m }
+
+// AddChatMembersRequestValidationError is the validation error returned by
+// AddChatMembersRequest.Validate if the designated constraints aren't met.
+type AddChatMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddChatMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddChatMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddChatMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddChatMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddChatMembersRequestValidationError) ErrorName() string {
+	return "AddChatMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddChatMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddChatMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddChatMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddChatMembersRequestValidationError{}
+
+var _AddChatMembersRequest_Usernames_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RemoveChatMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveChatMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveChatMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveChatMemberRequestMultiError, or nil if none found.
+func (m *RemoveChatMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveChatMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if !_RemoveChatMemberRequest_Member_Pattern.MatchString(m.GetMember()) {
+		err := RemoveChatMemberRequestValidationError{
+			field:  "Member",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveChatMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveChatMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveChatMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveChatMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveChatMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveChatMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveChatMemberRequestValidationError is the validation error returned by
+// RemoveChatMemberRequest.Validate if the designated constraints aren't met.
+type RemoveChatMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveChatMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveChatMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveChatMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveChatMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveChatMemberRequestValidationError) ErrorName() string {
+	return "RemoveChatMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveChatMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveChatMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveChatMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveChatMemberRequestValidationError{}
+
+var _RemoveChatMemberRequest_Member_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on LeaveChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveChatRequestMultiError, or nil if none found.
+func (m *LeaveChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return LeaveChatRequestMultiError(errors)
+	}
+
+	return nil
 }
 
-// GetUserChatsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUserChatsResponse.ValidateAll() if the designated
+// LeaveChatRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveChatRequestMultiError) AllErrors() []error { return m }
+
+// LeaveChatRequestValidationError is the validation error returned by
+// LeaveChatRequest.Validate if the designated constraints aren't met.
+type LeaveChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveChatRequestValidationError) ErrorName() string { return "LeaveChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaveChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveChatRequestValidationError{}
+
+// Validate checks the field values on RenameChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameChatRequestMultiError, or nil if none found.
+func (m *RenameChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if !_RenameChatRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RenameChatRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameChatRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameChatRequestMultiError) AllErrors() []error { return m }
+
+// RenameChatRequestValidationError is the validation error returned by
+// RenameChatRequest.Validate if the designated constraints aren't met.
+type RenameChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameChatRequestValidationError) ErrorName() string {
+	return "RenameChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameChatRequestValidationError{}
+
+var _RenameChatRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on TransferOwnershipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferOwnershipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferOwnershipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferOwnershipRequestMultiError, or nil if none found.
+func (m *TransferOwnershipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferOwnershipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	if !_TransferOwnershipRequest_NewOwner_Pattern.MatchString(m.GetNewOwner()) {
+		err := TransferOwnershipRequestValidationError{
+			field:  "NewOwner",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferOwnershipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferOwnershipRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferOwnershipRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserChatsResponseMultiError []error
+type TransferOwnershipRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserChatsResponseMultiError) Error() string {
+func (m TransferOwnershipRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -867,11 +5415,11 @@ func (m GetUserChatsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserChatsResponseMultiError) AllErrors() []error { return m }
+func (m TransferOwnershipRequestMultiError) AllErrors() []error { return m }
 
-// GetUserChatsResponseValidationError is the validation error returned by
-// GetUserChatsResponse.Validate if the designated constraints aren't met.
-type GetUserChatsResponseValidationError struct {
+// TransferOwnershipRequestValidationError is the validation error returned by
+// TransferOwnershipRequest.Validate if the designated constraints aren't met.
+type TransferOwnershipRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -879,24 +5427,24 @@ type GetUserChatsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserChatsResponseValidationError) Field() string { return e.field }
+func (e TransferOwnershipRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserChatsResponseValidationError) Reason() string { return e.reason }
+func (e TransferOwnershipRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserChatsResponseValidationError) Cause() error { return e.cause }
+func (e TransferOwnershipRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserChatsResponseValidationError) Key() bool { return e.key }
+func (e TransferOwnershipRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserChatsResponseValidationError) ErrorName() string {
-	return "GetUserChatsResponseValidationError"
+func (e TransferOwnershipRequestValidationError) ErrorName() string {
+	return "TransferOwnershipRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserChatsResponseValidationError) Error() string {
+func (e TransferOwnershipRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -908,14 +5456,14 @@ func (e GetUserChatsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserChatsResponse.%s: %s%s",
+		"invalid %sTransferOwnershipRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserChatsResponseValidationError{}
+var _ error = TransferOwnershipRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -923,24 +5471,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserChatsResponseValidationError{}
+} = TransferOwnershipRequestValidationError{}
 
-// Validate checks the field values on ChatInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ChatInfo) Validate() error {
+var _TransferOwnershipRequest_NewOwner_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SetMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMemberRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChatInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ChatInfoMultiError, or nil
-// if none found.
-func (m *ChatInfo) ValidateAll() error {
+// ValidateAll checks the field values on SetMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMemberRoleRequestMultiError, or nil if none found.
+func (m *SetMemberRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChatInfo) validate(all bool) error {
+func (m *SetMemberRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -949,21 +5499,44 @@ func (m *ChatInfo) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for Username
+
+	if !_SetMemberRoleRequest_Member_Pattern.MatchString(m.GetMember()) {
+		err := SetMemberRoleRequestValidationError{
+			field:  "Member",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetMemberRoleRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := SetMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [ROLE_ADMIN ROLE_MEMBER]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ChatInfoMultiError(errors)
+		return SetMemberRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChatInfoMultiError is an error wrapping multiple validation errors returned
-// by ChatInfo.ValidateAll() if the designated constraints aren't met.
-type ChatInfoMultiError []error
+// SetMemberRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMemberRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChatInfoMultiError) Error() string {
+func (m SetMemberRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -972,11 +5545,11 @@ func (m ChatInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChatInfoMultiError) AllErrors() []error { return m }
+func (m SetMemberRoleRequestMultiError) AllErrors() []error { return m }
 
-// ChatInfoValidationError is the validation error returned by
-// ChatInfo.Validate if the designated constraints aren't met.
-type ChatInfoValidationError struct {
+// SetMemberRoleRequestValidationError is the validation error returned by
+// SetMemberRoleRequest.Validate if the designated constraints aren't met.
+type SetMemberRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -984,22 +5557,24 @@ type ChatInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChatInfoValidationError) Field() string { return e.field }
+func (e SetMemberRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChatInfoValidationError) Reason() string { return e.reason }
+func (e SetMemberRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChatInfoValidationError) Cause() error { return e.cause }
+func (e SetMemberRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChatInfoValidationError) Key() bool { return e.key }
+func (e SetMemberRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChatInfoValidationError) ErrorName() string { return "ChatInfoValidationError" }
+func (e SetMemberRoleRequestValidationError) ErrorName() string {
+	return "SetMemberRoleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ChatInfoValidationError) Error() string {
+func (e SetMemberRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1011,14 +5586,14 @@ func (e ChatInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChatInfo.%s: %s%s",
+		"invalid %sSetMemberRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChatInfoValidationError{}
+var _ error = SetMemberRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1026,4 +5601,11 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChatInfoValidationError{}
+} = SetMemberRoleRequestValidationError{}
+
+var _SetMemberRoleRequest_Member_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _SetMemberRoleRequest_Role_InLookup = map[Role]struct{}{
+	2: {},
+	3: {},
+}
@@ -29,8 +29,55 @@ type ChatV1Client interface {
 	DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetUserChats(ctx context.Context, in *GetUserChatsRequest, opts ...grpc.CallOption) (*GetUserChatsResponse, error)
 	ConnectChat(ctx context.Context, in *ConnectChatRequest, opts ...grpc.CallOption) (ChatV1_ConnectChatClient, error)
+	// Подключает пользователя к чату и возвращает stream событий чата: новых,
+	// измененных и удаленных сообщений, добавления и удаления участников,
+	// набора текста, подключения и отключения участников, прочтения сообщений,
+	// изменения реакций, закрепления и открепления сообщений, а также события
+	// resync, когда подписчик отстал и мог пропустить события
+	ConnectChatEvents(ctx context.Context, in *ConnectChatRequest, opts ...grpc.CallOption) (ChatV1_ConnectChatEventsClient, error)
 	// Отправляет сообщение в чат
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Меняет текст сообщения. Изменить сообщение может только его автор
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Удаляет сообщение. Удалить сообщение может его автор, владелец или администратор чата
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Сообщает подключенным к чату участникам, что пользователь набирает текст.
+	// Без обновления индикатор гаснет через несколько секунд
+	SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Ставит реакцию пользователя на сообщение
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Убирает реакцию пользователя с сообщения
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Закрепляет сообщение чата от имени владельца или администратора
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Открепляет сообщение чата от имени владельца или администратора
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Возвращает закрепленные сообщения чата, начиная с закрепленных последними
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	// Возвращает историю сообщений чата (постранично)
+	GetChatMessages(ctx context.Context, in *GetChatMessagesRequest, opts ...grpc.CallOption) (*GetChatMessagesResponse, error)
+	// Возвращает ответы на сообщение чата (постранично)
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetChatMessagesResponse, error)
+	// Отмечает сообщения чата до указанного включительно прочитанными пользователем
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Возвращает автору сообщения, доставлено ли оно остальным участникам чата
+	// и прочитали ли они его
+	GetMessageStatus(ctx context.Context, in *GetMessageStatusRequest, opts ...grpc.CallOption) (*GetMessageStatusResponse, error)
+	// Возвращает, подключены ли пользователи к какому-либо чату, и время их
+	// последнего отключения
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+	// Добавляет пользователей в чат. Уже состоящие в чате пользователи пропускаются
+	AddChatMembers(ctx context.Context, in *AddChatMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Удаляет пользователя из чата и отключает его от чата
+	RemoveChatMember(ctx context.Context, in *RemoveChatMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Выводит пользователя из чата
+	LeaveChat(ctx context.Context, in *LeaveChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Переименовывает чат
+	RenameChat(ctx context.Context, in *RenameChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Передает владение чатом другому участнику. Прежний владелец становится администратором
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Назначает участнику чата роль администратора или обычного участника
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type chatV1Client struct {
@@ -100,6 +147,38 @@ func (x *chatV1ConnectChatClient) Recv() (*Message, error) {
 	return m, nil
 }
 
+func (c *chatV1Client) ConnectChatEvents(ctx context.Context, in *ConnectChatRequest, opts ...grpc.CallOption) (ChatV1_ConnectChatEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatV1_ServiceDesc.Streams[1], "/chat_v1.ChatV1/ConnectChatEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatV1ConnectChatEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatV1_ConnectChatEventsClient interface {
+	Recv() (*ChatEvent, error)
+	grpc.ClientStream
+}
+
+type chatV1ConnectChatEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatV1ConnectChatEventsClient) Recv() (*ChatEvent, error) {
+	m := new(ChatEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *chatV1Client) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/SendMessage", in, out, opts...)
@@ -109,6 +188,177 @@ func (c *chatV1Client) SendMessage(ctx context.Context, in *SendMessageRequest,
 	return out, nil
 }
 
+func (c *chatV1Client) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/EditMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/DeleteMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/SetTyping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/AddReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/RemoveReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/PinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/UnpinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/ListPinnedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) GetChatMessages(ctx context.Context, in *GetChatMessagesRequest, opts ...grpc.CallOption) (*GetChatMessagesResponse, error) {
+	out := new(GetChatMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/GetChatMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetChatMessagesResponse, error) {
+	out := new(GetChatMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/GetThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) GetMessageStatus(ctx context.Context, in *GetMessageStatusRequest, opts ...grpc.CallOption) (*GetMessageStatusResponse, error) {
+	out := new(GetMessageStatusResponse)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/GetMessageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/GetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) AddChatMembers(ctx context.Context, in *AddChatMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/AddChatMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) RemoveChatMember(ctx context.Context, in *RemoveChatMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/RemoveChatMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) LeaveChat(ctx context.Context, in *LeaveChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/LeaveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) RenameChat(ctx context.Context, in *RenameChatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/RenameChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatV1Client) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/chat_v1.ChatV1/SetMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatV1Server is the server API for ChatV1 service.
 // All implementations must embed UnimplementedChatV1Server
 // for forward compatibility
@@ -119,8 +369,55 @@ type ChatV1Server interface {
 	DeleteChat(context.Context, *DeleteChatRequest) (*emptypb.Empty, error)
 	GetUserChats(context.Context, *GetUserChatsRequest) (*GetUserChatsResponse, error)
 	ConnectChat(*ConnectChatRequest, ChatV1_ConnectChatServer) error
+	// Подключает пользователя к чату и возвращает stream событий чата: новых,
+	// измененных и удаленных сообщений, добавления и удаления участников,
+	// набора текста, подключения и отключения участников, прочтения сообщений,
+	// изменения реакций, закрепления и открепления сообщений, а также события
+	// resync, когда подписчик отстал и мог пропустить события
+	ConnectChatEvents(*ConnectChatRequest, ChatV1_ConnectChatEventsServer) error
 	// Отправляет сообщение в чат
 	SendMessage(context.Context, *SendMessageRequest) (*emptypb.Empty, error)
+	// Меняет текст сообщения. Изменить сообщение может только его автор
+	EditMessage(context.Context, *EditMessageRequest) (*emptypb.Empty, error)
+	// Удаляет сообщение. Удалить сообщение может его автор, владелец или администратор чата
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*emptypb.Empty, error)
+	// Сообщает подключенным к чату участникам, что пользователь набирает текст.
+	// Без обновления индикатор гаснет через несколько секунд
+	SetTyping(context.Context, *SetTypingRequest) (*emptypb.Empty, error)
+	// Ставит реакцию пользователя на сообщение
+	AddReaction(context.Context, *AddReactionRequest) (*emptypb.Empty, error)
+	// Убирает реакцию пользователя с сообщения
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*emptypb.Empty, error)
+	// Закрепляет сообщение чата от имени владельца или администратора
+	PinMessage(context.Context, *PinMessageRequest) (*emptypb.Empty, error)
+	// Открепляет сообщение чата от имени владельца или администратора
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*emptypb.Empty, error)
+	// Возвращает закрепленные сообщения чата, начиная с закрепленных последними
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	// Возвращает историю сообщений чата (постранично)
+	GetChatMessages(context.Context, *GetChatMessagesRequest) (*GetChatMessagesResponse, error)
+	// Возвращает ответы на сообщение чата (постранично)
+	GetThread(context.Context, *GetThreadRequest) (*GetChatMessagesResponse, error)
+	// Отмечает сообщения чата до указанного включительно прочитанными пользователем
+	MarkRead(context.Context, *MarkReadRequest) (*emptypb.Empty, error)
+	// Возвращает автору сообщения, доставлено ли оно остальным участникам чата
+	// и прочитали ли они его
+	GetMessageStatus(context.Context, *GetMessageStatusRequest) (*GetMessageStatusResponse, error)
+	// Возвращает, подключены ли пользователи к какому-либо чату, и время их
+	// последнего отключения
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
+	// Добавляет пользователей в чат. Уже состоящие в чате пользователи пропускаются
+	AddChatMembers(context.Context, *AddChatMembersRequest) (*emptypb.Empty, error)
+	// Удаляет пользователя из чата и отключает его от чата
+	RemoveChatMember(context.Context, *RemoveChatMemberRequest) (*emptypb.Empty, error)
+	// Выводит пользователя из чата
+	LeaveChat(context.Context, *LeaveChatRequest) (*emptypb.Empty, error)
+	// Переименовывает чат
+	RenameChat(context.Context, *RenameChatRequest) (*emptypb.Empty, error)
+	// Передает владение чатом другому участнику. Прежний владелец становится администратором
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error)
+	// Назначает участнику чата роль администратора или обычного участника
+	SetMemberRole(context.Context, *SetMemberRoleRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedChatV1Server()
 }
 
@@ -140,9 +437,69 @@ func (UnimplementedChatV1Server) GetUserChats(context.Context, *GetUserChatsRequ
 func (UnimplementedChatV1Server) ConnectChat(*ConnectChatRequest, ChatV1_ConnectChatServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConnectChat not implemented")
 }
+func (UnimplementedChatV1Server) ConnectChatEvents(*ConnectChatRequest, ChatV1_ConnectChatEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConnectChatEvents not implemented")
+}
 func (UnimplementedChatV1Server) SendMessage(context.Context, *SendMessageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedChatV1Server) EditMessage(context.Context, *EditMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatV1Server) DeleteMessage(context.Context, *DeleteMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedChatV1Server) SetTyping(context.Context, *SetTypingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTyping not implemented")
+}
+func (UnimplementedChatV1Server) AddReaction(context.Context, *AddReactionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedChatV1Server) RemoveReaction(context.Context, *RemoveReactionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedChatV1Server) PinMessage(context.Context, *PinMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedChatV1Server) UnpinMessage(context.Context, *UnpinMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedChatV1Server) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedChatV1Server) GetChatMessages(context.Context, *GetChatMessagesRequest) (*GetChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatMessages not implemented")
+}
+func (UnimplementedChatV1Server) GetThread(context.Context, *GetThreadRequest) (*GetChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedChatV1Server) MarkRead(context.Context, *MarkReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedChatV1Server) GetMessageStatus(context.Context, *GetMessageStatusRequest) (*GetMessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageStatus not implemented")
+}
+func (UnimplementedChatV1Server) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedChatV1Server) AddChatMembers(context.Context, *AddChatMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMembers not implemented")
+}
+func (UnimplementedChatV1Server) RemoveChatMember(context.Context, *RemoveChatMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatMember not implemented")
+}
+func (UnimplementedChatV1Server) LeaveChat(context.Context, *LeaveChatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChat not implemented")
+}
+func (UnimplementedChatV1Server) RenameChat(context.Context, *RenameChatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameChat not implemented")
+}
+func (UnimplementedChatV1Server) TransferOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedChatV1Server) SetMemberRole(context.Context, *SetMemberRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberRole not implemented")
+}
 func (UnimplementedChatV1Server) mustEmbedUnimplementedChatV1Server() {}
 
 // UnsafeChatV1Server may be embedded to opt out of forward compatibility for this service.
@@ -231,6 +588,27 @@ func (x *chatV1ConnectChatServer) Send(m *Message) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ChatV1_ConnectChatEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatV1Server).ConnectChatEvents(m, &chatV1ConnectChatEventsServer{stream})
+}
+
+type ChatV1_ConnectChatEventsServer interface {
+	Send(*ChatEvent) error
+	grpc.ServerStream
+}
+
+type chatV1ConnectChatEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatV1ConnectChatEventsServer) Send(m *ChatEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ChatV1_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendMessageRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +627,348 @@ func _ChatV1_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatV1_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/EditMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/DeleteMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_SetTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).SetTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/SetTyping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).SetTyping(ctx, req.(*SetTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/AddReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/RemoveReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/PinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/UnpinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/ListPinnedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_GetChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).GetChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/GetChatMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).GetChatMessages(ctx, req.(*GetChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/GetThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_GetMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).GetMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/GetMessageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).GetMessageStatus(ctx, req.(*GetMessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/GetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_AddChatMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).AddChatMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/AddChatMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).AddChatMembers(ctx, req.(*AddChatMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_RemoveChatMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).RemoveChatMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/RemoveChatMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).RemoveChatMember(ctx, req.(*RemoveChatMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_LeaveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).LeaveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/LeaveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).LeaveChat(ctx, req.(*LeaveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_RenameChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).RenameChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/RenameChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).RenameChat(ctx, req.(*RenameChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatV1_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatV1Server).SetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_v1.ChatV1/SetMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatV1Server).SetMemberRole(ctx, req.(*SetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatV1_ServiceDesc is the grpc.ServiceDesc for ChatV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,6 +992,82 @@ var ChatV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _ChatV1_SendMessage_Handler,
 		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _ChatV1_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _ChatV1_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "SetTyping",
+			Handler:    _ChatV1_SetTyping_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _ChatV1_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _ChatV1_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _ChatV1_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _ChatV1_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _ChatV1_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "GetChatMessages",
+			Handler:    _ChatV1_GetChatMessages_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _ChatV1_GetThread_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _ChatV1_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetMessageStatus",
+			Handler:    _ChatV1_GetMessageStatus_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _ChatV1_GetPresence_Handler,
+		},
+		{
+			MethodName: "AddChatMembers",
+			Handler:    _ChatV1_AddChatMembers_Handler,
+		},
+		{
+			MethodName: "RemoveChatMember",
+			Handler:    _ChatV1_RemoveChatMember_Handler,
+		},
+		{
+			MethodName: "LeaveChat",
+			Handler:    _ChatV1_LeaveChat_Handler,
+		},
+		{
+			MethodName: "RenameChat",
+			Handler:    _ChatV1_RenameChat_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _ChatV1_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "SetMemberRole",
+			Handler:    _ChatV1_SetMemberRole_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -279,6 +1075,11 @@ var ChatV1_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ChatV1_ConnectChat_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ConnectChatEvents",
+			Handler:       _ChatV1_ConnectChatEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "chat.proto",
 }